@@ -0,0 +1,109 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package checkpoint persists the progress of long-running reconciles.
+//
+// A reconciler driving a multi-step flow, such as provisioning external resources, can store a
+// Checkpoint after each step with a Store, and Load it at the beginning of each reconciliation to
+// resume where it left off, instead of starting from scratch after an operator restart. Once the
+// flow is complete, the Checkpoint is cleared.
+//
+// Checkpoints are stored either in the status of the custom resource, see NewStatusStore, or in
+// a ConfigMap owned by it, see NewConfigMapStore. Writes are retried on conflicts, so that a
+// Checkpoint is never stored over changes made concurrently. A Checkpoint is only stored if it
+// was not stored by someone else since it was loaded, otherwise ErrCheckpointChanged is returned
+// and the flow must be resumed from a freshly loaded Checkpoint.
+//
+// Stores read the stored Checkpoint with the client they are given. The client of a manager
+// reads from its cache, which may return a Checkpoint older than the last one stored, so a
+// client reading from the API server, such as one created with client.New, should be used.
+package checkpoint
+
+import (
+	"context"
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("checkpoint")
+
+// ErrCheckpointChanged is returned by Store.Store when the stored Checkpoint was changed since
+// the Checkpoint being stored was loaded.
+var ErrCheckpointChanged = errors.New("checkpoint changed since it was loaded")
+
+// Checkpoint is a small progress marker of a multi-step flow. It can be embedded in the status
+// of a custom resource, see NewStatusStore.
+type Checkpoint struct {
+	// Phase is the current phase of the flow.
+	Phase string `json:"phase,omitempty"`
+	// Step is the index of the current step in Phase.
+	Step int `json:"step,omitempty"`
+	// ExternalIDs are the IDs of the external resources created so far, by name.
+	ExternalIDs map[string]string `json:"externalIDs,omitempty"`
+	// UpdatedAt is the last time the Checkpoint was stored.
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
+	// Revision is incremented each time the Checkpoint is stored, to detect concurrent writes.
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// ExternalID returns the external ID stored with name, and true if there is one.
+func (c *Checkpoint) ExternalID(name string) (string, bool) {
+	id, ok := c.ExternalIDs[name]
+	return id, ok
+}
+
+// SetExternalID stores the external ID id with name.
+func (c *Checkpoint) SetExternalID(name, id string) {
+	if c.ExternalIDs == nil {
+		c.ExternalIDs = map[string]string{}
+	}
+	c.ExternalIDs[name] = id
+}
+
+// DeepCopyInto copies c into out.
+func (c *Checkpoint) DeepCopyInto(out *Checkpoint) {
+	*out = *c
+	c.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
+	if c.ExternalIDs != nil {
+		out.ExternalIDs = make(map[string]string, len(c.ExternalIDs))
+		for name, id := range c.ExternalIDs {
+			out.ExternalIDs[name] = id
+		}
+	}
+}
+
+// DeepCopy returns a copy of c.
+func (c *Checkpoint) DeepCopy() *Checkpoint {
+	if c == nil {
+		return nil
+	}
+	out := &Checkpoint{}
+	c.DeepCopyInto(out)
+	return out
+}
+
+// Store persists the Checkpoint of an object.
+type Store interface {
+	// Load returns the stored Checkpoint, or nil if there is none.
+	Load(ctx context.Context) (*Checkpoint, error)
+	// Store stores checkpoint, setting its UpdatedAt to the current time and incrementing its
+	// Revision. It returns ErrCheckpointChanged, wrapped, if the stored Checkpoint does not have
+	// the Revision of checkpoint, i.e. it was stored or cleared since checkpoint was loaded. A new
+	// Checkpoint, with a zero Revision, can only be stored if there is none.
+	Store(ctx context.Context, checkpoint *Checkpoint) error
+	// Clear removes the stored Checkpoint, if any.
+	Clear(ctx context.Context) error
+}
@@ -0,0 +1,240 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpoint
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+type clusterStatus struct {
+	Ready      bool        `json:"ready,omitempty"`
+	Checkpoint *Checkpoint `json:"checkpoint,omitempty"`
+}
+
+type cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Status            clusterStatus `json:"status,omitempty"`
+}
+
+func (c *cluster) DeepCopyObject() runtime.Object {
+	out := &cluster{TypeMeta: c.TypeMeta, Status: clusterStatus{Ready: c.Status.Ready, Checkpoint: c.Status.Checkpoint.DeepCopy()}}
+	c.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	return out
+}
+
+func clusterCheckpoint(c *cluster) **Checkpoint {
+	return &c.Status.Checkpoint
+}
+
+var _ = Describe("Checkpoint", func() {
+	It("should copy external IDs", func() {
+		checkpoint := &Checkpoint{Phase: "Provisioning"}
+		_, ok := checkpoint.ExternalID("volume")
+		Expect(ok).To(BeFalse())
+
+		checkpoint.SetExternalID("volume", "vol-1")
+		out := checkpoint.DeepCopy()
+		out.SetExternalID("volume", "vol-2")
+		Expect(checkpoint.ExternalIDs).To(HaveKeyWithValue("volume", "vol-1"))
+		Expect(out.ExternalIDs).To(HaveKeyWithValue("volume", "vol-2"))
+		Expect((*Checkpoint)(nil).DeepCopy()).To(BeNil())
+	})
+})
+
+var _ = Describe("Store", func() {
+	var (
+		ctx       context.Context
+		scheme    *runtime.Scheme
+		owner     *cluster
+		conflicts int
+		builder   *fake.ClientBuilder
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gv := schema.GroupVersion{Group: "example.com", Version: "v1"}
+		scheme = runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		scheme.AddKnownTypes(gv, &cluster{})
+		metav1.AddToGroupVersion(scheme, gv)
+
+		owner = &cluster{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gv.String(), Kind: "cluster"},
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default", UID: "uid"},
+		}
+		conflicts = 0
+		builder = fake.NewClientBuilder().WithScheme(scheme).WithObjects(owner).WithStatusSubresource(owner).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					if conflicts > 0 {
+						conflicts--
+						return apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, obj.GetName(), nil)
+					}
+					return c.Patch(ctx, obj, patch, opts...)
+				},
+				SubResourcePatch: func(ctx context.Context, c client.Client, subResource string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+					if conflicts > 0 {
+						conflicts--
+						return apierrors.NewConflict(schema.GroupResource{Resource: "clusters"}, obj.GetName(), nil)
+					}
+					return c.SubResource(subResource).Patch(ctx, obj, patch, opts...)
+				},
+			})
+	})
+
+	expectRoundTrip := func(store Store) {
+		checkpoint, err := store.Load(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkpoint).To(BeNil())
+
+		stored := &Checkpoint{Phase: "Provisioning", Step: 2}
+		stored.SetExternalID("volume", "vol-1")
+		Expect(store.Store(ctx, stored)).To(Succeed())
+		Expect(stored.Revision).To(Equal(int64(1)))
+		checkpoint, err = store.Load(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkpoint.Phase).To(Equal("Provisioning"))
+		Expect(checkpoint.Step).To(Equal(2))
+		Expect(checkpoint.ExternalIDs).To(HaveKeyWithValue("volume", "vol-1"))
+		Expect(checkpoint.UpdatedAt.IsZero()).To(BeFalse())
+		Expect(checkpoint.Revision).To(Equal(int64(1)))
+
+		conflicts = 2
+		stored.Step = 3
+		Expect(store.Store(ctx, stored)).To(Succeed())
+		Expect(conflicts).To(BeZero())
+		checkpoint, err = store.Load(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkpoint.Step).To(Equal(3))
+
+		Expect(store.Clear(ctx)).To(Succeed())
+		Expect(store.Clear(ctx)).To(Succeed())
+		checkpoint, err = store.Load(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkpoint).To(BeNil())
+	}
+
+	expectStaleWritesRejected := func(store Store) {
+		Expect(store.Store(ctx, &Checkpoint{Phase: "Provisioning"})).To(Succeed())
+		loaded, err := store.Load(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		concurrent, err := store.Load(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		concurrent.Step = 1
+		Expect(store.Store(ctx, concurrent)).To(Succeed())
+		loaded.Phase = "Configuring"
+		Expect(store.Store(ctx, loaded)).To(MatchError(ErrCheckpointChanged))
+		Expect(store.Store(ctx, &Checkpoint{Phase: "Configuring"})).To(MatchError(ErrCheckpointChanged))
+
+		checkpoint, err := store.Load(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkpoint.Phase).To(Equal("Provisioning"))
+		Expect(checkpoint.Step).To(Equal(1))
+
+		Expect(store.Clear(ctx)).To(Succeed())
+		Expect(store.Store(ctx, concurrent)).To(MatchError(ErrCheckpointChanged))
+	}
+
+	Context("NewStatusStore", func() {
+		It("should keep the checkpoint in the status", func() {
+			cl := builder.Build()
+			expectRoundTrip(NewStatusStore(cl, owner, clusterCheckpoint))
+		})
+
+		It("should not store checkpoints changed since they were loaded", func() {
+			expectStaleWritesRejected(NewStatusStore(builder.Build(), owner, clusterCheckpoint))
+		})
+
+		It("should keep other status fields changed concurrently", func() {
+			cl := builder.Build()
+			store := NewStatusStore(cl, owner, clusterCheckpoint)
+			checkpoint := &Checkpoint{Phase: "Provisioning"}
+			Expect(store.Store(ctx, checkpoint)).To(Succeed())
+
+			current := &cluster{}
+			Expect(cl.Get(ctx, client.ObjectKeyFromObject(owner), current)).To(Succeed())
+			current.Status.Ready = true
+			Expect(cl.Status().Update(ctx, current)).To(Succeed())
+
+			checkpoint.Phase = "Configuring"
+			Expect(store.Store(ctx, checkpoint)).To(Succeed())
+			Expect(cl.Get(ctx, client.ObjectKeyFromObject(owner), current)).To(Succeed())
+			Expect(current.Status.Ready).To(BeTrue())
+			Expect(current.Status.Checkpoint.Phase).To(Equal("Configuring"))
+		})
+
+		It("should fail when the object does not exist", func() {
+			cl := fake.NewClientBuilder().WithScheme(scheme).Build()
+			store := NewStatusStore(cl, owner, clusterCheckpoint)
+			_, err := store.Load(ctx)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			Expect(apierrors.IsNotFound(store.Store(ctx, &Checkpoint{}))).To(BeTrue())
+		})
+	})
+
+	Context("NewConfigMapStore", func() {
+		It("should keep the checkpoint in a ConfigMap owned by the owner", func() {
+			cl := builder.Build()
+			store := NewConfigMapStore(cl, owner, "db-checkpoint")
+			Expect(store.Store(ctx, &Checkpoint{Phase: "Provisioning"})).To(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			Expect(cl.Get(ctx, client.ObjectKey{Namespace: "default", Name: "db-checkpoint"}, cm)).To(Succeed())
+			Expect(cm.Data).To(HaveKeyWithValue(ConfigMapCheckpointKey, ContainSubstring(`"phase":"Provisioning"`)))
+			Expect(cm.OwnerReferences).To(HaveLen(1))
+			Expect(cm.OwnerReferences[0].UID).To(Equal(owner.UID))
+
+			Expect(store.Clear(ctx)).To(Succeed())
+			Expect(apierrors.IsNotFound(cl.Get(ctx, client.ObjectKeyFromObject(cm), cm))).To(BeTrue())
+
+			expectRoundTrip(store)
+		})
+
+		It("should not store checkpoints changed since they were loaded", func() {
+			expectStaleWritesRejected(NewConfigMapStore(builder.Build(), owner, "db-checkpoint"))
+		})
+
+		It("should fail on malformed checkpoints", func() {
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "db-checkpoint", Namespace: "default"},
+				Data:       map[string]string{ConfigMapCheckpointKey: "{"},
+			}
+			cl := builder.WithObjects(cm).Build()
+			_, err := NewConfigMapStore(cl, owner, "db-checkpoint").Load(ctx)
+			Expect(err).To(MatchError(ContainSubstring("error decoding checkpoint")))
+		})
+
+		It("should fail for cluster-scoped owners", func() {
+			cl := builder.Build()
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+			err := NewConfigMapStore(cl, node, "node-checkpoint").Store(ctx, &Checkpoint{})
+			Expect(err).To(MatchError(ContainSubstring("must be namespaced")))
+		})
+	})
+})
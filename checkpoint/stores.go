@@ -0,0 +1,242 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpoint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ConfigMapCheckpointKey is the key of the data of the ConfigMaps of NewConfigMapStore storing
+// the Checkpoint as JSON.
+const ConfigMapCheckpointKey = "checkpoint"
+
+// NewStatusStore returns a Store keeping the Checkpoint in the status of objects like obj,
+// typically a custom resource. checkpoint returns a pointer to the Checkpoint field of an
+// object, e.g.
+//
+//	checkpoint.NewStatusStore(cl, cluster, func(c *dbv1.Cluster) **checkpoint.Checkpoint {
+//		return &c.Status.Checkpoint
+//	})
+//
+// The object is read again before each access, obj is only used for its type and key. Writes
+// are made with optimistic locking and retried on conflicts, so that other status fields
+// changed concurrently are kept. cl should read from the API server rather than from a cache,
+// see the package documentation.
+func NewStatusStore[T client.Object](cl client.Client, obj T, checkpoint func(T) **Checkpoint) Store {
+	return &statusStore[T]{client: cl, obj: obj, checkpoint: checkpoint}
+}
+
+// statusStore is a Store keeping the Checkpoint in the status of objects of type T.
+type statusStore[T client.Object] struct {
+	client     client.Client
+	obj        T
+	checkpoint func(T) **Checkpoint
+}
+
+func (s *statusStore[T]) Load(ctx context.Context) (*Checkpoint, error) {
+	obj, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return (*s.checkpoint(obj)).DeepCopy(), nil
+}
+
+func (s *statusStore[T]) Store(ctx context.Context, checkpoint *Checkpoint) error {
+	next := checkpoint.DeepCopy()
+	next.UpdatedAt = metav1.Now()
+	next.Revision++
+	err := s.update(ctx, func(current **Checkpoint) (bool, error) {
+		if err := checkRevision(*current, checkpoint); err != nil {
+			return false, err
+		}
+		*current = next.DeepCopy()
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	checkpoint.UpdatedAt, checkpoint.Revision = next.UpdatedAt, next.Revision
+	log.V(1).Info("Stored checkpoint", "object", client.ObjectKeyFromObject(s.obj), "phase", checkpoint.Phase, "step", checkpoint.Step)
+	return nil
+}
+
+func (s *statusStore[T]) Clear(ctx context.Context) error {
+	return s.update(ctx, func(current **Checkpoint) (bool, error) {
+		if *current == nil {
+			return false, nil
+		}
+		*current = nil
+		return true, nil
+	})
+}
+
+// update patches the status of the object with mutate, if it returns true, retrying on
+// conflicts with the latest version of the object.
+func (s *statusStore[T]) update(ctx context.Context, mutate func(**Checkpoint) (bool, error)) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, err := s.get(ctx)
+		if err != nil {
+			return err
+		}
+		base := obj.DeepCopyObject().(client.Object)
+		if ok, err := mutate(s.checkpoint(obj)); err != nil || !ok {
+			return err
+		}
+		return s.client.Status().Patch(ctx, obj, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{}))
+	})
+	if err != nil {
+		return fmt.Errorf("error storing checkpoint of %s: %w", client.ObjectKeyFromObject(s.obj), err)
+	}
+	return nil
+}
+
+func (s *statusStore[T]) get(ctx context.Context) (T, error) {
+	obj := s.obj.DeepCopyObject().(T)
+	if err := s.client.Get(ctx, client.ObjectKeyFromObject(s.obj), obj); err != nil {
+		return obj, fmt.Errorf("error getting %s: %w", client.ObjectKeyFromObject(s.obj), err)
+	}
+	return obj, nil
+}
+
+// NewConfigMapStore returns a Store keeping the Checkpoint as JSON in the
+// ConfigMapCheckpointKey of the ConfigMap with the given name in the namespace of owner. The
+// ConfigMap is created with an owner reference to owner when a Checkpoint is first stored, so
+// that it is garbage collected along with owner, and deleted when the Checkpoint is cleared.
+// This allows keeping checkpoints of objects without a status, or without changing their API.
+//
+// owner must be namespaced. Writes are made with optimistic locking and retried on conflicts.
+// cl should read from the API server rather than from a cache, see the package documentation.
+func NewConfigMapStore(cl client.Client, owner client.Object, name string) Store {
+	return &configMapStore{client: cl, owner: owner, key: types.NamespacedName{Namespace: owner.GetNamespace(), Name: name}}
+}
+
+// configMapStore is a Store keeping the Checkpoint in a ConfigMap.
+type configMapStore struct {
+	client client.Client
+	owner  client.Object
+	key    types.NamespacedName
+}
+
+func (s *configMapStore) Load(ctx context.Context) (*Checkpoint, error) {
+	cm := &corev1.ConfigMap{}
+	if err := s.client.Get(ctx, s.key, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting checkpoint ConfigMap %s: %w", s.key, err)
+	}
+	return decodeConfigMapCheckpoint(cm)
+}
+
+func (s *configMapStore) Store(ctx context.Context, checkpoint *Checkpoint) error {
+	if s.key.Namespace == "" {
+		return errors.New("error storing checkpoint: the owner of a checkpoint ConfigMap must be namespaced")
+	}
+	next := checkpoint.DeepCopy()
+	next.UpdatedAt = metav1.Now()
+	next.Revision++
+	data, err := json.Marshal(next)
+	if err != nil {
+		return fmt.Errorf("error encoding checkpoint: %w", err)
+	}
+
+	retriable := func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}
+	err = retry.OnError(retry.DefaultRetry, retriable, func() error {
+		cm := &corev1.ConfigMap{}
+		err := s.client.Get(ctx, s.key, cm)
+		if apierrors.IsNotFound(err) {
+			if err := checkRevision(nil, checkpoint); err != nil {
+				return err
+			}
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: s.key.Name, Namespace: s.key.Namespace},
+				Data:       map[string]string{ConfigMapCheckpointKey: string(data)},
+			}
+			if err := controllerutil.SetOwnerReference(s.owner, cm, s.client.Scheme()); err != nil {
+				return err
+			}
+			return s.client.Create(ctx, cm)
+		}
+		if err != nil {
+			return err
+		}
+		current, err := decodeConfigMapCheckpoint(cm)
+		if err != nil {
+			return err
+		}
+		if err := checkRevision(current, checkpoint); err != nil {
+			return err
+		}
+
+		base := cm.DeepCopy()
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[ConfigMapCheckpointKey] = string(data)
+		return s.client.Patch(ctx, cm, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{}))
+	})
+	if err != nil {
+		return fmt.Errorf("error storing checkpoint in ConfigMap %s: %w", s.key, err)
+	}
+	checkpoint.UpdatedAt, checkpoint.Revision = next.UpdatedAt, next.Revision
+	log.V(1).Info("Stored checkpoint", "configMap", s.key, "phase", checkpoint.Phase, "step", checkpoint.Step)
+	return nil
+}
+
+func (s *configMapStore) Clear(ctx context.Context) error {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: s.key.Name, Namespace: s.key.Namespace}}
+	if err := s.client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting checkpoint ConfigMap %s: %w", s.key, err)
+	}
+	return nil
+}
+
+// checkRevision returns ErrCheckpointChanged if the current stored Checkpoint, nil if there is
+// none, does not have the Revision of checkpoint.
+func checkRevision(current, checkpoint *Checkpoint) error {
+	var revision int64
+	if current != nil {
+		revision = current.Revision
+	}
+	if revision != checkpoint.Revision {
+		return fmt.Errorf("%w: stored revision %d, loaded revision %d", ErrCheckpointChanged, revision, checkpoint.Revision)
+	}
+	return nil
+}
+
+func decodeConfigMapCheckpoint(cm *corev1.ConfigMap) (*Checkpoint, error) {
+	data, ok := cm.Data[ConfigMapCheckpointKey]
+	if !ok || data == "" {
+		return nil, nil
+	}
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal([]byte(data), checkpoint); err != nil {
+		return nil, fmt.Errorf("error decoding checkpoint of ConfigMap %s: %w", client.ObjectKeyFromObject(cm), err)
+	}
+	return checkpoint, nil
+}
@@ -0,0 +1,291 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ConfigMapConditionsKey is the key of the data of a ConfigMap used as a Backend, see
+// NewConfigMapBackend, holding the conditions as a JSON array.
+const ConfigMapConditionsKey = "conditions"
+
+// Backend stores conditions in an object, such as the operator's OperatorCondition, the status
+// of a custom resource or a ConfigMap. Conditions built by a BackendFactory read and write them
+// through their Backend, so that the same code works with any of them.
+type Backend interface {
+	// NamespacedName returns the name and namespace of the object storing the conditions.
+	NamespacedName() types.NamespacedName
+
+	// Conditions returns the stored conditions.
+	Conditions(ctx context.Context) ([]metav1.Condition, error)
+
+	// UpdateConditions reads the stored conditions, calls mutate with them, and stores them
+	// if mutate returns true.
+	UpdateConditions(ctx context.Context, mutate func(conditions *[]metav1.Condition) bool) error
+}
+
+// BackendFactory is a conditions factory building conditions stored by its Backend.
+type BackendFactory struct {
+	Backend Backend
+}
+
+var _ Factory = BackendFactory{}
+
+// NewCondition returns a Condition of the given type stored by the factory's Backend. For local
+// development, the conditions can be read and written locally instead, see DevOverrideEnvVar.
+func (f BackendFactory) NewCondition(condType apiv2.ConditionType) (Condition, error) {
+	if c, err := devOverride(condType); c != nil || err != nil {
+		return c, err
+	}
+	if f.Backend == nil {
+		return nil, fmt.Errorf("no conditions backend set")
+	}
+	return &backendCondition{backend: f.Backend, condType: condType}, nil
+}
+
+// GetNamespacedName returns the NamespacedName of the object storing the conditions.
+func (f BackendFactory) GetNamespacedName() (*types.NamespacedName, error) {
+	if f.Backend == nil {
+		return nil, fmt.Errorf("no conditions backend set")
+	}
+	key := f.Backend.NamespacedName()
+	return &key, nil
+}
+
+// NewFactory returns an InClusterFactory if OLM is available in the cluster of cl, see
+// IsOLMAvailable, and a BackendFactory storing conditions with fallback otherwise, so that
+// operators can set their conditions identically on clusters with and without OLM.
+func NewFactory(cl client.Client, fallback Backend) (Factory, error) {
+	available, err := IsOLMAvailable(cl)
+	if err != nil {
+		return nil, fmt.Errorf("error checking whether OLM is available: %w", err)
+	}
+	if available {
+		return InClusterFactory{Client: cl}, nil
+	}
+	return BackendFactory{Backend: fallback}, nil
+}
+
+// backendCondition is a Condition that gets and sets a specific conditionType in a Backend.
+type backendCondition struct {
+	backend  Backend
+	condType apiv2.ConditionType
+}
+
+var _ Condition = &backendCondition{}
+
+// Get implements conditions.Get
+func (c *backendCondition) Get(ctx context.Context) (*metav1.Condition, error) {
+	conditions, err := c.backend.Conditions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	con := meta.FindStatusCondition(conditions, string(c.condType))
+	if con == nil {
+		return nil, fmt.Errorf("conditionType %v not found", c.condType)
+	}
+	return con, nil
+}
+
+// Set implements conditions.Set
+func (c *backendCondition) Set(ctx context.Context, status metav1.ConditionStatus, option ...Option) error {
+	newCond := metav1.Condition{
+		Type:   string(c.condType),
+		Status: status,
+	}
+	for _, opt := range option {
+		opt(&newCond)
+	}
+	return c.backend.UpdateConditions(ctx, func(conditions *[]metav1.Condition) bool {
+		return meta.SetStatusCondition(conditions, newCond)
+	})
+}
+
+// NewOperatorConditionBackend returns a Backend storing conditions in the spec.conditions of
+// the OperatorCondition with the given key, like the conditions of an InClusterFactory.
+func NewOperatorConditionBackend(cl client.Client, key types.NamespacedName) Backend {
+	obj := &apiv2.OperatorCondition{}
+	obj.SetName(key.Name)
+	obj.SetNamespace(key.Namespace)
+	return &objectBackend[*apiv2.OperatorCondition]{
+		client: cl,
+		obj:    obj,
+		conditions: func(o *apiv2.OperatorCondition) *[]metav1.Condition {
+			return &o.Spec.Conditions
+		},
+		olm: true,
+	}
+}
+
+// NewStatusBackend returns a Backend storing conditions in the status of objects like obj, such
+// as a custom resource. conditions returns a pointer to the conditions of an object, e.g.
+//
+//	conditions.NewStatusBackend(cl, memcached, func(m *cachev1.Memcached) *[]metav1.Condition {
+//		return &m.Status.Conditions
+//	})
+//
+// The object is read again before each access, obj is only used for its type and key.
+func NewStatusBackend[T client.Object](cl client.Client, obj T, conditions func(T) *[]metav1.Condition) Backend {
+	return &objectBackend[T]{
+		client:     cl,
+		obj:        obj,
+		conditions: conditions,
+		status:     true,
+	}
+}
+
+// objectBackend is a Backend storing conditions in objects of type T.
+type objectBackend[T client.Object] struct {
+	client     client.Client
+	obj        T
+	conditions func(T) *[]metav1.Condition
+
+	// status is true if the conditions are written with the status subresource
+	status bool
+	// olm is true if the objects are OperatorConditions
+	olm bool
+}
+
+func (b *objectBackend[T]) NamespacedName() types.NamespacedName {
+	return client.ObjectKeyFromObject(b.obj)
+}
+
+func (b *objectBackend[T]) Conditions(ctx context.Context) ([]metav1.Condition, error) {
+	obj, err := b.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return *b.conditions(obj), nil
+}
+
+func (b *objectBackend[T]) UpdateConditions(ctx context.Context, mutate func(*[]metav1.Condition) bool) error {
+	obj, err := b.get(ctx)
+	if err != nil {
+		return err
+	}
+	if !mutate(b.conditions(obj)) {
+		return nil
+	}
+
+	if b.status {
+		err = b.client.Status().Update(ctx, obj)
+	} else {
+		err = b.client.Update(ctx, obj)
+	}
+	recordWriteError(err)
+	return b.wrapError(err)
+}
+
+func (b *objectBackend[T]) get(ctx context.Context) (T, error) {
+	obj := b.obj.DeepCopyObject().(T)
+	if err := b.client.Get(ctx, client.ObjectKeyFromObject(b.obj), obj); err != nil {
+		return obj, b.wrapError(err)
+	}
+	return obj, nil
+}
+
+func (b *objectBackend[T]) wrapError(err error) error {
+	if b.olm {
+		return wrapOLMError(err)
+	}
+	return err
+}
+
+// NewConfigMapBackend returns a Backend storing conditions as a JSON array in the
+// ConfigMapConditionsKey of the ConfigMap with the given key. The ConfigMap is created when a
+// condition is first set, which allows keeping conditions on clusters without OLM.
+func NewConfigMapBackend(cl client.Client, key types.NamespacedName) Backend {
+	return &configMapBackend{client: cl, key: key}
+}
+
+// configMapBackend is a Backend storing conditions in a ConfigMap.
+type configMapBackend struct {
+	client client.Client
+	key    types.NamespacedName
+}
+
+func (b *configMapBackend) NamespacedName() types.NamespacedName {
+	return b.key
+}
+
+func (b *configMapBackend) Conditions(ctx context.Context) ([]metav1.Condition, error) {
+	cm := &corev1.ConfigMap{}
+	if err := b.client.Get(ctx, b.key, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeConfigMapConditions(cm)
+}
+
+func (b *configMapBackend) UpdateConditions(ctx context.Context, mutate func(*[]metav1.Condition) bool) error {
+	cm := &corev1.ConfigMap{}
+	err := b.client.Get(ctx, b.key, cm)
+	create := apierrors.IsNotFound(err)
+	if err != nil && !create {
+		return err
+	}
+	if create {
+		cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: b.key.Name, Namespace: b.key.Namespace}}
+	}
+
+	conditions, err := decodeConfigMapConditions(cm)
+	if err != nil {
+		return err
+	}
+	if !mutate(&conditions) {
+		return nil
+	}
+	data, err := json.Marshal(conditions)
+	if err != nil {
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[ConfigMapConditionsKey] = string(data)
+
+	if create {
+		err = b.client.Create(ctx, cm)
+	} else {
+		err = b.client.Update(ctx, cm)
+	}
+	recordWriteError(err)
+	return err
+}
+
+func decodeConfigMapConditions(cm *corev1.ConfigMap) ([]metav1.Condition, error) {
+	data, ok := cm.Data[ConfigMapConditionsKey]
+	if !ok || data == "" {
+		return nil, nil
+	}
+	var conditions []metav1.Condition
+	if err := json.Unmarshal([]byte(data), &conditions); err != nil {
+		return nil, fmt.Errorf("error decoding conditions of ConfigMap %s: %w", client.ObjectKeyFromObject(cm), err)
+	}
+	return conditions, nil
+}
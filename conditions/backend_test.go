@@ -0,0 +1,118 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Backends", func() {
+	ctx := context.TODO()
+	key := types.NamespacedName{Name: "conditions", Namespace: "default"}
+	var cl client.Client
+	var operatorCond *apiv2.OperatorCondition
+
+	BeforeEach(func() {
+		sch := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(sch)).To(Succeed())
+		Expect(apiv2.AddToScheme(sch)).To(Succeed())
+		operatorCond = &apiv2.OperatorCondition{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		cl = fake.NewClientBuilder().WithScheme(sch).WithObjects(operatorCond).WithStatusSubresource(operatorCond).Build()
+	})
+
+	// expectRoundTrip sets and gets a condition through a BackendFactory using backend.
+	expectRoundTrip := func(backend Backend) {
+		factory := BackendFactory{Backend: backend}
+		objKey, err := factory.GetNamespacedName()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*objKey).To(Equal(key))
+
+		c, err := factory.NewCondition(conditionFoo)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.Get(ctx)
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+
+		Expect(c.Set(ctx, metav1.ConditionTrue, WithReason("Ready"), WithMessage("ready"))).To(Succeed())
+		con, err := c.Get(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(con.Status).To(Equal(metav1.ConditionTrue))
+		Expect(con.Reason).To(Equal("Ready"))
+		Expect(con.Message).To(Equal("ready"))
+	}
+
+	It("should store conditions in the spec of an OperatorCondition", func() {
+		expectRoundTrip(NewOperatorConditionBackend(cl, key))
+
+		Expect(cl.Get(ctx, key, operatorCond)).To(Succeed())
+		Expect(meta.IsStatusConditionTrue(operatorCond.Spec.Conditions, string(conditionFoo))).To(BeTrue())
+		Expect(operatorCond.Status.Conditions).To(BeEmpty())
+	})
+
+	It("should store conditions in the status of an object", func() {
+		expectRoundTrip(NewStatusBackend(cl, operatorCond, func(o *apiv2.OperatorCondition) *[]metav1.Condition {
+			return &o.Status.Conditions
+		}))
+
+		Expect(cl.Get(ctx, key, operatorCond)).To(Succeed())
+		Expect(meta.IsStatusConditionTrue(operatorCond.Status.Conditions, string(conditionFoo))).To(BeTrue())
+		Expect(operatorCond.Spec.Conditions).To(BeEmpty())
+	})
+
+	It("should store conditions in a ConfigMap", func() {
+		expectRoundTrip(NewConfigMapBackend(cl, key))
+
+		cm := &corev1.ConfigMap{}
+		Expect(cl.Get(ctx, key, cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue(ConfigMapConditionsKey, ContainSubstring(`"reason":"Ready"`)))
+	})
+
+	It("should fail without a backend", func() {
+		_, err := BackendFactory{}.NewCondition(conditionFoo)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("NewFactory", func() {
+		It("should use the OperatorCondition when OLM is available", func() {
+			sch := runtime.NewScheme()
+			Expect(apiv2.AddToScheme(sch)).To(Succeed())
+			mapper := meta.NewDefaultRESTMapper(nil)
+			mapper.Add(operatorConditionGVK, meta.RESTScopeNamespace)
+			olmClient := fake.NewClientBuilder().WithScheme(sch).WithRESTMapper(mapper).Build()
+
+			factory, err := NewFactory(olmClient, NewConfigMapBackend(olmClient, key))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(factory).To(BeAssignableToTypeOf(InClusterFactory{}))
+		})
+
+		It("should use the fallback backend when OLM is not available", func() {
+			backend := NewConfigMapBackend(cl, key)
+			factory, err := NewFactory(cl, backend)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(factory).To(Equal(BackendFactory{Backend: backend}))
+		})
+	})
+})
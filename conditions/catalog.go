@@ -0,0 +1,234 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ErrUndeclaredReason is returned when a condition is set with a reason that is not declared in
+// a Catalog for its type and status.
+var ErrUndeclaredReason = errors.New("undeclared condition reason")
+
+// reasonPattern is the format of reasons enforced by the validation of metav1.Condition.
+var reasonPattern = regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$`)
+
+// Severity describes how serious the situation reported by a reason is.
+type Severity string
+
+const (
+	// SeverityInfo reports an expected situation.
+	SeverityInfo Severity = "Info"
+	// SeverityWarning reports a situation that may require attention.
+	SeverityWarning Severity = "Warning"
+	// SeverityError reports a situation that requires action.
+	SeverityError Severity = "Error"
+)
+
+// ReasonSpec declares a reason that a condition type may be set with.
+type ReasonSpec struct {
+	// Reason is the reason of the condition, in the CamelCase format of metav1.Condition
+	Reason string
+	// Status is the status the reason is used with, or empty for any status
+	Status metav1.ConditionStatus
+	// Message is a text/template rendered with the data passed when the condition is set, ex.
+	// "Certificate {{.Name}} expires in {{.Days}} days"
+	Message string
+	// Severity describes how serious the situation reported by the reason is
+	Severity Severity
+	// Description documents the reason for users, see Catalog.Markdown
+	Description string
+
+	message *template.Template
+}
+
+// Catalog declares the condition types an operator reports and the reasons each of them may be
+// set with, so that reasons do not drift across a large codebase, and so that the conditions
+// an operator may report can be documented, see Markdown. Conditions are set through the Catalog,
+// which rejects undeclared reasons and renders the message template of the reason:
+//
+//	var catalog = conditions.NewCatalog().MustDeclare("Ready",
+//		conditions.ReasonSpec{Reason: "Deployed", Status: metav1.ConditionTrue, Severity: conditions.SeverityInfo},
+//		conditions.ReasonSpec{Reason: "CertExpired", Status: metav1.ConditionFalse, Severity: conditions.SeverityError,
+//			Message: "Certificate {{.}} expired"},
+//	)
+//	...
+//	_, err := catalog.SetStatusCondition(&cr.Status.Conditions, "Ready", metav1.ConditionFalse, "CertExpired", secretName)
+//
+// A Catalog is safe for concurrent use.
+type Catalog struct {
+	mu      sync.RWMutex
+	reasons map[string][]ReasonSpec
+}
+
+// NewCatalog returns an empty Catalog.
+func NewCatalog() *Catalog {
+	return &Catalog{reasons: map[string][]ReasonSpec{}}
+}
+
+// Declare declares the reasons condType may be set with, in addition to the reasons already
+// declared for it. It returns an error if a reason is invalid, if its message template does not
+// parse, or if it is already declared for the same status.
+func (c *Catalog) Declare(condType string, reasons ...ReasonSpec) error {
+	if condType == "" {
+		return errors.New("error declaring condition reasons: condition type is empty")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	declared := c.reasons[condType]
+	for _, spec := range reasons {
+		if !reasonPattern.MatchString(spec.Reason) {
+			return fmt.Errorf("error declaring reason %q of condition %s: invalid reason", spec.Reason, condType)
+		}
+		for _, other := range declared {
+			if other.Reason == spec.Reason && (other.Status == "" || spec.Status == "" || other.Status == spec.Status) {
+				return fmt.Errorf("error declaring reason %q of condition %s: already declared", spec.Reason, condType)
+			}
+		}
+		if spec.Message != "" {
+			tmpl, err := template.New(spec.Reason).Option("missingkey=error").Parse(spec.Message)
+			if err != nil {
+				return fmt.Errorf("error declaring reason %q of condition %s: %w", spec.Reason, condType, err)
+			}
+			spec.message = tmpl
+		}
+		declared = append(declared, spec)
+	}
+	c.reasons[condType] = declared
+	return nil
+}
+
+// MustDeclare is like Declare, but panics on error. It returns the Catalog so that catalogs can
+// be declared as package variables.
+func (c *Catalog) MustDeclare(condType string, reasons ...ReasonSpec) *Catalog {
+	if err := c.Declare(condType, reasons...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Types returns the declared condition types, sorted.
+func (c *Catalog) Types() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	types := make([]string, 0, len(c.reasons))
+	for condType := range c.reasons {
+		types = append(types, condType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Reasons returns the reasons declared for condType, in the order they were declared.
+func (c *Catalog) Reasons(condType string) []ReasonSpec {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]ReasonSpec(nil), c.reasons[condType]...)
+}
+
+// Lookup returns the declaration of reason for condType in the given status.
+func (c *Catalog) Lookup(condType string, status metav1.ConditionStatus, reason string) (ReasonSpec, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, spec := range c.reasons[condType] {
+		if spec.Reason == reason && (spec.Status == "" || spec.Status == status) {
+			return spec, true
+		}
+	}
+	return ReasonSpec{}, false
+}
+
+// Options returns the Options setting reason, and the message rendered from its template with
+// data, on a condition of type condType in the given status. It returns an error wrapping
+// ErrUndeclaredReason if reason is not declared for condType and status.
+func (c *Catalog) Options(condType string, status metav1.ConditionStatus, reason string, data any) ([]Option, error) {
+	spec, ok := c.Lookup(condType, status, reason)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s for condition %s=%s", ErrUndeclaredReason, reason, condType, status)
+	}
+
+	message := ""
+	if spec.message != nil {
+		var sb strings.Builder
+		if err := spec.message.Execute(&sb, data); err != nil {
+			return nil, fmt.Errorf("error rendering message of reason %s of condition %s: %w", reason, condType, err)
+		}
+		message = sb.String()
+	}
+	return []Option{WithReason(reason), WithMessage(message)}, nil
+}
+
+// Set sets cond, of type condType, to status with a declared reason and its rendered message,
+// see Options.
+func (c *Catalog) Set(ctx context.Context, cond Condition, condType string, status metav1.ConditionStatus, reason string, data any) error {
+	opts, err := c.Options(condType, status, reason, data)
+	if err != nil {
+		return err
+	}
+	return cond.Set(ctx, status, opts...)
+}
+
+// SetStatusCondition sets the condition of type condType in conditions, ex. the conditions of the
+// status of a custom resource, to status with a declared reason and its rendered message, see
+// Options and meta.SetStatusCondition. It returns true if conditions changed.
+func (c *Catalog) SetStatusCondition(conditions *[]metav1.Condition, condType string, status metav1.ConditionStatus, reason string, data any) (bool, error) {
+	opts, err := c.Options(condType, status, reason, data)
+	if err != nil {
+		return false, err
+	}
+	newCond := metav1.Condition{Type: condType, Status: status}
+	for _, opt := range opts {
+		opt(&newCond)
+	}
+	return meta.SetStatusCondition(conditions, newCond), nil
+}
+
+// Markdown documents the declared condition types and their reasons as Markdown tables, one per
+// type, for the documentation of the conditions an operator may report.
+func (c *Catalog) Markdown() string {
+	var sb strings.Builder
+	for i, condType := range c.Types() {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "## %s\n\n", condType)
+		sb.WriteString("| Reason | Status | Severity | Message | Description |\n")
+		sb.WriteString("|--------|--------|----------|---------|-------------|\n")
+		for _, spec := range c.Reasons(condType) {
+			status := string(spec.Status)
+			if status == "" {
+				status = "Any"
+			}
+			fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s |\n", spec.Reason, status, spec.Severity,
+				markdownCell(spec.Message), markdownCell(spec.Description))
+		}
+	}
+	return sb.String()
+}
+
+func markdownCell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// recordingCondition records the last condition set on it.
+type recordingCondition struct {
+	cond *metav1.Condition
+}
+
+func (c *recordingCondition) Get(context.Context) (*metav1.Condition, error) {
+	return c.cond, nil
+}
+
+func (c *recordingCondition) Set(_ context.Context, status metav1.ConditionStatus, opts ...Option) error {
+	c.cond = &metav1.Condition{Status: status}
+	for _, opt := range opts {
+		opt(c.cond)
+	}
+	return nil
+}
+
+var _ = Describe("Catalog", func() {
+	var catalog *Catalog
+
+	BeforeEach(func() {
+		catalog = NewCatalog().
+			MustDeclare("Ready",
+				ReasonSpec{Reason: "Deployed", Status: metav1.ConditionTrue, Severity: SeverityInfo, Description: "All operands are ready"},
+				ReasonSpec{Reason: "CertExpired", Status: metav1.ConditionFalse, Severity: SeverityError,
+					Message: "Certificate {{.Name}} expired", Description: "Renew the certificate | rotate"},
+			).
+			MustDeclare("Degraded", ReasonSpec{Reason: "AsExpected", Severity: SeverityInfo})
+	})
+
+	It("should reject invalid declarations", func() {
+		Expect(catalog.Declare("", ReasonSpec{Reason: "Valid"})).NotTo(Succeed())
+		Expect(catalog.Declare("Ready", ReasonSpec{Reason: "not valid"})).To(MatchError(ContainSubstring("invalid reason")))
+		Expect(catalog.Declare("Ready", ReasonSpec{Reason: "Deployed", Status: metav1.ConditionTrue})).To(MatchError(ContainSubstring("already declared")))
+		Expect(catalog.Declare("Degraded", ReasonSpec{Reason: "AsExpected", Status: metav1.ConditionFalse})).To(MatchError(ContainSubstring("already declared")))
+		Expect(catalog.Declare("Ready", ReasonSpec{Reason: "BadTemplate", Message: "{{.Name"})).NotTo(Succeed())
+		Expect(func() { catalog.MustDeclare("Ready", ReasonSpec{Reason: ""}) }).To(Panic())
+
+		Expect(catalog.Declare("Ready", ReasonSpec{Reason: "Deployed", Status: metav1.ConditionFalse})).To(Succeed())
+	})
+
+	It("should list declared types and reasons", func() {
+		Expect(catalog.Types()).To(Equal([]string{"Degraded", "Ready"}))
+		reasons := catalog.Reasons("Ready")
+		Expect(reasons).To(HaveLen(2))
+		Expect(reasons[0].Reason).To(Equal("Deployed"))
+		Expect(catalog.Reasons("Unknown")).To(BeEmpty())
+	})
+
+	It("should only set declared reasons", func() {
+		var conditions []metav1.Condition
+		changed, err := catalog.SetStatusCondition(&conditions, "Ready", metav1.ConditionFalse, "CertExpired", map[string]string{"Name": "tls"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		cond := meta.FindStatusCondition(conditions, "Ready")
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal("CertExpired"))
+		Expect(cond.Message).To(Equal("Certificate tls expired"))
+
+		_, err = catalog.SetStatusCondition(&conditions, "Ready", metav1.ConditionTrue, "CertExpired", nil)
+		Expect(err).To(MatchError(ErrUndeclaredReason))
+		_, err = catalog.SetStatusCondition(&conditions, "Ready", metav1.ConditionTrue, "Typo", nil)
+		Expect(err).To(MatchError(ErrUndeclaredReason))
+		_, err = catalog.SetStatusCondition(&conditions, "Ready", metav1.ConditionFalse, "CertExpired", map[string]string{})
+		Expect(err).To(MatchError(ContainSubstring("error rendering message")))
+
+		changed, err = catalog.SetStatusCondition(&conditions, "Degraded", metav1.ConditionUnknown, "AsExpected", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(conditions).To(HaveLen(2))
+	})
+
+	It("should set Conditions", func() {
+		cond := &recordingCondition{}
+		Expect(catalog.Set(context.Background(), cond, "Ready", metav1.ConditionTrue, "Deployed", nil)).To(Succeed())
+		Expect(cond.cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.cond.Reason).To(Equal("Deployed"))
+		Expect(cond.cond.Message).To(BeEmpty())
+
+		Expect(catalog.Set(context.Background(), cond, "Ready", metav1.ConditionTrue, "Typo", nil)).To(MatchError(ErrUndeclaredReason))
+		Expect(cond.cond.Reason).To(Equal("Deployed"))
+	})
+
+	It("should document the catalog", func() {
+		Expect(catalog.Markdown()).To(Equal(`## Degraded
+
+| Reason | Status | Severity | Message | Description |
+|--------|--------|----------|---------|-------------|
+| AsExpected | Any | Info |  |  |
+
+## Ready
+
+| Reason | Status | Severity | Message | Description |
+|--------|--------|----------|---------|-------------|
+| Deployed | True | Info |  | All operands are ready |
+| CertExpired | False | Error | Certificate {{.Name}} expired | Renew the certificate \| rotate |
+`))
+	})
+})
@@ -0,0 +1,223 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition types of the OpenShift ClusterOperator status conventions.
+const (
+	ClusterOperatorAvailable   = "Available"
+	ClusterOperatorProgressing = "Progressing"
+	ClusterOperatorDegraded    = "Degraded"
+
+	// ReadyType is the condition type derived from ClusterOperator conditions by
+	// FromClusterOperatorConditions.
+	ReadyType = "Ready"
+)
+
+const (
+	// AsExpectedReason is the reason of aggregated conditions that are in their
+	// expected state, following the ClusterOperator conventions.
+	AsExpectedReason = "AsExpected"
+	// MultipleReasonsReason is the reason of aggregated conditions whose status is
+	// caused by several source conditions.
+	MultipleReasonsReason = "MultipleReasons"
+	// NoDataReason is the reason of aggregated conditions whose source conditions
+	// are missing or unknown.
+	NoDataReason = "NoData"
+)
+
+// ConditionSource identifies a condition contributing to an aggregated condition.
+// If Inverted is set, the status of the source is negated before aggregation, so
+// that e.g. a False Ready condition contributes a True Degraded condition.
+type ConditionSource struct {
+	Type     string
+	Inverted bool
+}
+
+// ClusterOperatorMapping describes how to derive the Available, Progressing and
+// Degraded conditions from an operator's own conditions.
+// Available is True if all of its sources are True. Progressing and Degraded are
+// True if any of their sources is True.
+type ClusterOperatorMapping struct {
+	Available   []ConditionSource
+	Progressing []ConditionSource
+	Degraded    []ConditionSource
+}
+
+// DefaultClusterOperatorMapping derives Available from Ready, Degraded from the
+// inverse of Ready, and Progressing from a Progressing condition.
+var DefaultClusterOperatorMapping = ClusterOperatorMapping{
+	Available:   []ConditionSource{{Type: ReadyType}},
+	Progressing: []ConditionSource{{Type: ClusterOperatorProgressing}},
+	Degraded:    []ConditionSource{{Type: ReadyType, Inverted: true}},
+}
+
+// ToClusterOperatorConditions returns the Available, Progressing and Degraded conditions
+// derived from the given conditions using the mapping. The reasons and messages of the
+// source conditions causing an aggregated condition to deviate from its expected state
+// are aggregated into it. LastTransitionTime is not set, use SetClusterOperatorConditions
+// to maintain it on an existing list of conditions.
+func ToClusterOperatorConditions(conditions []metav1.Condition, mapping ClusterOperatorMapping) []metav1.Condition {
+	return []metav1.Condition{
+		allOf(ClusterOperatorAvailable, conditions, mapping.Available),
+		anyOf(ClusterOperatorProgressing, conditions, mapping.Progressing),
+		anyOf(ClusterOperatorDegraded, conditions, mapping.Degraded),
+	}
+}
+
+// SetClusterOperatorConditions sets the conditions returned by ToClusterOperatorConditions
+// in dst, updating their LastTransitionTime only when their status changes.
+func SetClusterOperatorConditions(dst *[]metav1.Condition, conditions []metav1.Condition, mapping ClusterOperatorMapping) {
+	for _, c := range ToClusterOperatorConditions(conditions, mapping) {
+		meta.SetStatusCondition(dst, c)
+	}
+}
+
+// FromClusterOperatorConditions returns a Ready condition derived from ClusterOperator
+// style conditions: Ready is True if Available is True and Degraded is False. The
+// Progressing condition is returned as is, if present.
+func FromClusterOperatorConditions(conditions []metav1.Condition) []metav1.Condition {
+	out := []metav1.Condition{
+		allOf(ReadyType, conditions, []ConditionSource{
+			{Type: ClusterOperatorAvailable},
+			{Type: ClusterOperatorDegraded, Inverted: true},
+		}),
+	}
+	if progressing := meta.FindStatusCondition(conditions, ClusterOperatorProgressing); progressing != nil {
+		p := *progressing
+		p.LastTransitionTime = metav1.Time{}
+		out = append(out, p)
+	}
+	return out
+}
+
+// sourceStatus is a source condition with its status adjusted for inversion.
+type sourceStatus struct {
+	source ConditionSource
+	status metav1.ConditionStatus
+	cond   *metav1.Condition
+}
+
+func resolveSources(conditions []metav1.Condition, sources []ConditionSource) []sourceStatus {
+	resolved := make([]sourceStatus, 0, len(sources))
+	for _, source := range sources {
+		s := sourceStatus{source: source, status: metav1.ConditionUnknown}
+		if c := meta.FindStatusCondition(conditions, source.Type); c != nil {
+			s.cond = c
+			s.status = c.Status
+			if source.Inverted {
+				s.status = invert(c.Status)
+			}
+		}
+		resolved = append(resolved, s)
+	}
+	return resolved
+}
+
+// allOf returns a condition that is True if all sources are True, False if any is False and
+// Unknown otherwise.
+func allOf(condType string, conditions []metav1.Condition, sources []ConditionSource) metav1.Condition {
+	return aggregate(condType, resolveSources(conditions, sources), metav1.ConditionFalse, metav1.ConditionTrue)
+}
+
+// anyOf returns a condition that is True if any source is True, False if all are False and
+// Unknown otherwise.
+func anyOf(condType string, conditions []metav1.Condition, sources []ConditionSource) metav1.Condition {
+	return aggregate(condType, resolveSources(conditions, sources), metav1.ConditionTrue, metav1.ConditionFalse)
+}
+
+// aggregate returns a condition whose status is dominant if any source has that status,
+// fallback if all sources have that status, and Unknown otherwise.
+func aggregate(condType string, sources []sourceStatus, dominant, fallback metav1.ConditionStatus) metav1.Condition {
+	out := metav1.Condition{Type: condType}
+	var contributing, unknown []sourceStatus
+	for _, s := range sources {
+		if s.cond != nil && s.cond.ObservedGeneration > out.ObservedGeneration {
+			out.ObservedGeneration = s.cond.ObservedGeneration
+		}
+		switch s.status {
+		case dominant:
+			contributing = append(contributing, s)
+		case fallback:
+		default:
+			unknown = append(unknown, s)
+		}
+	}
+
+	switch {
+	case len(contributing) > 0:
+		out.Status = dominant
+		out.Reason, out.Message = summarize(contributing)
+	case len(unknown) > 0:
+		out.Status = metav1.ConditionUnknown
+		out.Reason, out.Message = summarize(unknown)
+		if out.Reason == "" {
+			out.Reason = NoDataReason
+		}
+	default:
+		out.Status = fallback
+		out.Reason = AsExpectedReason
+	}
+	return out
+}
+
+// summarize returns the reason and the aggregated message of the given sources.
+func summarize(sources []sourceStatus) (string, string) {
+	reasons := map[string]struct{}{}
+	messages := make([]string, 0, len(sources))
+	for _, s := range sources {
+		if s.cond == nil {
+			messages = append(messages, s.source.Type+": condition not found")
+			continue
+		}
+		if s.cond.Reason != "" {
+			reasons[s.cond.Reason] = struct{}{}
+		}
+		if s.cond.Message != "" {
+			messages = append(messages, s.source.Type+": "+s.cond.Message)
+		}
+	}
+	sort.Strings(messages)
+
+	var reason string
+	switch len(reasons) {
+	case 0:
+	case 1:
+		for r := range reasons {
+			reason = r
+		}
+	default:
+		reason = MultipleReasonsReason
+	}
+	return reason, strings.Join(messages, "\n")
+}
+
+func invert(status metav1.ConditionStatus) metav1.ConditionStatus {
+	switch status {
+	case metav1.ConditionTrue:
+		return metav1.ConditionFalse
+	case metav1.ConditionFalse:
+		return metav1.ConditionTrue
+	default:
+		return status
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ClusterOperator conditions", func() {
+	Describe("ToClusterOperatorConditions", func() {
+		It("should report Available when Ready is True", func() {
+			conds := ToClusterOperatorConditions([]metav1.Condition{
+				{Type: ReadyType, Status: metav1.ConditionTrue, Reason: "Reconciled", ObservedGeneration: 3},
+				{Type: ClusterOperatorProgressing, Status: metav1.ConditionFalse, Reason: "Done"},
+			}, DefaultClusterOperatorMapping)
+
+			Expect(conds).To(HaveLen(3))
+			available := meta.FindStatusCondition(conds, ClusterOperatorAvailable)
+			Expect(available.Status).To(Equal(metav1.ConditionTrue))
+			Expect(available.Reason).To(Equal(AsExpectedReason))
+			Expect(available.ObservedGeneration).To(Equal(int64(3)))
+			Expect(meta.IsStatusConditionFalse(conds, ClusterOperatorProgressing)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(conds, ClusterOperatorDegraded)).To(BeTrue())
+		})
+
+		It("should invert Ready into Degraded and carry its reason and message", func() {
+			conds := ToClusterOperatorConditions([]metav1.Condition{
+				{Type: ReadyType, Status: metav1.ConditionFalse, Reason: "DeploymentFailed", Message: "deployment foo failed"},
+			}, DefaultClusterOperatorMapping)
+
+			degraded := meta.FindStatusCondition(conds, ClusterOperatorDegraded)
+			Expect(degraded.Status).To(Equal(metav1.ConditionTrue))
+			Expect(degraded.Reason).To(Equal("DeploymentFailed"))
+			Expect(degraded.Message).To(Equal("Ready: deployment foo failed"))
+
+			available := meta.FindStatusCondition(conds, ClusterOperatorAvailable)
+			Expect(available.Status).To(Equal(metav1.ConditionFalse))
+			Expect(available.Reason).To(Equal("DeploymentFailed"))
+
+			progressing := meta.FindStatusCondition(conds, ClusterOperatorProgressing)
+			Expect(progressing.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(progressing.Reason).To(Equal(NoDataReason))
+			Expect(progressing.Message).To(Equal("Progressing: condition not found"))
+		})
+
+		It("should aggregate the messages of several sources", func() {
+			mapping := ClusterOperatorMapping{
+				Degraded: []ConditionSource{
+					{Type: "DatabaseReady", Inverted: true},
+					{Type: "CacheReady", Inverted: true},
+					{Type: "Healthy", Inverted: true},
+				},
+			}
+			conds := ToClusterOperatorConditions([]metav1.Condition{
+				{Type: "DatabaseReady", Status: metav1.ConditionFalse, Reason: "Unreachable", Message: "db down"},
+				{Type: "CacheReady", Status: metav1.ConditionFalse, Reason: "Evicted", Message: "cache evicted"},
+				{Type: "Healthy", Status: metav1.ConditionTrue, Reason: "Probed"},
+			}, mapping)
+
+			degraded := meta.FindStatusCondition(conds, ClusterOperatorDegraded)
+			Expect(degraded.Status).To(Equal(metav1.ConditionTrue))
+			Expect(degraded.Reason).To(Equal(MultipleReasonsReason))
+			Expect(degraded.Message).To(Equal("CacheReady: cache evicted\nDatabaseReady: db down"))
+		})
+	})
+
+	Describe("SetClusterOperatorConditions", func() {
+		It("should only update the transition time when the status changes", func() {
+			transitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+			dst := []metav1.Condition{
+				{Type: ClusterOperatorAvailable, Status: metav1.ConditionTrue, Reason: AsExpectedReason, LastTransitionTime: transitionTime},
+				{Type: ClusterOperatorDegraded, Status: metav1.ConditionFalse, Reason: AsExpectedReason, LastTransitionTime: transitionTime},
+			}
+
+			SetClusterOperatorConditions(&dst, []metav1.Condition{
+				{Type: ReadyType, Status: metav1.ConditionTrue},
+				{Type: ClusterOperatorProgressing, Status: metav1.ConditionTrue, Reason: "Upgrading"},
+			}, DefaultClusterOperatorMapping)
+
+			Expect(dst).To(HaveLen(3))
+			Expect(meta.FindStatusCondition(dst, ClusterOperatorAvailable).LastTransitionTime).To(Equal(transitionTime))
+			Expect(meta.FindStatusCondition(dst, ClusterOperatorDegraded).LastTransitionTime).To(Equal(transitionTime))
+			progressing := meta.FindStatusCondition(dst, ClusterOperatorProgressing)
+			Expect(progressing.Status).To(Equal(metav1.ConditionTrue))
+			Expect(progressing.LastTransitionTime.IsZero()).To(BeFalse())
+		})
+	})
+
+	Describe("FromClusterOperatorConditions", func() {
+		It("should report Ready when Available and not Degraded", func() {
+			conds := FromClusterOperatorConditions([]metav1.Condition{
+				{Type: ClusterOperatorAvailable, Status: metav1.ConditionTrue, Reason: AsExpectedReason},
+				{Type: ClusterOperatorDegraded, Status: metav1.ConditionFalse, Reason: AsExpectedReason},
+				{Type: ClusterOperatorProgressing, Status: metav1.ConditionTrue, Reason: "Upgrading"},
+			})
+
+			Expect(conds).To(HaveLen(2))
+			Expect(meta.IsStatusConditionTrue(conds, ReadyType)).To(BeTrue())
+			Expect(meta.FindStatusCondition(conds, ClusterOperatorProgressing).Reason).To(Equal("Upgrading"))
+		})
+
+		It("should report not Ready when Degraded", func() {
+			conds := FromClusterOperatorConditions([]metav1.Condition{
+				{Type: ClusterOperatorAvailable, Status: metav1.ConditionTrue, Reason: AsExpectedReason},
+				{Type: ClusterOperatorDegraded, Status: metav1.ConditionTrue, Reason: "SyncFailed", Message: "sync failed"},
+			})
+
+			Expect(conds).To(HaveLen(1))
+			ready := meta.FindStatusCondition(conds, ReadyType)
+			Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+			Expect(ready.Reason).To(Equal("SyncFailed"))
+			Expect(ready.Message).To(Equal("Degraded: sync failed"))
+		})
+	})
+})
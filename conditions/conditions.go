@@ -19,10 +19,13 @@ import (
 	"fmt"
 
 	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-lib/internal/metrics"
 )
 
 var (
@@ -45,7 +48,7 @@ func (c *condition) Get(ctx context.Context) (*metav1.Condition, error) {
 	operatorCond := &apiv2.OperatorCondition{}
 	err := c.client.Get(ctx, c.namespacedName, operatorCond)
 	if err != nil {
-		return nil, err
+		return nil, wrapOLMError(err)
 	}
 	con := meta.FindStatusCondition(operatorCond.Spec.Conditions, string(c.condType))
 
@@ -60,7 +63,7 @@ func (c *condition) Set(ctx context.Context, status metav1.ConditionStatus, opti
 	operatorCond := &apiv2.OperatorCondition{}
 	err := c.client.Get(ctx, c.namespacedName, operatorCond)
 	if err != nil {
-		return err
+		return wrapOLMError(err)
 	}
 
 	newCond := &metav1.Condition{
@@ -72,5 +75,18 @@ func (c *condition) Set(ctx context.Context, status metav1.ConditionStatus, opti
 		opt(newCond)
 	}
 	meta.SetStatusCondition(&operatorCond.Spec.Conditions, *newCond)
-	return c.client.Update(ctx, operatorCond)
+	err = c.client.Update(ctx, operatorCond)
+	recordWriteError(err)
+	return wrapOLMError(err)
+}
+
+// recordWriteError records a failed write of an OperatorCondition in the library's error metric.
+func recordWriteError(err error) {
+	switch {
+	case err == nil:
+	case apierrors.IsConflict(err):
+		metrics.RecordError(metrics.SubsystemConditions, "write_conflict")
+	default:
+		metrics.RecordError(metrics.SubsystemConditions, "write_failed")
+	}
 }
@@ -22,6 +22,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,6 +31,9 @@ import (
 	kubeclock "k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/operator-framework/operator-lib/internal/metrics"
 )
 
 var _ = Describe("Condition", func() {
@@ -163,6 +167,21 @@ var _ = Describe("Condition", func() {
 				Expect(err).To(HaveOccurred())
 				Expect(apierrors.IsNotFound(err)).To(BeTrue())
 			})
+			It("should record write conflicts in the errors metric", func() {
+				conflicts := metrics.Errors.WithLabelValues(metrics.SubsystemConditions, "write_conflict")
+				before := testutil.ToFloat64(conflicts)
+				conflictClient := interceptor.NewClient(cl.(client.WithWatch), interceptor.Funcs{
+					Update: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.UpdateOption) error {
+						return apierrors.NewConflict(apiv2.GroupVersion.WithResource("operatorconditions").GroupResource(), obj.GetName(), fmt.Errorf("TEST"))
+					},
+				})
+
+				c, err := NewCondition(conflictClient, conditionFoo)
+				Expect(err).NotTo(HaveOccurred())
+				err = c.Set(ctx, metav1.ConditionFalse)
+				Expect(apierrors.IsConflict(err)).To(BeTrue())
+				Expect(testutil.ToFloat64(conflicts)).To(Equal(before + 1))
+			})
 		})
 	})
 })
@@ -0,0 +1,238 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DevOverrideEnvVar is the environment variable that, when set to a YAML or JSON list of
+	// conditions, makes the package read and write that list in memory instead of the
+	// OperatorCondition: the Conditions created by InClusterFactory and BackendFactory, the
+	// EffectiveStatus, ObservedConditions and IfUpgradeAllowed of InClusterFactory, and Mirrors
+	// all use it. The conditions are both the operator's and the ones observed by OLM, there are
+	// no overrides. It is meant for local development only, to exercise the code paths depending
+	// on conditions without OLM, e.g.
+	//
+	//	OPERATOR_CONDITIONS_OVERRIDE='[{"type": "Upgradeable", "status": "False"}]' make run
+	DevOverrideEnvVar = "OPERATOR_CONDITIONS_OVERRIDE"
+	// DevOverrideFileEnvVar is the environment variable that, when set to the path of a file
+	// holding a YAML or JSON list of conditions, makes the package read and write that file
+	// instead of the OperatorCondition, see DevOverrideEnvVar. The file is read on every Get,
+	// so that it can be edited while the operator runs, and is created by Set if needed. It takes
+	// precedence over DevOverrideEnvVar.
+	DevOverrideFileEnvVar = "OPERATOR_CONDITIONS_OVERRIDE_FILE"
+)
+
+var devLog = logf.Log.WithName("conditions").WithName("dev-override")
+
+var (
+	devStoresMu sync.Mutex
+	// devStores holds the conditions of DevOverrideEnvVar in memory, by value of the variable.
+	devStores = map[string]*[]metav1.Condition{}
+	// devWarned holds the overrides that were already logged, by variable and value.
+	devWarned = map[string]bool{}
+)
+
+// devOverrideStore returns the local conditions of the developer override, or nil if no override
+// is configured. The override is logged once per value, as a warning since it makes the operator
+// ignore its OperatorCondition.
+func devOverrideStore() (*localStore, error) {
+	devStoresMu.Lock()
+	defer devStoresMu.Unlock()
+
+	if path := os.Getenv(DevOverrideFileEnvVar); path != "" {
+		warnDevOverride(DevOverrideFileEnvVar, path, "path", path)
+		return &localStore{path: path}, nil
+	}
+	value := os.Getenv(DevOverrideEnvVar)
+	if value == "" {
+		return nil, nil
+	}
+
+	conds, ok := devStores[value]
+	if !ok {
+		parsed, err := parseConditions([]byte(value))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", DevOverrideEnvVar, err)
+		}
+		conds = &parsed
+		devStores[value] = conds
+	}
+	warnDevOverride(DevOverrideEnvVar, value, "env", DevOverrideEnvVar)
+	return &localStore{conds: conds}, nil
+}
+
+// warnDevOverride logs that the override of envVar set to value is used, unless it was already
+// logged. devStoresMu must be held.
+func warnDevOverride(envVar, value string, keysAndValues ...interface{}) {
+	key := envVar + "=" + value
+	if devWarned[key] {
+		return
+	}
+	devWarned[key] = true
+	devLog.Info("WARNING: using local conditions instead of the OperatorCondition, this is meant for local development only", keysAndValues...)
+}
+
+// devOverride returns the Condition of type condType backed by the developer override, or nil
+// if no override is configured.
+func devOverride(condType apiv2.ConditionType) (Condition, error) {
+	store, err := devOverrideStore()
+	if store == nil || err != nil {
+		return nil, err
+	}
+	return &localCondition{condType: condType, store: store}, nil
+}
+
+// devOverrideOperatorCondition returns an OperatorCondition holding the conditions of the
+// developer override both in its spec and its status, or nil if no override is configured.
+func devOverrideOperatorCondition() (*apiv2.OperatorCondition, error) {
+	store, err := devOverrideStore()
+	if store == nil || err != nil {
+		return nil, err
+	}
+	conds, err := store.get()
+	if err != nil {
+		return nil, err
+	}
+	operatorCond := &apiv2.OperatorCondition{}
+	operatorCond.Spec.Conditions = conds
+	operatorCond.Status.Conditions = append([]metav1.Condition(nil), conds...)
+	return operatorCond, nil
+}
+
+// localStore is a local list of conditions, held in a file if path is set, or in memory
+// otherwise.
+type localStore struct {
+	path  string
+	conds *[]metav1.Condition
+}
+
+// get returns the conditions of the store.
+func (s *localStore) get() ([]metav1.Condition, error) {
+	devStoresMu.Lock()
+	defer devStoresMu.Unlock()
+	conds, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	return append([]metav1.Condition(nil), conds...), nil
+}
+
+// update calls mutate with the conditions of the store, and stores them if mutate returns true.
+func (s *localStore) update(mutate func(*[]metav1.Condition) bool) error {
+	devStoresMu.Lock()
+	defer devStoresMu.Unlock()
+	conds, err := s.load()
+	if err != nil {
+		return err
+	}
+	if !mutate(&conds) {
+		return nil
+	}
+	return s.store(conds)
+}
+
+func (s *localStore) load() ([]metav1.Condition, error) {
+	if s.path == "" {
+		return *s.conds, nil
+	}
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", s.path, err)
+	}
+	conds, err := parseConditions(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", s.path, err)
+	}
+	return conds, nil
+}
+
+func (s *localStore) store(conds []metav1.Condition) error {
+	if s.path == "" {
+		*s.conds = conds
+		return nil
+	}
+	data, err := yaml.Marshal(conds)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("error writing %s: %w", s.path, err)
+	}
+	return nil
+}
+
+// localCondition is a Condition that gets and sets a conditionType in a localStore.
+type localCondition struct {
+	condType apiv2.ConditionType
+	store    *localStore
+}
+
+var _ Condition = &localCondition{}
+
+// Get implements conditions.Get
+func (c *localCondition) Get(_ context.Context) (*metav1.Condition, error) {
+	conds, err := c.store.get()
+	if err != nil {
+		return nil, err
+	}
+	con := meta.FindStatusCondition(conds, string(c.condType))
+	if con == nil {
+		return nil, fmt.Errorf("conditionType %v not found", c.condType)
+	}
+	return con, nil
+}
+
+// Set implements conditions.Set
+func (c *localCondition) Set(_ context.Context, status metav1.ConditionStatus, option ...Option) error {
+	newCond := &metav1.Condition{
+		Type:   string(c.condType),
+		Status: status,
+	}
+	for _, opt := range option {
+		opt(newCond)
+	}
+	err := c.store.update(func(conds *[]metav1.Condition) bool {
+		meta.SetStatusCondition(conds, *newCond)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	devLog.Info("Set overridden condition locally", "type", c.condType, "status", status, "reason", newCond.Reason)
+	return nil
+}
+
+func parseConditions(data []byte) ([]metav1.Condition, error) {
+	var conds []metav1.Condition
+	if err := yaml.Unmarshal(data, &conds); err != nil {
+		return nil, err
+	}
+	return conds, nil
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Developer override", func() {
+	ctx := context.TODO()
+	upgradeable := apiv2.ConditionType(apiv2.Upgradeable)
+
+	var f InClusterFactory
+
+	BeforeEach(func() {
+		// the OperatorCondition does not exist, so that any access to it fails
+		f = InClusterFactory{Client: fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()}
+		GinkgoT().Setenv(operatorCondEnvVar, "")
+	})
+
+	Context("with the environment variable", func() {
+		BeforeEach(func() {
+			GinkgoT().Setenv(DevOverrideEnvVar, `[{"type": "Upgradeable", "status": "False", "reason": "Dev"}]`)
+			devStoresMu.Lock()
+			devStores = map[string]*[]metav1.Condition{}
+			devStoresMu.Unlock()
+		})
+
+		It("should get the conditions of the override", func() {
+			c, err := f.NewCondition(upgradeable)
+			Expect(err).NotTo(HaveOccurred())
+			cond, err := c.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("Dev"))
+		})
+
+		It("should set conditions in memory", func() {
+			c, err := f.NewCondition(upgradeable)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Set(ctx, metav1.ConditionTrue, WithReason("Ready"))).To(Succeed())
+
+			other, err := f.NewCondition(upgradeable)
+			Expect(err).NotTo(HaveOccurred())
+			cond, err := other.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal("Ready"))
+		})
+
+		It("should return an error for missing conditions", func() {
+			c, err := f.NewCondition("Missing")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = c.Get(ctx)
+			Expect(err).To(MatchError(ContainSubstring("conditionType Missing not found")))
+		})
+
+		It("should be used to check whether upgrades are allowed", func() {
+			GinkgoT().Setenv(DevOverrideEnvVar, `[{"type": "Upgradeable", "status": "True", "reason": "Dev"}]`)
+			e, err := f.EffectiveStatus(ctx, upgradeable)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(e.Origin).To(Equal(OriginOperator))
+			Expect(e.Reason).To(Equal("Dev"))
+			observed, err := f.ObservedConditions(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(observed).To(HaveLen(1))
+
+			ran := false
+			Expect(f.IfUpgradeAllowed(ctx, func(context.Context) error {
+				ran = true
+				return nil
+			})).To(Succeed())
+			Expect(ran).To(BeTrue())
+		})
+
+		It("should be used by conditions built by a BackendFactory", func() {
+			c, err := BackendFactory{}.NewCondition(upgradeable)
+			Expect(err).NotTo(HaveOccurred())
+			cond, err := c.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cond.Reason).To(Equal("Dev"))
+		})
+
+		It("should be written by Mirrors", func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			f = InClusterFactory{Client: fake.NewClientBuilder().WithScheme(scheme).Build()}
+			m, err := f.NewMirror([]MirrorRule{{
+				GVK:        corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+				SourceType: "Ready",
+				TargetType: "OperandsReady",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.Sync(ctx)).To(Succeed())
+
+			c, err := f.NewCondition("OperandsReady")
+			Expect(err).NotTo(HaveOccurred())
+			cond, err := c.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should return an error for a malformed override", func() {
+			GinkgoT().Setenv(DevOverrideEnvVar, `{"type": `)
+			_, err := f.NewCondition(upgradeable)
+			Expect(err).To(MatchError(ContainSubstring(DevOverrideEnvVar)))
+		})
+	})
+
+	Context("with the file", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "conditions.yaml")
+			GinkgoT().Setenv(DevOverrideFileEnvVar, path)
+		})
+
+		It("should read the file on every Get", func() {
+			c, err := f.NewCondition(upgradeable)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = c.Get(ctx)
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+
+			Expect(os.WriteFile(path, []byte("- type: Upgradeable\n  status: \"False\"\n"), 0o600)).To(Succeed())
+			cond, err := c.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+
+			Expect(os.WriteFile(path, []byte("- type: Upgradeable\n  status: \"True\"\n"), 0o600)).To(Succeed())
+			cond, err = c.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		})
+
+		It("should write conditions to the file", func() {
+			c, err := f.NewCondition(upgradeable)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Set(ctx, metav1.ConditionFalse, WithReason("Migrating"), WithMessage("migrating data"))).To(Succeed())
+
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring("Migrating"))
+
+			cond, err := c.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Message).To(Equal("migrating data"))
+		})
+	})
+
+	It("should use the OperatorCondition without an override", func() {
+		GinkgoT().Setenv(operatorCondEnvVar, "operator-condition")
+		readNamespace = func() (string, error) { return "default", nil }
+		c, err := f.NewCondition(upgradeable)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c).To(BeAssignableToTypeOf(&condition{}))
+	})
+})
@@ -0,0 +1,123 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"fmt"
+
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Origin identifies where the effective state of a condition of an OperatorCondition comes from.
+type Origin string
+
+const (
+	// OriginOverride is used for conditions set by a cluster administrator in spec.overrides.
+	// Overrides take precedence over the conditions written by the operator.
+	OriginOverride Origin = "Override"
+	// OriginOperator is used for conditions written by the operator in spec.conditions.
+	OriginOperator Origin = "Operator"
+	// OriginOLM is used for conditions only found in status.conditions, which are written by OLM.
+	OriginOLM Origin = "OLM"
+)
+
+// EffectiveCondition is the state of a condition enforced by OLM, along with the conditions of
+// the same type found in the different parts of the OperatorCondition.
+type EffectiveCondition struct {
+	// Condition is the effective condition.
+	metav1.Condition
+	// Origin is the part of the OperatorCondition the effective condition was taken from.
+	Origin Origin
+
+	// Override is the condition found in spec.overrides, if any.
+	Override *metav1.Condition
+	// Operator is the condition found in spec.conditions, if any.
+	Operator *metav1.Condition
+	// Observed is the condition found in status.conditions, if any.
+	Observed *metav1.Condition
+}
+
+// Overridden returns true if the condition written by the operator is not enforced because
+// an override with a different status is set.
+func (e *EffectiveCondition) Overridden() bool {
+	return e.Override != nil && (e.Operator == nil || e.Operator.Status != e.Override.Status)
+}
+
+// Effective returns the effective condition of the given type of operatorCond, or nil if no such
+// condition exists. Following the precedence applied by OLM, a condition in spec.overrides wins
+// over the condition written by the operator in spec.conditions, which wins over the condition
+// in status.conditions.
+func Effective(operatorCond *apiv2.OperatorCondition, condType string) *EffectiveCondition {
+	e := &EffectiveCondition{
+		Override: meta.FindStatusCondition(operatorCond.Spec.Overrides, condType),
+		Operator: meta.FindStatusCondition(operatorCond.Spec.Conditions, condType),
+		Observed: meta.FindStatusCondition(operatorCond.Status.Conditions, condType),
+	}
+	switch {
+	case e.Override != nil:
+		e.Condition, e.Origin = *e.Override, OriginOverride
+	case e.Operator != nil:
+		e.Condition, e.Origin = *e.Operator, OriginOperator
+	case e.Observed != nil:
+		e.Condition, e.Origin = *e.Observed, OriginOLM
+	default:
+		return nil
+	}
+	return e
+}
+
+// ObservedConditions returns the conditions written by OLM in the status of the operator's
+// OperatorCondition. The OperatorCondition's name and namespace are determined by the
+// Factory's GetNamespacedName.
+func (f InClusterFactory) ObservedConditions(ctx context.Context) ([]metav1.Condition, error) {
+	operatorCond, err := f.getOperatorCondition(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return operatorCond.Status.Conditions, nil
+}
+
+// EffectiveStatus returns the effective condition of the given type of the operator's
+// OperatorCondition, see Effective. It can be used to find out whether OLM actually enforces
+// the state written by the operator, e.g. whether an administrator overrode Upgradeable.
+func (f InClusterFactory) EffectiveStatus(ctx context.Context, condType apiv2.ConditionType) (*EffectiveCondition, error) {
+	operatorCond, err := f.getOperatorCondition(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e := Effective(operatorCond, string(condType))
+	if e == nil {
+		return nil, fmt.Errorf("conditionType %v not found", condType)
+	}
+	return e, nil
+}
+
+func (f InClusterFactory) getOperatorCondition(ctx context.Context) (*apiv2.OperatorCondition, error) {
+	if operatorCond, err := devOverrideOperatorCondition(); operatorCond != nil || err != nil {
+		return operatorCond, err
+	}
+	objKey, err := f.GetNamespacedName()
+	if err != nil {
+		return nil, err
+	}
+	operatorCond := &apiv2.OperatorCondition{}
+	if err := f.Client.Get(ctx, *objKey, operatorCond); err != nil {
+		return nil, wrapOLMError(err)
+	}
+	return operatorCond, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Effective", func() {
+	upgradeable := func(status metav1.ConditionStatus, reason string) metav1.Condition {
+		return metav1.Condition{Type: apiv2.Upgradeable, Status: status, Reason: reason}
+	}
+
+	It("should return nil if the condition does not exist", func() {
+		Expect(Effective(&apiv2.OperatorCondition{}, apiv2.Upgradeable)).To(BeNil())
+	})
+
+	It("should prefer overrides over operator conditions", func() {
+		op := &apiv2.OperatorCondition{
+			Spec: apiv2.OperatorConditionSpec{
+				Overrides:  []metav1.Condition{upgradeable(metav1.ConditionTrue, "Override")},
+				Conditions: []metav1.Condition{upgradeable(metav1.ConditionFalse, "Migrating")},
+			},
+			Status: apiv2.OperatorConditionStatus{
+				Conditions: []metav1.Condition{upgradeable(metav1.ConditionTrue, "Override")},
+			},
+		}
+		e := Effective(op, apiv2.Upgradeable)
+		Expect(e).NotTo(BeNil())
+		Expect(e.Origin).To(Equal(OriginOverride))
+		Expect(e.Status).To(Equal(metav1.ConditionTrue))
+		Expect(e.Reason).To(Equal("Override"))
+		Expect(e.Operator.Reason).To(Equal("Migrating"))
+		Expect(e.Observed).NotTo(BeNil())
+		Expect(e.Overridden()).To(BeTrue())
+	})
+
+	It("should not report overrides with the same status as overriding", func() {
+		op := &apiv2.OperatorCondition{
+			Spec: apiv2.OperatorConditionSpec{
+				Overrides:  []metav1.Condition{upgradeable(metav1.ConditionFalse, "Override")},
+				Conditions: []metav1.Condition{upgradeable(metav1.ConditionFalse, "Migrating")},
+			},
+		}
+		e := Effective(op, apiv2.Upgradeable)
+		Expect(e.Origin).To(Equal(OriginOverride))
+		Expect(e.Overridden()).To(BeFalse())
+	})
+
+	It("should use operator conditions without overrides", func() {
+		op := &apiv2.OperatorCondition{
+			Spec: apiv2.OperatorConditionSpec{
+				Conditions: []metav1.Condition{upgradeable(metav1.ConditionFalse, "Migrating")},
+			},
+		}
+		e := Effective(op, apiv2.Upgradeable)
+		Expect(e.Origin).To(Equal(OriginOperator))
+		Expect(e.Status).To(Equal(metav1.ConditionFalse))
+		Expect(e.Overridden()).To(BeFalse())
+	})
+
+	It("should fall back to the conditions written by OLM", func() {
+		op := &apiv2.OperatorCondition{
+			Status: apiv2.OperatorConditionStatus{
+				Conditions: []metav1.Condition{upgradeable(metav1.ConditionTrue, "Observed")},
+			},
+		}
+		e := Effective(op, apiv2.Upgradeable)
+		Expect(e.Origin).To(Equal(OriginOLM))
+		Expect(e.Reason).To(Equal("Observed"))
+	})
+})
+
+var _ = Describe("InClusterFactory", func() {
+	ctx := context.TODO()
+	objKey := types.NamespacedName{Name: "operator-condition-test", Namespace: "default"}
+
+	var f InClusterFactory
+
+	BeforeEach(func() {
+		Expect(os.Setenv(operatorCondEnvVar, objKey.Name)).To(Succeed())
+		readNamespace = func() (string, error) {
+			return objKey.Namespace, nil
+		}
+
+		sch := runtime.NewScheme()
+		Expect(apiv2.AddToScheme(sch)).To(Succeed())
+		f = InClusterFactory{Client: fake.NewClientBuilder().WithScheme(sch).WithObjects(&apiv2.OperatorCondition{
+			ObjectMeta: metav1.ObjectMeta{Name: objKey.Name, Namespace: objKey.Namespace},
+			Spec: apiv2.OperatorConditionSpec{
+				Overrides:  []metav1.Condition{{Type: apiv2.Upgradeable, Status: metav1.ConditionTrue, Reason: "Override"}},
+				Conditions: []metav1.Condition{{Type: apiv2.Upgradeable, Status: metav1.ConditionFalse, Reason: "Migrating"}},
+			},
+			Status: apiv2.OperatorConditionStatus{
+				Conditions: []metav1.Condition{{Type: apiv2.Upgradeable, Status: metav1.ConditionTrue, Reason: "Override"}},
+			},
+		}).Build()}
+	})
+
+	It("should return the effective status", func() {
+		e, err := f.EffectiveStatus(ctx, apiv2.ConditionType(apiv2.Upgradeable))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Status).To(Equal(metav1.ConditionTrue))
+		Expect(e.Overridden()).To(BeTrue())
+	})
+
+	It("should return an error for missing conditions", func() {
+		_, err := f.EffectiveStatus(ctx, "Missing")
+		Expect(err).To(MatchError(ContainSubstring("conditionType Missing not found")))
+	})
+
+	It("should return the conditions written by OLM", func() {
+		conds, err := f.ObservedConditions(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conds).To(HaveLen(1))
+		Expect(conds[0].Reason).To(Equal("Override"))
+	})
+
+	It("should return an error if the OperatorCondition does not exist", func() {
+		Expect(os.Setenv(operatorCondEnvVar, "missing")).To(Succeed())
+		_, err := f.EffectiveStatus(ctx, apiv2.ConditionType(apiv2.Upgradeable))
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,203 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var errorConditionLog = logf.Log.WithName("conditions").WithName("error-condition")
+
+const (
+	// ReconcileFailedReason is the reason set by WrapWithErrorCondition when a reconciliation
+	// fails.
+	ReconcileFailedReason = "ReconcileFailed"
+	// ReconcileSucceededReason is the reason set by WrapWithErrorCondition when a reconciliation
+	// succeeds.
+	ReconcileSucceededReason = "ReconcileSucceeded"
+
+	// DefaultErrorConditionInterval is the minimum time between two updates of the message of a
+	// False condition set by WrapWithErrorCondition by default.
+	DefaultErrorConditionInterval = 30 * time.Second
+	// DefaultErrorConditionMaxLength is the maximum length of the message of a condition set by
+	// WrapWithErrorCondition by default.
+	DefaultErrorConditionMaxLength = 1024
+)
+
+// ErrorConditionOption configures the reconciler returned by WrapWithErrorCondition.
+type ErrorConditionOption func(*errorConditionReconciler)
+
+// WithErrorConditionInterval sets the minimum time between two updates of the message of the
+// condition while reconciliations keep failing. It defaults to DefaultErrorConditionInterval.
+// Changes of the status of the condition are never delayed.
+func WithErrorConditionInterval(interval time.Duration) ErrorConditionOption {
+	return func(r *errorConditionReconciler) {
+		r.interval = interval
+	}
+}
+
+// WithErrorConditionMaxLength sets the maximum length, in bytes, of the message of the
+// condition. Longer error messages are truncated. It defaults to DefaultErrorConditionMaxLength.
+func WithErrorConditionMaxLength(maxLength int) ErrorConditionOption {
+	return func(r *errorConditionReconciler) {
+		r.maxLength = maxLength
+	}
+}
+
+// WithErrorConditionClock sets the clock used to rate-limit the updates of the condition.
+func WithErrorConditionClock(c clock.PassiveClock) ErrorConditionOption {
+	return func(r *errorConditionReconciler) {
+		r.clock = c
+	}
+}
+
+// WrapWithErrorCondition returns a reconciler that calls r and mirrors its outcome to the
+// condType condition of the operator's OperatorCondition: the condition is set to False with
+// ReconcileFailedReason and the error as message whenever r returns an error, and back to True
+// with ReconcileSucceededReason when it succeeds. This surfaces recurring failures to OLM and
+// users without changes to r.
+//
+// The condition is False as long as the last reconciliation of any object failed, and True once
+// the last reconciliations of all objects succeeded. Its message names the object that failed
+// last. While reconciliations keep failing, the message is updated at most once per interval,
+// see WithErrorConditionInterval, to avoid writing the OperatorCondition on every retry.
+// Failures to update the condition are logged and do not change the result of r.
+func WrapWithErrorCondition(r reconcile.Reconciler, condType apiv2.ConditionType, cl client.Client, opts ...ErrorConditionOption) (reconcile.Reconciler, error) {
+	cond, err := InClusterFactory{Client: cl}.NewCondition(condType)
+	if err != nil {
+		return nil, err
+	}
+
+	ecr := &errorConditionReconciler{
+		reconciler: r,
+		condition:  cond,
+		condType:   condType,
+		interval:   DefaultErrorConditionInterval,
+		maxLength:  DefaultErrorConditionMaxLength,
+		clock:      clock.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(ecr)
+	}
+	return ecr, nil
+}
+
+// errorConditionReconciler mirrors the outcome of a reconciler to a condition.
+type errorConditionReconciler struct {
+	reconciler reconcile.Reconciler
+	condition  Condition
+	condType   apiv2.ConditionType
+	interval   time.Duration
+	maxLength  int
+	clock      clock.PassiveClock
+
+	mu sync.Mutex
+	// failing are the requests whose last reconciliation failed
+	failing map[types.NamespacedName]struct{}
+	// written is true once the condition was set, lastStatus and lastWrite are the status and
+	// time of the last update of the condition
+	written    bool
+	lastStatus metav1.ConditionStatus
+	lastWrite  time.Time
+}
+
+func (r *errorConditionReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	result, err := r.reconciler.Reconcile(ctx, req)
+
+	status, reason, message := metav1.ConditionTrue, ReconcileSucceededReason, ""
+	if err != nil {
+		status, reason = metav1.ConditionFalse, ReconcileFailedReason
+		message = truncateMessage(fmt.Sprintf("error reconciling %s: %v", req.NamespacedName, err), r.maxLength)
+	}
+
+	if !r.recordOutcome(req.NamespacedName, status) {
+		return result, err
+	}
+	if setErr := r.condition.Set(ctx, status, WithReason(reason), WithMessage(message)); setErr != nil {
+		errorConditionLog.Error(setErr, "Failed to set condition", "type", r.condType, "status", status)
+		r.mu.Lock()
+		r.written = false
+		r.mu.Unlock()
+	}
+	return result, err
+}
+
+// recordOutcome records the status of the last reconciliation of req, and returns true if the
+// condition must be set to status, in which case the update is recorded as well. The condition
+// is only set to True once no request is failing anymore.
+func (r *errorConditionReconciler) recordOutcome(req types.NamespacedName, status metav1.ConditionStatus) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.failing == nil {
+		r.failing = map[types.NamespacedName]struct{}{}
+	}
+	if status == metav1.ConditionFalse {
+		r.failing[req] = struct{}{}
+	} else {
+		delete(r.failing, req)
+		if len(r.failing) > 0 {
+			return false
+		}
+	}
+
+	if !r.shouldWrite(status) {
+		return false
+	}
+	r.written, r.lastStatus, r.lastWrite = true, status, r.clock.Now()
+	return true
+}
+
+// shouldWrite returns true if the condition must be set to status: when it was never set, when
+// its status changes, or when it stays False and the interval has passed since the last update.
+func (r *errorConditionReconciler) shouldWrite(status metav1.ConditionStatus) bool {
+	switch {
+	case !r.written || status != r.lastStatus:
+		return true
+	case status == metav1.ConditionTrue:
+		return false
+	default:
+		return r.clock.Since(r.lastWrite) >= r.interval
+	}
+}
+
+// truncateMessage truncates message to maxLength bytes, if maxLength is positive, marking the
+// truncation with an ellipsis. Multi-byte characters are not split.
+func truncateMessage(message string, maxLength int) string {
+	const ellipsis = "..."
+	if maxLength <= 0 || len(message) <= maxLength {
+		return message
+	}
+	if maxLength <= len(ellipsis) {
+		return ellipsis[:maxLength]
+	}
+	cut := maxLength - len(ellipsis)
+	for cut > 0 && !utf8.RuneStart(message[cut]) {
+		cut--
+	}
+	return message[:cut] + ellipsis
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("WrapWithErrorCondition", func() {
+	ctx := context.TODO()
+	objKey := types.NamespacedName{Name: "operator-condition-test", Namespace: "default"}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "db", Namespace: "apps"}}
+	condType := apiv2.ConditionType("ReconcileSucceeding")
+
+	var (
+		cl        client.Client
+		clock     *clocktesting.FakePassiveClock
+		reconErr  error
+		updates   int
+		wrapped   reconcile.Reconciler
+		inner     reconcile.Reconciler
+		condition func() *metav1.Condition
+	)
+
+	BeforeEach(func() {
+		Expect(os.Setenv(operatorCondEnvVar, objKey.Name)).To(Succeed())
+		readNamespace = func() (string, error) {
+			return objKey.Namespace, nil
+		}
+
+		sch := runtime.NewScheme()
+		Expect(apiv2.AddToScheme(sch)).To(Succeed())
+		updates = 0
+		cl = fake.NewClientBuilder().WithScheme(sch).WithObjects(&apiv2.OperatorCondition{
+			ObjectMeta: metav1.ObjectMeta{Name: objKey.Name, Namespace: objKey.Namespace},
+		}).WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				updates++
+				return c.Update(ctx, obj, opts...)
+			},
+		}).Build()
+		clock = clocktesting.NewFakePassiveClock(time.Now())
+
+		reconErr = nil
+		inner = reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+			return reconcile.Result{RequeueAfter: time.Minute}, reconErr
+		})
+		var err error
+		wrapped, err = WrapWithErrorCondition(inner, condType, cl, WithErrorConditionClock(clock),
+			WithErrorConditionInterval(time.Minute), WithErrorConditionMaxLength(64))
+		Expect(err).NotTo(HaveOccurred())
+
+		condition = func() *metav1.Condition {
+			op := &apiv2.OperatorCondition{}
+			Expect(cl.Get(ctx, objKey, op)).To(Succeed())
+			return meta.FindStatusCondition(op.Spec.Conditions, string(condType))
+		}
+	})
+
+	It("should error when the OperatorCondition cannot be found", func() {
+		Expect(os.Unsetenv(operatorCondEnvVar)).To(Succeed())
+		r, err := WrapWithErrorCondition(inner, condType, cl)
+		Expect(err).To(HaveOccurred())
+		Expect(r).To(BeNil())
+	})
+
+	It("should mirror the outcome of reconciliations to the condition", func() {
+		By("succeeding")
+		result, err := wrapped.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+		Expect(condition().Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition().Reason).To(Equal(ReconcileSucceededReason))
+
+		By("failing")
+		reconErr = errors.New("database unreachable")
+		_, err = wrapped.Reconcile(ctx, req)
+		Expect(err).To(MatchError(reconErr))
+		Expect(condition().Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition().Reason).To(Equal(ReconcileFailedReason))
+		Expect(condition().Message).To(Equal("error reconciling apps/db: database unreachable"))
+
+		By("succeeding again")
+		reconErr = nil
+		_, err = wrapped.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(condition().Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition().Message).To(BeEmpty())
+	})
+
+	It("should stay False until all failing objects are reconciled successfully", func() {
+		other := reconcile.Request{NamespacedName: types.NamespacedName{Name: "cache", Namespace: "apps"}}
+		reconErr = errors.New("database unreachable")
+		_, _ = wrapped.Reconcile(ctx, req)
+		_, _ = wrapped.Reconcile(ctx, other)
+		Expect(condition().Status).To(Equal(metav1.ConditionFalse))
+
+		By("succeeding for one of the failing objects")
+		reconErr = nil
+		_, _ = wrapped.Reconcile(ctx, other)
+		Expect(condition().Status).To(Equal(metav1.ConditionFalse))
+
+		By("succeeding for the last failing object")
+		_, _ = wrapped.Reconcile(ctx, req)
+		Expect(condition().Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should rate-limit updates while reconciliations keep failing", func() {
+		reconErr = errors.New("first")
+		_, _ = wrapped.Reconcile(ctx, req)
+		Expect(updates).To(Equal(1))
+
+		reconErr = errors.New("second")
+		_, _ = wrapped.Reconcile(ctx, req)
+		Expect(updates).To(Equal(1))
+		Expect(condition().Message).To(HaveSuffix("first"))
+
+		clock.SetTime(clock.Now().Add(time.Minute))
+		_, _ = wrapped.Reconcile(ctx, req)
+		Expect(updates).To(Equal(2))
+		Expect(condition().Message).To(HaveSuffix("second"))
+
+		By("not updating the condition while reconciliations succeed")
+		reconErr = nil
+		_, _ = wrapped.Reconcile(ctx, req)
+		_, _ = wrapped.Reconcile(ctx, req)
+		Expect(updates).To(Equal(3))
+	})
+
+	It("should truncate long messages", func() {
+		reconErr = errors.New(strings.Repeat("é", 100))
+		_, _ = wrapped.Reconcile(ctx, req)
+		Expect(len(condition().Message)).To(BeNumerically("<=", 64))
+		Expect(condition().Message).To(HaveSuffix("é..."))
+	})
+
+	It("should return the result of the reconciler when the condition cannot be set", func() {
+		Expect(cl.Delete(ctx, &apiv2.OperatorCondition{ObjectMeta: metav1.ObjectMeta{Name: objKey.Name, Namespace: objKey.Namespace}})).To(Succeed())
+		reconErr = errors.New("database unreachable")
+		result, err := wrapped.Reconcile(ctx, req)
+		Expect(err).To(MatchError(reconErr))
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+	})
+})
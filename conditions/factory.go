@@ -36,12 +36,54 @@ type Factory interface {
 // configuration.
 type InClusterFactory struct {
 	Client client.Client
+
+	conditionName      string
+	conditionNamespace string
+}
+
+// FactoryOption configures an InClusterFactory created with NewInClusterFactory.
+type FactoryOption func(*InClusterFactory)
+
+// WithOperatorConditionName sets the name of the operator's OperatorCondition, instead of
+// reading it from the OPERATOR_CONDITION_NAME environment variable set by OLM.
+func WithOperatorConditionName(name string) FactoryOption {
+	return func(f *InClusterFactory) {
+		f.conditionName = name
+	}
+}
+
+// WithOperatorConditionNamespace sets the namespace of the operator's OperatorCondition, instead
+// of reading it from the namespace file mounted into the operator's pod.
+func WithOperatorConditionNamespace(namespace string) FactoryOption {
+	return func(f *InClusterFactory) {
+		f.conditionNamespace = namespace
+	}
+}
+
+// NewInClusterFactory returns an InClusterFactory using the provided client. By default, the
+// name and namespace of the operator's OperatorCondition are determined as OLM sets them, see
+// GetNamespacedName. Tests and operators not deployed by OLM can set them with opts.
+func NewInClusterFactory(cl client.Client, opts ...FactoryOption) InClusterFactory {
+	f := InClusterFactory{Client: cl}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
 }
 
 // NewCondition creates a new Condition using the provided client and condition
 // type. The condition's name and namespace are determined by the Factory's GetName
-// and GetNamespace functions.
+// and GetNamespace functions. The OperatorCondition is not fetched until Get or Set are
+// called, use IsOLMAvailable to check whether OLM is installed beforehand. If the operator is not
+// run by OLM, i.e. the name of its OperatorCondition is not set in its environment, an error
+// wrapping ErrNotManagedByOLM is returned.
+//
+// For local development, the conditions can be read and written locally instead, see
+// DevOverrideEnvVar and DevOverrideFileEnvVar.
 func (f InClusterFactory) NewCondition(condType apiv2.ConditionType) (Condition, error) {
+	if c, err := devOverride(condType); c != nil || err != nil {
+		return c, err
+	}
 	objKey, err := f.GetNamespacedName()
 	if err != nil {
 		return nil, err
@@ -56,11 +98,13 @@ func (f InClusterFactory) NewCondition(condType apiv2.ConditionType) (Condition,
 // GetNamespacedName returns the NamespacedName of the CR. It returns an error
 // when the name of the CR cannot be found from the environment variable set by
 // OLM. Hence, GetNamespacedName() can provide the NamespacedName when the operator
-// is running on cluster and is being managed by OLM.
+// is running on cluster and is being managed by OLM. Otherwise, the error wraps
+// ErrNotManagedByOLM. The name and namespace set with WithOperatorConditionName and
+// WithOperatorConditionNamespace take precedence.
 func (f InClusterFactory) GetNamespacedName() (*types.NamespacedName, error) {
 	conditionName, err := f.getConditionName()
 	if err != nil {
-		return nil, fmt.Errorf("get operator condition name: %v", err)
+		return nil, fmt.Errorf("get operator condition name: %w", err)
 	}
 	conditionNamespace, err := f.getConditionNamespace()
 	if err != nil {
@@ -78,11 +122,15 @@ const (
 )
 
 // getConditionName reads and returns the OPERATOR_CONDITION_NAME environment
-// variable. If the variable is unset or empty, it returns an error.
+// variable, unless the name was set with WithOperatorConditionName. If the variable is unset or
+// empty, it returns an error wrapping ErrNotManagedByOLM.
 func (f InClusterFactory) getConditionName() (string, error) {
+	if f.conditionName != "" {
+		return f.conditionName, nil
+	}
 	name := os.Getenv(operatorCondEnvVar)
 	if name == "" {
-		return "", fmt.Errorf("could not determine operator condition name: environment variable %s not set", operatorCondEnvVar)
+		return "", fmt.Errorf("%w: could not determine operator condition name: environment variable %s not set", ErrNotManagedByOLM, operatorCondEnvVar)
 	}
 	return name, nil
 }
@@ -92,8 +140,12 @@ var readNamespace = utils.GetOperatorNamespace
 
 // getConditionNamespace reads the namespace file mounted into a pod in a
 // cluster via its service account volume. If the file is not found or cannot be
-// read, this function returns an error.
+// read, this function returns an error. The namespace set with WithOperatorConditionNamespace
+// is returned instead if any.
 func (f InClusterFactory) getConditionNamespace() (string, error) {
+	if f.conditionNamespace != "" {
+		return f.conditionNamespace, nil
+	}
 	return readNamespace()
 }
 
@@ -101,9 +153,9 @@ func (f InClusterFactory) getConditionNamespace() (string, error) {
 // for the specified conditionType. The condition will internally fetch the namespacedName
 // of the operatorConditionCRD.
 //
-// Deprecated: Use InClusterFactory{cl}.NewCondition() instead.
+// Deprecated: Use InClusterFactory{Client: cl}.NewCondition() instead.
 func NewCondition(cl client.Client, condType apiv2.ConditionType) (Condition, error) {
-	return InClusterFactory{cl}.NewCondition(condType)
+	return InClusterFactory{Client: cl}.NewCondition(condType)
 }
 
 // GetNamespacedName returns the NamespacedName of the CR. It returns an error
@@ -57,7 +57,7 @@ var _ = Describe("InClusterFactory", func() {
 		sch := runtime.NewScheme()
 		Expect(apiv2.AddToScheme(sch)).To(Succeed())
 		cl = fake.NewClientBuilder().WithScheme(sch).Build()
-		f = InClusterFactory{cl}
+		f = InClusterFactory{Client: cl}
 	})
 
 	Describe("NewCondition", func() {
@@ -67,6 +67,36 @@ var _ = Describe("InClusterFactory", func() {
 	Describe("GetNamespacedName", func() {
 		testGetNamespacedName(f.GetNamespacedName)
 	})
+
+	Describe("NewInClusterFactory", func() {
+		It("should use the name and namespace set as options", func() {
+			Expect(os.Unsetenv(operatorCondEnvVar)).To(Succeed())
+			readNamespace = func() (string, error) {
+				return "", os.ErrNotExist
+			}
+
+			f := NewInClusterFactory(cl, WithOperatorConditionName("test"), WithOperatorConditionNamespace("testns"))
+			Expect(f.Client).To(Equal(cl))
+			objKey, err := f.GetNamespacedName()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*objKey).To(Equal(types.NamespacedName{Name: "test", Namespace: "testns"}))
+		})
+
+		It("should read the name and namespace that are not set as options as OLM sets them", func() {
+			Expect(os.Setenv(operatorCondEnvVar, "test")).To(Succeed())
+			readNamespace = func() (string, error) {
+				return "testns", nil
+			}
+
+			objKey, err := NewInClusterFactory(cl, WithOperatorConditionNamespace("other")).GetNamespacedName()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*objKey).To(Equal(types.NamespacedName{Name: "test", Namespace: "other"}))
+
+			objKey, err = NewInClusterFactory(cl, WithOperatorConditionName("other")).GetNamespacedName()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*objKey).To(Equal(types.NamespacedName{Name: "other", Namespace: "testns"}))
+		})
+	})
 })
 
 func testNewCondition(fn func(apiv2.ConditionType) (Condition, error)) {
@@ -85,7 +115,8 @@ func testNewCondition(fn func(apiv2.ConditionType) (Condition, error)) {
 		Expect(os.Unsetenv(operatorCondEnvVar)).To(Succeed())
 
 		c, err := fn(conditionFoo)
-		Expect(err).To(HaveOccurred())
+		Expect(err).To(MatchError(ErrNotManagedByOLM))
+		Expect(IsOLMNotAvailable(err)).To(BeFalse())
 		Expect(c).To(BeNil())
 	})
 }
@@ -96,6 +127,7 @@ func testGetNamespacedName(fn func() (*types.NamespacedName, error)) {
 
 		objKey, err := fn()
 		Expect(err).To(MatchError(ContainSubstring("could not determine operator condition name")))
+		Expect(err).To(MatchError(ErrNotManagedByOLM))
 		Expect(objKey).To(BeNil())
 	})
 
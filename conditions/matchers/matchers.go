@@ -0,0 +1,222 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package matchers provides Gomega matchers asserting the conditions of operators, e.g.
+//
+//	Expect(operatorCondition).To(matchers.HaveCondition("Upgradeable", BeFalse(),
+//		matchers.WithReason("MigrationInProgress")))
+//
+// The matchers accept OperatorConditions, whose spec conditions are matched, slices of
+// metav1.Condition, unstructured objects with .status.conditions, and any value with a
+// GetConditions() []metav1.Condition method, such as the status types of custom resources.
+package matchers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/types"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/operator-framework/operator-lib/conditions"
+)
+
+// ConditionOption adds an expectation on the condition matched by HaveCondition.
+type ConditionOption func(*conditionMatcher)
+
+// WithReason expects the reason of the condition to match reason, a string or a Gomega matcher.
+func WithReason(reason interface{}) ConditionOption {
+	return func(m *conditionMatcher) {
+		m.fields = append(m.fields, fieldExpectation{name: "reason", expected: reason, value: func(c metav1.Condition) interface{} {
+			return c.Reason
+		}})
+	}
+}
+
+// WithMessage expects the message of the condition to match message, a string or a Gomega
+// matcher such as ContainSubstring.
+func WithMessage(message interface{}) ConditionOption {
+	return func(m *conditionMatcher) {
+		m.fields = append(m.fields, fieldExpectation{name: "message", expected: message, value: func(c metav1.Condition) interface{} {
+			return c.Message
+		}})
+	}
+}
+
+// WithObservedGeneration expects the condition to have been set for the given generation, an
+// int64 or a Gomega matcher.
+func WithObservedGeneration(generation interface{}) ConditionOption {
+	return func(m *conditionMatcher) {
+		m.fields = append(m.fields, fieldExpectation{name: "observedGeneration", expected: generation, value: func(c metav1.Condition) interface{} {
+			return c.ObservedGeneration
+		}})
+	}
+}
+
+// HaveCondition succeeds if the actual value has a condition of type conditionType whose status
+// matches status, and that meets the expectations of opts.
+//
+// status can be a metav1.ConditionStatus, a bool, a Gomega matcher or nil. Bools and matchers are
+// matched against true for the ConditionTrue status and false for the ConditionFalse status, so
+// that BeTrue() and BeFalse() can be used; conditions with the ConditionUnknown status only match
+// metav1.ConditionUnknown. A nil status matches any status.
+func HaveCondition(conditionType string, status interface{}, opts ...ConditionOption) types.GomegaMatcher {
+	m := &conditionMatcher{conditionType: conditionType, status: status}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+type fieldExpectation struct {
+	name     string
+	expected interface{}
+	value    func(metav1.Condition) interface{}
+}
+
+type conditionMatcher struct {
+	conditionType string
+	status        interface{}
+	fields        []fieldExpectation
+
+	// mismatch describes why the last match failed
+	mismatch string
+}
+
+var _ types.GomegaMatcher = &conditionMatcher{}
+
+func (m *conditionMatcher) Match(actual interface{}) (bool, error) {
+	conds, err := conditionsOf(actual)
+	if err != nil {
+		return false, err
+	}
+
+	cond := meta.FindStatusCondition(conds, m.conditionType)
+	if cond == nil {
+		m.mismatch = fmt.Sprintf("no condition of type %q is set", m.conditionType)
+		return false, nil
+	}
+
+	if ok, err := m.matchStatus(*cond); err != nil || !ok {
+		return false, err
+	}
+	for _, field := range m.fields {
+		value := field.value(*cond)
+		ok, err := matchValue(field.expected, value)
+		if err != nil {
+			return false, fmt.Errorf("error matching the %s of condition %q: %w", field.name, m.conditionType, err)
+		}
+		if !ok {
+			m.mismatch = fmt.Sprintf("the %s of condition %q is %#v", field.name, m.conditionType, value)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (m *conditionMatcher) matchStatus(cond metav1.Condition) (bool, error) {
+	var ok bool
+	var err error
+	switch expected := m.status.(type) {
+	case nil:
+		return true, nil
+	case metav1.ConditionStatus:
+		ok = cond.Status == expected
+	case string:
+		ok = string(cond.Status) == expected
+	default:
+		if cond.Status != metav1.ConditionTrue && cond.Status != metav1.ConditionFalse {
+			break
+		}
+		ok, err = matchValue(expected, cond.Status == metav1.ConditionTrue)
+	}
+	if err != nil {
+		return false, fmt.Errorf("error matching the status of condition %q: %w", m.conditionType, err)
+	}
+	if !ok {
+		m.mismatch = fmt.Sprintf("the status of condition %q is %s", m.conditionType, cond.Status)
+	}
+	return ok, nil
+}
+
+func (m *conditionMatcher) FailureMessage(actual interface{}) string {
+	return format.Message(actual, "to have "+m.description()) + "\n" + m.mismatch
+}
+
+func (m *conditionMatcher) NegatedFailureMessage(actual interface{}) string {
+	return format.Message(actual, "not to have "+m.description())
+}
+
+// description describes the expected condition, e.g. `condition "Upgradeable" with status false
+// and reason "MigrationInProgress"`.
+func (m *conditionMatcher) description() string {
+	var expectations []string
+	if m.status != nil {
+		expectations = append(expectations, "status "+describe(m.status))
+	}
+	for _, field := range m.fields {
+		expectations = append(expectations, field.name+" "+describe(field.expected))
+	}
+	if len(expectations) == 0 {
+		return fmt.Sprintf("condition %q", m.conditionType)
+	}
+	return fmt.Sprintf("condition %q with %s", m.conditionType, strings.Join(expectations, " and "))
+}
+
+// matchValue matches value against expected, a Gomega matcher or a value expected to be equal.
+func matchValue(expected, value interface{}) (bool, error) {
+	matcher, ok := expected.(types.GomegaMatcher)
+	if !ok {
+		matcher = gomega.BeEquivalentTo(expected)
+	}
+	return matcher.Match(value)
+}
+
+func describe(expected interface{}) string {
+	if _, ok := expected.(types.GomegaMatcher); ok {
+		return fmt.Sprintf("matching %T", expected)
+	}
+	return fmt.Sprintf("%#v", expected)
+}
+
+// conditionsOf returns the conditions of actual.
+func conditionsOf(actual interface{}) ([]metav1.Condition, error) {
+	switch v := actual.(type) {
+	case []metav1.Condition:
+		return v, nil
+	case *[]metav1.Condition:
+		if v == nil {
+			return nil, nil
+		}
+		return *v, nil
+	case apiv2.OperatorCondition:
+		return v.Spec.Conditions, nil
+	case *apiv2.OperatorCondition:
+		if v == nil {
+			return nil, fmt.Errorf("HaveCondition expects a non-nil OperatorCondition")
+		}
+		return v.Spec.Conditions, nil
+	case *unstructured.Unstructured:
+		return conditions.UnstructuredConditions(v)
+	case interface{ GetConditions() []metav1.Condition }:
+		return v.GetConditions(), nil
+	default:
+		return nil, fmt.Errorf("HaveCondition expects an OperatorCondition, conditions or a value with a GetConditions method, got:\n%s", format.Object(actual, 1))
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matchers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type fakeStatus struct {
+	Conditions []metav1.Condition
+}
+
+func (s fakeStatus) GetConditions() []metav1.Condition {
+	return s.Conditions
+}
+
+var _ = Describe("HaveCondition", func() {
+	var conds []metav1.Condition
+
+	BeforeEach(func() {
+		conds = []metav1.Condition{
+			{
+				Type:               apiv2.Upgradeable,
+				Status:             metav1.ConditionFalse,
+				Reason:             "MigrationInProgress",
+				Message:            "migrating the database to v2",
+				ObservedGeneration: 3,
+			},
+			{
+				Type:   "Ready",
+				Status: metav1.ConditionUnknown,
+				Reason: "Pending",
+			},
+		}
+	})
+
+	It("should match the status of a condition", func() {
+		Expect(conds).To(HaveCondition(apiv2.Upgradeable, BeFalse()))
+		Expect(conds).To(HaveCondition(apiv2.Upgradeable, false))
+		Expect(conds).To(HaveCondition(apiv2.Upgradeable, metav1.ConditionFalse))
+		Expect(conds).To(HaveCondition(apiv2.Upgradeable, nil))
+		Expect(conds).NotTo(HaveCondition(apiv2.Upgradeable, BeTrue()))
+	})
+
+	It("should only match Unknown conditions with the Unknown status", func() {
+		Expect(conds).To(HaveCondition("Ready", metav1.ConditionUnknown))
+		Expect(conds).NotTo(HaveCondition("Ready", BeFalse()))
+		Expect(conds).NotTo(HaveCondition("Ready", BeTrue()))
+	})
+
+	It("should match the reason, message and observed generation of a condition", func() {
+		Expect(conds).To(HaveCondition(apiv2.Upgradeable, BeFalse(),
+			WithReason("MigrationInProgress"),
+			WithMessage(ContainSubstring("database")),
+			WithObservedGeneration(3)))
+		Expect(conds).NotTo(HaveCondition(apiv2.Upgradeable, BeFalse(), WithReason("Other")))
+		Expect(conds).NotTo(HaveCondition(apiv2.Upgradeable, BeFalse(), WithObservedGeneration(BeNumerically(">", 3))))
+	})
+
+	It("should not match missing conditions", func() {
+		Expect(conds).NotTo(HaveCondition("Degraded", nil))
+		Expect([]metav1.Condition(nil)).NotTo(HaveCondition("Degraded", nil))
+	})
+
+	It("should explain why a condition does not match", func() {
+		m := HaveCondition(apiv2.Upgradeable, BeFalse(), WithReason("Other"))
+		Expect(m.Match(conds)).To(BeFalse())
+		Expect(m.FailureMessage(conds)).To(ContainSubstring(`to have condition "Upgradeable" with status matching`))
+		Expect(m.FailureMessage(conds)).To(ContainSubstring(`the reason of condition "Upgradeable" is "MigrationInProgress"`))
+
+		m = HaveCondition("Degraded", nil)
+		Expect(m.Match(conds)).To(BeFalse())
+		Expect(m.FailureMessage(conds)).To(ContainSubstring(`no condition of type "Degraded" is set`))
+	})
+
+	It("should match the spec conditions of OperatorConditions", func() {
+		operatorCondition := &apiv2.OperatorCondition{Spec: apiv2.OperatorConditionSpec{Conditions: conds}}
+		Expect(operatorCondition).To(HaveCondition(apiv2.Upgradeable, BeFalse()))
+		Expect(*operatorCondition).To(HaveCondition(apiv2.Upgradeable, BeFalse()))
+	})
+
+	It("should match pointers to conditions and values with a GetConditions method", func() {
+		Expect(&conds).To(HaveCondition(apiv2.Upgradeable, BeFalse()))
+		Expect(fakeStatus{Conditions: conds}).To(HaveCondition(apiv2.Upgradeable, BeFalse()))
+	})
+
+	It("should match the status conditions of unstructured objects", func() {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		Expect(unstructured.SetNestedSlice(u.Object, []interface{}{
+			map[string]interface{}{
+				"type":               "Ready",
+				"status":             "True",
+				"reason":             "Reconciled",
+				"message":            "",
+				"lastTransitionTime": "2021-01-01T00:00:00Z",
+			},
+		}, "status", "conditions")).To(Succeed())
+		Expect(u).To(HaveCondition("Ready", BeTrue(), WithReason("Reconciled")))
+	})
+
+	It("should return an error for unsupported values", func() {
+		_, err := HaveCondition("Ready", nil).Match("Ready")
+		Expect(err).To(MatchError(ContainSubstring("HaveCondition expects")))
+	})
+})
@@ -0,0 +1,285 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var mirrorLog = logf.Log.WithName("conditions").WithName("mirror")
+
+const (
+	// DefaultMirrorInterval is the interval at which a Mirror rolls up operand conditions by default.
+	DefaultMirrorInterval = 30 * time.Second
+
+	// DefaultMirrorMaxMessages is the number of operand messages included in a mirrored
+	// condition by default. Further messages are summarized by their count.
+	DefaultMirrorMaxMessages = 10
+)
+
+// AggregationPolicy defines how the conditions of several operands are rolled up.
+type AggregationPolicy string
+
+const (
+	// AllOf rolls up to True if the conditions of all operands are True, to False if any is
+	// False and to Unknown otherwise. It rolls up to True if there are no operands.
+	AllOf AggregationPolicy = "AllOf"
+	// AnyOf rolls up to True if the condition of any operand is True, to False if all are
+	// False and to Unknown otherwise. It rolls up to False if there are no operands.
+	AnyOf AggregationPolicy = "AnyOf"
+)
+
+// MirrorRule describes an operator-level condition rolled up from a condition of operands.
+type MirrorRule struct {
+	// GVK is the kind of the operands.
+	GVK schema.GroupVersionKind
+	// Namespace restricts the operands to a namespace. Operands in all namespaces are
+	// considered if it is empty.
+	Namespace string
+	// LabelSelector restricts the operands to those matching it, if set.
+	LabelSelector labels.Selector
+
+	// SourceType is the type of the condition of the operands, e.g. "Ready".
+	SourceType string
+	// Inverted negates the status of the source conditions before aggregation.
+	Inverted bool
+	// Policy is the aggregation policy, AllOf if empty.
+	Policy AggregationPolicy
+
+	// TargetType is the type of the condition set on the OperatorCondition, e.g. "DatabasesReady".
+	TargetType string
+}
+
+// Mirror rolls up conditions of operand custom resources into conditions of the operator's
+// OperatorCondition, in spec.conditions, according to its MirrorRules. The messages of the
+// operands causing a rolled-up condition to deviate from its expected state are summarized
+// in it. A Mirror is a manager.Runnable that syncs the conditions periodically while the
+// operator is the leader; Sync can also be called directly, e.g. at the end of a reconciliation.
+type Mirror struct {
+	client         client.Client
+	namespacedName types.NamespacedName
+	rules          []MirrorRule
+	interval       time.Duration
+	maxMessages    int
+
+	// local, if set, holds the conditions of the developer override, see DevOverrideEnvVar
+	local *localStore
+}
+
+// MirrorOption configures a Mirror.
+type MirrorOption func(*Mirror)
+
+// WithMirrorInterval sets the interval at which the Mirror syncs the conditions.
+// It defaults to DefaultMirrorInterval.
+func WithMirrorInterval(interval time.Duration) MirrorOption {
+	return func(m *Mirror) {
+		m.interval = interval
+	}
+}
+
+// WithMirrorMaxMessages sets the number of operand messages included in a mirrored condition.
+// It defaults to DefaultMirrorMaxMessages, a non-positive value includes all messages.
+func WithMirrorMaxMessages(n int) MirrorOption {
+	return func(m *Mirror) {
+		m.maxMessages = n
+	}
+}
+
+var _ manager.Runnable = &Mirror{}
+var _ manager.LeaderElectionRunnable = &Mirror{}
+
+// NewMirror creates a Mirror for the operator's OperatorCondition with the given rules. The
+// OperatorCondition's name and namespace are determined by the Factory's GetNamespacedName. For
+// local development, the conditions can be written locally instead, see DevOverrideEnvVar.
+func (f InClusterFactory) NewMirror(rules []MirrorRule, opts ...MirrorOption) (*Mirror, error) {
+	local, err := devOverrideStore()
+	if err != nil {
+		return nil, err
+	}
+	objKey := &types.NamespacedName{}
+	if local == nil {
+		objKey, err = f.GetNamespacedName()
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, rule := range rules {
+		if rule.SourceType == "" || rule.TargetType == "" {
+			return nil, fmt.Errorf("mirror rule for %s must have a source and a target condition type", rule.GVK)
+		}
+		if rule.Policy != "" && rule.Policy != AllOf && rule.Policy != AnyOf {
+			return nil, fmt.Errorf("mirror rule for %s has unknown aggregation policy %q", rule.GVK, rule.Policy)
+		}
+	}
+
+	m := &Mirror{
+		client:         f.Client,
+		namespacedName: *objKey,
+		rules:          rules,
+		interval:       DefaultMirrorInterval,
+		maxMessages:    DefaultMirrorMaxMessages,
+		local:          local,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m, nil
+}
+
+// Start implements manager.Runnable. It syncs the conditions until the context is done.
+// Errors are logged and retried at the next interval.
+func (m *Mirror) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := m.Sync(ctx); err != nil {
+			mirrorLog.Error(err, "Failed to mirror operand conditions", "operatorCondition", m.namespacedName)
+		}
+	}, m.interval)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Conditions are only
+// mirrored by the leader.
+func (m *Mirror) NeedLeaderElection() bool {
+	return true
+}
+
+// Sync rolls up the conditions of the operands and sets them on the OperatorCondition.
+// The OperatorCondition is only updated if a condition changed.
+func (m *Mirror) Sync(ctx context.Context) error {
+	conditions := make([]metav1.Condition, 0, len(m.rules))
+	for _, rule := range m.rules {
+		c, err := m.rollUp(ctx, rule)
+		if err != nil {
+			return err
+		}
+		conditions = append(conditions, c)
+	}
+
+	if m.local != nil {
+		return m.local.update(func(conds *[]metav1.Condition) bool {
+			changed := false
+			for _, c := range conditions {
+				if meta.SetStatusCondition(conds, c) {
+					changed = true
+				}
+			}
+			return changed
+		})
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		operatorCond := &apiv2.OperatorCondition{}
+		if err := m.client.Get(ctx, m.namespacedName, operatorCond); err != nil {
+			return wrapOLMError(err)
+		}
+
+		changed := false
+		for _, c := range conditions {
+			if meta.SetStatusCondition(&operatorCond.Spec.Conditions, c) {
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+
+		if err := m.client.Update(ctx, operatorCond); err != nil {
+			recordWriteError(err)
+			return err
+		}
+		return nil
+	})
+}
+
+// rollUp returns the condition of rule aggregated from the conditions of its operands.
+func (m *Mirror) rollUp(ctx context.Context, rule MirrorRule) (metav1.Condition, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(rule.GVK.GroupVersion().WithKind(rule.GVK.Kind + "List"))
+	opts := []client.ListOption{client.InNamespace(rule.Namespace)}
+	if rule.LabelSelector != nil {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: rule.LabelSelector})
+	}
+	if err := m.client.List(ctx, list, opts...); err != nil {
+		return metav1.Condition{}, fmt.Errorf("error listing operands of kind %s: %w", rule.GVK, err)
+	}
+
+	sources := make([]sourceStatus, 0, len(list.Items))
+	for i := range list.Items {
+		operand := &list.Items[i]
+		s := sourceStatus{
+			source: ConditionSource{Type: operandName(rule.GVK, operand), Inverted: rule.Inverted},
+			status: metav1.ConditionUnknown,
+		}
+		c, err := FindUnstructuredCondition(operand, rule.SourceType)
+		if err != nil {
+			mirrorLog.V(1).Info("Ignoring malformed conditions of operand", "operand", s.source.Type, "error", err.Error())
+		}
+		if c != nil {
+			s.cond = c
+			s.status = c.Status
+			if rule.Inverted {
+				s.status = invert(c.Status)
+			}
+		}
+		sources = append(sources, s)
+	}
+
+	var c metav1.Condition
+	if rule.Policy == AnyOf {
+		c = aggregate(rule.TargetType, sources, metav1.ConditionTrue, metav1.ConditionFalse)
+	} else {
+		c = aggregate(rule.TargetType, sources, metav1.ConditionFalse, metav1.ConditionTrue)
+	}
+	// the observed generations of operands are unrelated to the OperatorCondition
+	c.ObservedGeneration = 0
+	c.Message = truncateMessages(c.Message, m.maxMessages)
+	return c, nil
+}
+
+// operandName identifies operand in the messages of mirrored conditions.
+func operandName(gvk schema.GroupVersionKind, operand client.Object) string {
+	if operand.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s", gvk.Kind, operand.GetName())
+	}
+	return fmt.Sprintf("%s %s/%s", gvk.Kind, operand.GetNamespace(), operand.GetName())
+}
+
+// truncateMessages keeps the first max lines of message and summarizes the others by their count.
+func truncateMessages(message string, max int) string {
+	if message == "" || max <= 0 {
+		return message
+	}
+	lines := strings.Split(message, "\n")
+	if len(lines) <= max {
+		return message
+	}
+	return fmt.Sprintf("%s\nand %d more", strings.Join(lines[:max], "\n"), len(lines)-max)
+}
@@ -0,0 +1,232 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("Mirror", func() {
+	ctx := context.TODO()
+	objKey := types.NamespacedName{Name: "operator-condition-test", Namespace: "default"}
+	databaseGVK := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"}
+
+	var (
+		sch     *runtime.Scheme
+		cl      client.Client
+		updates int
+	)
+
+	newDatabase := func(namespace, name string, conditions ...metav1.Condition) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(databaseGVK)
+		u.SetNamespace(namespace)
+		u.SetName(name)
+		u.SetLabels(map[string]string{"app": "db"})
+		for _, c := range conditions {
+			_, err := SetUnstructuredCondition(u, c)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		return u
+	}
+
+	ready := func(status metav1.ConditionStatus, message string) metav1.Condition {
+		return metav1.Condition{Type: "Ready", Status: status, Reason: "Reconciled", Message: message}
+	}
+
+	build := func(objs ...client.Object) {
+		objs = append(objs, &apiv2.OperatorCondition{
+			ObjectMeta: metav1.ObjectMeta{Name: objKey.Name, Namespace: objKey.Namespace},
+		})
+		cl = fake.NewClientBuilder().WithScheme(sch).WithObjects(objs...).WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				updates++
+				return c.Update(ctx, obj, opts...)
+			},
+		}).Build()
+	}
+
+	getCondition := func(condType string) *metav1.Condition {
+		op := &apiv2.OperatorCondition{}
+		Expect(cl.Get(ctx, objKey, op)).To(Succeed())
+		return meta.FindStatusCondition(op.Spec.Conditions, condType)
+	}
+
+	BeforeEach(func() {
+		Expect(os.Setenv(operatorCondEnvVar, objKey.Name)).To(Succeed())
+		readNamespace = func() (string, error) {
+			return objKey.Namespace, nil
+		}
+
+		sch = runtime.NewScheme()
+		Expect(apiv2.AddToScheme(sch)).To(Succeed())
+		updates = 0
+	})
+
+	It("should error when the namespacedName cannot be found", func() {
+		Expect(os.Unsetenv(operatorCondEnvVar)).To(Succeed())
+		m, err := InClusterFactory{Client: cl}.NewMirror(nil)
+		Expect(err).To(HaveOccurred())
+		Expect(m).To(BeNil())
+	})
+
+	It("should reject invalid rules", func() {
+		_, err := InClusterFactory{Client: cl}.NewMirror([]MirrorRule{{GVK: databaseGVK, SourceType: "Ready"}})
+		Expect(err).To(MatchError(ContainSubstring("must have a source and a target condition type")))
+
+		_, err = InClusterFactory{Client: cl}.NewMirror([]MirrorRule{{GVK: databaseGVK, SourceType: "Ready", TargetType: "DatabasesReady", Policy: "Most"}})
+		Expect(err).To(MatchError(ContainSubstring(`unknown aggregation policy "Most"`)))
+	})
+
+	It("should roll up the conditions of all operands", func() {
+		build(
+			newDatabase("ns1", "db1", ready(metav1.ConditionTrue, "")),
+			newDatabase("ns2", "db2", ready(metav1.ConditionFalse, "disk full")),
+			newDatabase("ns2", "db3", ready(metav1.ConditionFalse, "no quorum")),
+		)
+		m, err := InClusterFactory{Client: cl}.NewMirror([]MirrorRule{
+			{GVK: databaseGVK, SourceType: "Ready", TargetType: "DatabasesReady"},
+			{GVK: databaseGVK, SourceType: "Ready", TargetType: "AnyDatabaseReady", Policy: AnyOf},
+			{GVK: databaseGVK, SourceType: "Ready", TargetType: "DatabasesDegraded", Policy: AnyOf, Inverted: true},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.Sync(ctx)).To(Succeed())
+
+		c := getCondition("DatabasesReady")
+		Expect(c).NotTo(BeNil())
+		Expect(c.Status).To(Equal(metav1.ConditionFalse))
+		Expect(c.Reason).To(Equal("Reconciled"))
+		Expect(c.Message).To(Equal("Database ns2/db2: disk full\nDatabase ns2/db3: no quorum"))
+
+		c = getCondition("AnyDatabaseReady")
+		Expect(c).NotTo(BeNil())
+		Expect(c.Status).To(Equal(metav1.ConditionTrue))
+
+		c = getCondition("DatabasesDegraded")
+		Expect(c).NotTo(BeNil())
+		Expect(c.Status).To(Equal(metav1.ConditionTrue))
+		Expect(c.Message).To(ContainSubstring("disk full"))
+	})
+
+	It("should report operands without the condition as unknown", func() {
+		build(
+			newDatabase("ns1", "db1", ready(metav1.ConditionTrue, "")),
+			newDatabase("ns1", "db2"),
+		)
+		m, err := InClusterFactory{Client: cl}.NewMirror([]MirrorRule{{GVK: databaseGVK, SourceType: "Ready", TargetType: "DatabasesReady"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.Sync(ctx)).To(Succeed())
+
+		c := getCondition("DatabasesReady")
+		Expect(c).NotTo(BeNil())
+		Expect(c.Status).To(Equal(metav1.ConditionUnknown))
+		Expect(c.Reason).To(Equal(NoDataReason))
+		Expect(c.Message).To(Equal("Database ns1/db2: condition not found"))
+	})
+
+	It("should only consider operands matching the namespace and label selector", func() {
+		other := newDatabase("ns1", "db2", ready(metav1.ConditionFalse, "disk full"))
+		other.SetLabels(nil)
+		build(
+			newDatabase("ns1", "db1", ready(metav1.ConditionTrue, "")),
+			other,
+			newDatabase("ns2", "db3", ready(metav1.ConditionFalse, "no quorum")),
+		)
+		m, err := InClusterFactory{Client: cl}.NewMirror([]MirrorRule{{
+			GVK:           databaseGVK,
+			Namespace:     "ns1",
+			LabelSelector: labels.SelectorFromSet(labels.Set{"app": "db"}),
+			SourceType:    "Ready",
+			TargetType:    "DatabasesReady",
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.Sync(ctx)).To(Succeed())
+
+		c := getCondition("DatabasesReady")
+		Expect(c).NotTo(BeNil())
+		Expect(c.Status).To(Equal(metav1.ConditionTrue))
+		Expect(c.Reason).To(Equal(AsExpectedReason))
+	})
+
+	It("should summarize the messages of many operands", func() {
+		var objs []client.Object
+		for i := 0; i < 5; i++ {
+			objs = append(objs, newDatabase("ns1", fmt.Sprintf("db%d", i), ready(metav1.ConditionFalse, "disk full")))
+		}
+		build(objs...)
+		m, err := InClusterFactory{Client: cl}.NewMirror([]MirrorRule{{GVK: databaseGVK, SourceType: "Ready", TargetType: "DatabasesReady"}},
+			WithMirrorMaxMessages(2))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.Sync(ctx)).To(Succeed())
+
+		c := getCondition("DatabasesReady")
+		Expect(c).NotTo(BeNil())
+		Expect(strings.Split(c.Message, "\n")).To(Equal([]string{
+			"Database ns1/db0: disk full",
+			"Database ns1/db1: disk full",
+			"and 3 more",
+		}))
+	})
+
+	It("should only update the OperatorCondition when a condition changes", func() {
+		build(newDatabase("ns1", "db1", ready(metav1.ConditionTrue, "")))
+		m, err := InClusterFactory{Client: cl}.NewMirror([]MirrorRule{{GVK: databaseGVK, SourceType: "Ready", TargetType: "DatabasesReady"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.Sync(ctx)).To(Succeed())
+		Expect(m.Sync(ctx)).To(Succeed())
+		Expect(updates).To(Equal(1))
+	})
+
+	It("should sync the conditions periodically when started", func() {
+		build(newDatabase("ns1", "db1", ready(metav1.ConditionTrue, "")))
+		m, err := InClusterFactory{Client: cl}.NewMirror([]MirrorRule{{GVK: databaseGVK, SourceType: "Ready", TargetType: "DatabasesReady"}},
+			WithMirrorInterval(10*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.NeedLeaderElection()).To(BeTrue())
+
+		ctx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() { done <- m.Start(ctx) }()
+		Eventually(func() *metav1.Condition { return getCondition("DatabasesReady") }).ShouldNot(BeNil())
+
+		db := newDatabase("ns1", "db1")
+		Expect(cl.Get(ctx, client.ObjectKeyFromObject(db), db)).To(Succeed())
+		_, err = SetUnstructuredCondition(db, ready(metav1.ConditionFalse, "disk full"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cl.Update(ctx, db)).To(Succeed())
+		Eventually(func() metav1.ConditionStatus { return getCondition("DatabasesReady").Status }).Should(Equal(metav1.ConditionFalse))
+
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+})
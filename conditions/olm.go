@@ -0,0 +1,71 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"errors"
+	"fmt"
+
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// ErrOLMNotAvailable indicates that the OperatorCondition API is not served by the
+	// cluster, which means that OLM is not installed
+	ErrOLMNotAvailable = errors.New("operator Condition API is not available, OLM is not installed")
+
+	// ErrNotManagedByOLM indicates that the operator was not deployed by OLM, i.e. the name of
+	// its OperatorCondition is not set in its environment, whether or not OLM is installed
+	ErrNotManagedByOLM = errors.New("operator is not managed by OLM")
+)
+
+// operatorConditionGVK is the GroupVersionKind of the OperatorCondition API used by this package.
+var operatorConditionGVK = apiv2.GroupVersion.WithKind("OperatorCondition")
+
+// IsOLMAvailable checks whether the OperatorCondition API is served by the cluster, using
+// the discovery information of the client's RESTMapper. It returns false without an error
+// if OLM is not installed, and an error if availability could not be determined.
+func IsOLMAvailable(cl client.Client) (bool, error) {
+	_, err := cl.RESTMapper().RESTMapping(operatorConditionGVK.GroupKind(), operatorConditionGVK.Version)
+	if meta.IsNoMatchError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// IsOLMNotAvailable returns true if err indicates that OLM is not installed.
+func IsOLMNotAvailable(err error) bool {
+	return errors.Is(err, ErrOLMNotAvailable) || meta.IsNoMatchError(err)
+}
+
+// isNotManagedByOLM returns true if err indicates that OLM is not installed or did not deploy
+// the operator.
+func isNotManagedByOLM(err error) bool {
+	return errors.Is(err, ErrNotManagedByOLM) || IsOLMNotAvailable(err)
+}
+
+// wrapOLMError wraps errors caused by the OperatorCondition API not being served in
+// ErrOLMNotAvailable, and returns other errors unchanged.
+func wrapOLMError(err error) error {
+	if err != nil && !errors.Is(err, ErrOLMNotAvailable) && meta.IsNoMatchError(err) {
+		return fmt.Errorf("%w: %v", ErrOLMNotAvailable, err)
+	}
+	return err
+}
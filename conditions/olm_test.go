@@ -0,0 +1,100 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("OLM availability", func() {
+	var olmClient, noOLMClient client.Client
+
+	BeforeEach(func() {
+		sch := runtime.NewScheme()
+		Expect(apiv2.AddToScheme(sch)).To(Succeed())
+		mapper := meta.NewDefaultRESTMapper(nil)
+		mapper.Add(operatorConditionGVK, meta.RESTScopeNamespace)
+		olmClient = fake.NewClientBuilder().WithScheme(sch).WithRESTMapper(mapper).Build()
+		noOLMClient = fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+
+		Expect(os.Setenv(operatorCondEnvVar, "test-operator-condition")).To(Succeed())
+		readNamespace = func() (string, error) {
+			return "default", nil
+		}
+	})
+
+	Describe("IsOLMAvailable", func() {
+		It("should return true when the OperatorCondition API is served", func() {
+			ok, err := IsOLMAvailable(olmClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should return false when the OperatorCondition API is not served", func() {
+			ok, err := IsOLMAvailable(noOLMClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("Get/Set", func() {
+		It("should wrap discovery errors in ErrOLMNotAvailable", func() {
+			noMatch := &meta.NoKindMatchError{GroupKind: operatorConditionGVK.GroupKind(), SearchedVersions: []string{"v2"}}
+			cl := interceptor.NewClient(olmClient.(client.WithWatch), interceptor.Funcs{
+				Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+					return noMatch
+				},
+			})
+			c, err := InClusterFactory{Client: cl}.NewCondition(conditionFoo)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = c.Get(context.TODO())
+			Expect(err).To(MatchError(ErrOLMNotAvailable))
+			Expect(err).To(MatchError(ContainSubstring(noMatch.Error())))
+			Expect(IsOLMNotAvailable(err)).To(BeTrue())
+
+			err = c.Set(context.TODO(), metav1.ConditionTrue)
+			Expect(err).To(MatchError(ErrOLMNotAvailable))
+		})
+
+		It("should not wrap other errors", func() {
+			c, err := InClusterFactory{Client: olmClient}.NewCondition(conditionFoo)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = c.Get(context.TODO())
+			Expect(err).To(HaveOccurred())
+			Expect(IsOLMNotAvailable(err)).To(BeFalse())
+		})
+	})
+
+	Describe("IsOLMNotAvailable", func() {
+		It("should detect unwrapped discovery errors", func() {
+			Expect(IsOLMNotAvailable(&meta.NoKindMatchError{GroupKind: operatorConditionGVK.GroupKind()})).To(BeTrue())
+			Expect(IsOLMNotAvailable(fmt.Errorf("TEST"))).To(BeFalse())
+		})
+	})
+})
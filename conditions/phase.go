@@ -0,0 +1,82 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaxPhaseLength is the maximum length of the phases returned by Phase. Longer phases are
+// truncated, so that they fit in the printer columns of kubectl get.
+const MaxPhaseLength = 48
+
+// UnknownPhase is returned by Phase when no rule matches the conditions.
+const UnknownPhase = "Unknown"
+
+// PhaseRule maps a condition in a given status to a phase.
+type PhaseRule struct {
+	// ConditionType and Status identify the condition that the rule matches
+	ConditionType string
+	Status        metav1.ConditionStatus
+
+	// Phase is returned when the rule matches
+	Phase string
+
+	// WithReason appends the reason of the condition to the phase, ex. "Degraded: CertExpired"
+	WithReason bool
+}
+
+// DefaultPhaseRules are the rules used by Phase when none are given. Problems take precedence
+// over progress, which takes precedence over readiness.
+var DefaultPhaseRules = []PhaseRule{
+	{ConditionType: "Degraded", Status: metav1.ConditionTrue, Phase: "Degraded", WithReason: true},
+	{ConditionType: "Ready", Status: metav1.ConditionFalse, Phase: "NotReady", WithReason: true},
+	{ConditionType: "Available", Status: metav1.ConditionFalse, Phase: "Unavailable", WithReason: true},
+	{ConditionType: "Progressing", Status: metav1.ConditionTrue, Phase: "Progressing"},
+	{ConditionType: "Ready", Status: metav1.ConditionTrue, Phase: "Ready"},
+	{ConditionType: "Available", Status: metav1.ConditionTrue, Phase: "Ready"},
+}
+
+// Phase derives a short, stable phase from conditions, suitable for a .status.phase field shown
+// with additionalPrinterColumns. Rules are evaluated in order and the first matching rule determines
+// the phase, DefaultPhaseRules are used if no rules are given. UnknownPhase is returned if no rule
+// matches. Phases longer than MaxPhaseLength are truncated.
+func Phase(conditions []metav1.Condition, rules ...PhaseRule) string {
+	if len(rules) == 0 {
+		rules = DefaultPhaseRules
+	}
+
+	for _, rule := range rules {
+		c := meta.FindStatusCondition(conditions, rule.ConditionType)
+		if c == nil || c.Status != rule.Status {
+			continue
+		}
+		phase := rule.Phase
+		if rule.WithReason && c.Reason != "" {
+			phase += ": " + c.Reason
+		}
+		return truncatePhase(phase)
+	}
+	return UnknownPhase
+}
+
+func truncatePhase(phase string) string {
+	const ellipsis = "..."
+	if len(phase) <= MaxPhaseLength {
+		return phase
+	}
+	return phase[:MaxPhaseLength-len(ellipsis)] + ellipsis
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Phase", func() {
+	cond := func(conditionType string, status metav1.ConditionStatus, reason string) metav1.Condition {
+		return metav1.Condition{Type: conditionType, Status: status, Reason: reason}
+	}
+
+	It("should return Ready for ready resources", func() {
+		Expect(Phase([]metav1.Condition{cond("Ready", metav1.ConditionTrue, "AsExpected")})).To(Equal("Ready"))
+		Expect(Phase([]metav1.Condition{cond("Available", metav1.ConditionTrue, "AsExpected")})).To(Equal("Ready"))
+	})
+
+	It("should give precedence to problems and include their reason", func() {
+		conditions := []metav1.Condition{
+			cond("Ready", metav1.ConditionTrue, "AsExpected"),
+			cond("Progressing", metav1.ConditionTrue, "Upgrading"),
+			cond("Degraded", metav1.ConditionTrue, "CertExpired"),
+		}
+		Expect(Phase(conditions)).To(Equal("Degraded: CertExpired"))
+
+		conditions[2].Status = metav1.ConditionFalse
+		Expect(Phase(conditions)).To(Equal("Progressing"))
+	})
+
+	It("should return UnknownPhase if no rule matches", func() {
+		Expect(Phase(nil)).To(Equal(UnknownPhase))
+		Expect(Phase([]metav1.Condition{cond("Ready", metav1.ConditionUnknown, "")})).To(Equal(UnknownPhase))
+	})
+
+	It("should use custom rules", func() {
+		rules := []PhaseRule{{ConditionType: "Synced", Status: metav1.ConditionFalse, Phase: "OutOfSync", WithReason: true}}
+		Expect(Phase([]metav1.Condition{cond("Synced", metav1.ConditionFalse, "RemoteDown")}, rules...)).To(Equal("OutOfSync: RemoteDown"))
+		Expect(Phase([]metav1.Condition{cond("Ready", metav1.ConditionTrue, "")}, rules...)).To(Equal(UnknownPhase))
+	})
+
+	It("should truncate long phases", func() {
+		phase := Phase([]metav1.Condition{cond("Degraded", metav1.ConditionTrue, strings.Repeat("X", 100))})
+		Expect(phase).To(HaveLen(MaxPhaseLength))
+		Expect(phase).To(HavePrefix("Degraded: XXX"))
+		Expect(phase).To(HaveSuffix("..."))
+	})
+})
@@ -0,0 +1,173 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testing provides a hermetic OperatorCondition fixture for the tests of operators
+// using the conditions package.
+//
+// A Fixture serves OperatorConditions from an in-memory fake client and provides a conditions
+// factory pointed at an OperatorCondition in it, as OLM does for the operators it manages, so
+// that tests need neither a cluster nor OLM manifests downloaded at test time:
+//
+//	fixture, err := conditionstesting.NewFixture("my-operator.v1.0.0", "operators")
+//	...
+//	cond, err := fixture.Factory().NewCondition(apiv2.ConditionType(apiv2.Upgradeable))
+//
+// Fixtures do not change the environment of the process, so they can be used by parallel tests.
+//
+// Tests running against a real API server, ex. with envtest, can install the embedded
+// OperatorCondition CustomResourceDefinition returned by CRD instead.
+package testing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/operator-framework/api/crds"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/operator-framework/operator-lib/conditions"
+)
+
+// CRD returns the CustomResourceDefinition of the OperatorCondition API, embedded in the
+// operator-framework/api module, ex. for the CRDs of an envtest.Environment.
+func CRD() *apiextensionsv1.CustomResourceDefinition {
+	return crds.OperatorCondition()
+}
+
+// NewScheme returns a scheme with the Kubernetes built-in types and the OperatorCondition API.
+func NewScheme() (*runtime.Scheme, error) {
+	sch := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(sch); err != nil {
+		return nil, err
+	}
+	if err := apiv2.AddToScheme(sch); err != nil {
+		return nil, err
+	}
+	return sch, nil
+}
+
+// Fixture is an in-memory OperatorCondition of the operator under test.
+type Fixture struct {
+	// Client is a fake client serving the OperatorCondition and the objects passed to
+	// NewFixture. It is meant to be passed to the conditions package. Its RESTMapper
+	// reports the OperatorCondition API as available, see conditions.IsOLMAvailable.
+	Client client.WithWatch
+	// Key identifies the OperatorCondition.
+	Key types.NamespacedName
+}
+
+// NewFixture creates an OperatorCondition with the given name and namespace in a fake client,
+// along with objs.
+func NewFixture(name, namespace string, objs ...client.Object) (*Fixture, error) {
+	sch, err := NewScheme()
+	if err != nil {
+		return nil, fmt.Errorf("error creating scheme: %w", err)
+	}
+	operatorCond := &apiv2.OperatorCondition{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	c := fake.NewClientBuilder().
+		WithScheme(sch).
+		WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(sch)).
+		WithObjects(append([]client.Object{operatorCond}, objs...)...).
+		WithStatusSubresource(operatorCond).
+		Build()
+
+	return &Fixture{
+		Client: c,
+		Key:    types.NamespacedName{Name: name, Namespace: namespace},
+	}, nil
+}
+
+// Factory returns a conditions factory using Client and pointed at the OperatorCondition of the
+// fixture, for the code under test.
+func (f *Fixture) Factory() conditions.InClusterFactory {
+	return conditions.NewInClusterFactory(f.Client,
+		conditions.WithOperatorConditionName(f.Key.Name),
+		conditions.WithOperatorConditionNamespace(f.Key.Namespace),
+	)
+}
+
+// Get returns the current state of the OperatorCondition.
+func (f *Fixture) Get(ctx context.Context) (*apiv2.OperatorCondition, error) {
+	operatorCond := &apiv2.OperatorCondition{}
+	if err := f.Client.Get(ctx, f.Key, operatorCond); err != nil {
+		return nil, err
+	}
+	return operatorCond, nil
+}
+
+// Condition returns the condition of the given type written by the operator in spec.conditions,
+// or nil if there is none.
+func (f *Fixture) Condition(ctx context.Context, condType string) (*metav1.Condition, error) {
+	operatorCond, err := f.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return meta.FindStatusCondition(operatorCond.Spec.Conditions, condType), nil
+}
+
+// SetOverride sets a condition in spec.overrides, as a cluster administrator overriding the
+// state written by the operator would.
+func (f *Fixture) SetOverride(ctx context.Context, cond metav1.Condition) error {
+	return f.update(ctx, func(operatorCond *apiv2.OperatorCondition) error {
+		meta.SetStatusCondition(&operatorCond.Spec.Overrides, cond)
+		return f.Client.Update(ctx, operatorCond)
+	})
+}
+
+// RemoveOverride removes the condition of the given type from spec.overrides.
+func (f *Fixture) RemoveOverride(ctx context.Context, condType string) error {
+	return f.update(ctx, func(operatorCond *apiv2.OperatorCondition) error {
+		meta.RemoveStatusCondition(&operatorCond.Spec.Overrides, condType)
+		return f.Client.Update(ctx, operatorCond)
+	})
+}
+
+// Sync copies the effective conditions into status.conditions, as OLM does: conditions in
+// spec.overrides take precedence over those in spec.conditions.
+func (f *Fixture) Sync(ctx context.Context) error {
+	return f.update(ctx, func(operatorCond *apiv2.OperatorCondition) error {
+		var observed []metav1.Condition
+		for _, c := range operatorCond.Spec.Conditions {
+			if override := meta.FindStatusCondition(operatorCond.Spec.Overrides, c.Type); override != nil {
+				c = *override
+			}
+			meta.SetStatusCondition(&observed, c)
+		}
+		for _, c := range operatorCond.Spec.Overrides {
+			meta.SetStatusCondition(&observed, c)
+		}
+		operatorCond.Status.Conditions = observed
+		return f.Client.Status().Update(ctx, operatorCond)
+	})
+}
+
+func (f *Fixture) update(ctx context.Context, fn func(*apiv2.OperatorCondition) error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		operatorCond, err := f.Get(ctx)
+		if err != nil {
+			return err
+		}
+		return fn(operatorCond)
+	})
+}
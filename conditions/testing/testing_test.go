@@ -0,0 +1,97 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/operator-framework/operator-lib/conditions"
+)
+
+var _ = Describe("Fixture", func() {
+	var (
+		ctx     context.Context
+		fixture *Fixture
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		fixture, err = NewFixture("operator.v1.0.0", "operators")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should point the conditions package at the OperatorCondition", func() {
+		key, err := fixture.Factory().GetNamespacedName()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*key).To(Equal(types.NamespacedName{Name: "operator.v1.0.0", Namespace: "operators"}))
+
+		available, err := conditions.IsOLMAvailable(fixture.Client)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(available).To(BeTrue())
+	})
+
+	It("should record the conditions set by the operator", func() {
+		cond, err := fixture.Factory().NewCondition(apiv2.ConditionType(apiv2.Upgradeable))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cond.Set(ctx, metav1.ConditionFalse, conditions.WithReason("Migrating"))).To(Succeed())
+
+		c, err := fixture.Condition(ctx, apiv2.Upgradeable)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c).NotTo(BeNil())
+		Expect(c.Status).To(Equal(metav1.ConditionFalse))
+		Expect(c.Reason).To(Equal("Migrating"))
+	})
+
+	It("should simulate overrides and their resolution by OLM", func() {
+		f := fixture.Factory()
+		cond, err := f.NewCondition(apiv2.ConditionType(apiv2.Upgradeable))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cond.Set(ctx, metav1.ConditionFalse, conditions.WithReason("Migrating"))).To(Succeed())
+		Expect(fixture.SetOverride(ctx, metav1.Condition{Type: apiv2.Upgradeable, Status: metav1.ConditionTrue, Reason: "Admin"})).To(Succeed())
+		Expect(fixture.Sync(ctx)).To(Succeed())
+
+		effective, err := f.EffectiveStatus(ctx, apiv2.ConditionType(apiv2.Upgradeable))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(effective.Origin).To(Equal(conditions.OriginOverride))
+		Expect(effective.Observed).NotTo(BeNil())
+		Expect(effective.Observed.Status).To(Equal(metav1.ConditionTrue))
+
+		Expect(fixture.RemoveOverride(ctx, apiv2.Upgradeable)).To(Succeed())
+		Expect(fixture.Sync(ctx)).To(Succeed())
+		effective, err = f.EffectiveStatus(ctx, apiv2.ConditionType(apiv2.Upgradeable))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(effective.Origin).To(Equal(conditions.OriginOperator))
+		Expect(effective.Observed.Status).To(Equal(metav1.ConditionFalse))
+	})
+
+	It("should not change the environment of the process", func() {
+		_, err := conditions.InClusterFactory{Client: fixture.Client}.GetNamespacedName()
+		Expect(err).To(MatchError(conditions.ErrNotManagedByOLM))
+	})
+
+	It("should provide the OperatorCondition CRD", func() {
+		crd := CRD()
+		Expect(crd.Spec.Group).To(Equal(apiv2.GroupVersion.Group))
+		Expect(crd.Spec.Names.Kind).To(Equal("OperatorCondition"))
+	})
+})
@@ -0,0 +1,167 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The helpers below read and modify the .status.conditions array of unstructured objects, for
+// operators managing custom resources without Go types. They follow the semantics of
+// meta.SetStatusCondition, and preserve fields of the conditions that metav1.Condition does not
+// define, such as lastProbeTime.
+
+// UnstructuredConditions returns the .status.conditions of u.
+func UnstructuredConditions(u *unstructured.Unstructured) ([]metav1.Condition, error) {
+	entries, _, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := make([]metav1.Condition, 0, len(entries))
+	for _, entry := range entries {
+		m, ok := entry.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("status condition of %s is a %T, not an object", u.GetName(), entry)
+		}
+		c := metav1.Condition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &c); err != nil {
+			return nil, fmt.Errorf("error decoding status condition of %s: %w", u.GetName(), err)
+		}
+		conditions = append(conditions, c)
+	}
+	return conditions, nil
+}
+
+// FindUnstructuredCondition returns the condition of u with the given type, or nil if it is not set.
+func FindUnstructuredCondition(u *unstructured.Unstructured, conditionType string) (*metav1.Condition, error) {
+	conditions, err := UnstructuredConditions(u)
+	if err != nil {
+		return nil, err
+	}
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// SetUnstructuredCondition sets newCondition in the .status.conditions of u and returns true if
+// u was changed. Like meta.SetStatusCondition, the LastTransitionTime is only updated when the
+// status changes, and defaults to now if newCondition does not set it.
+func SetUnstructuredCondition(u *unstructured.Unstructured, newCondition metav1.Condition) (bool, error) {
+	entries, _, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil {
+		return false, err
+	}
+
+	if newCondition.LastTransitionTime.IsZero() {
+		newCondition.LastTransitionTime = metav1.Now()
+	}
+	desired, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&newCondition)
+	if err != nil {
+		return false, err
+	}
+
+	i := indexOfCondition(entries, newCondition.Type)
+	if i < 0 {
+		entries = append(entries, desired)
+		return true, unstructured.SetNestedSlice(u.Object, entries, "status", "conditions")
+	}
+
+	existing, ok := entries[i].(map[string]interface{})
+	if !ok {
+		return false, fmt.Errorf("status condition of %s is a %T, not an object", u.GetName(), entries[i])
+	}
+	changed := false
+	fields := []string{"reason", "message", "observedGeneration"}
+	if existing["status"] != desired["status"] {
+		fields = append(fields, "status", "lastTransitionTime")
+	}
+	for _, field := range fields {
+		value, ok := desired[field]
+		if existing[field] == value {
+			continue
+		}
+		if ok {
+			existing[field] = value
+		} else {
+			delete(existing, field)
+		}
+		changed = true
+	}
+	if !changed {
+		return false, nil
+	}
+	entries[i] = existing
+	return true, unstructured.SetNestedSlice(u.Object, entries, "status", "conditions")
+}
+
+// RemoveUnstructuredCondition removes the condition with the given type from the
+// .status.conditions of u and returns true if it was present.
+func RemoveUnstructuredCondition(u *unstructured.Unstructured, conditionType string) (bool, error) {
+	entries, found, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil || !found {
+		return false, err
+	}
+
+	i := indexOfCondition(entries, conditionType)
+	if i < 0 {
+		return false, nil
+	}
+	entries = append(entries[:i], entries[i+1:]...)
+	return true, unstructured.SetNestedSlice(u.Object, entries, "status", "conditions")
+}
+
+// UnstructuredConditionsPatch returns a merge patch that only sets the .status.conditions of u,
+// to be applied to the status subresource with client.Status().Patch. The resourceVersion of u
+// is included in the patch, so that it fails with a conflict if the object was modified since
+// it was read, instead of overwriting conditions set concurrently.
+func UnstructuredConditionsPatch(u *unstructured.Unstructured) (client.Patch, error) {
+	entries, _, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil {
+		return nil, err
+	}
+
+	patch := map[string]interface{}{
+		"status": map[string]interface{}{"conditions": entries},
+	}
+	if rv := u.GetResourceVersion(); rv != "" {
+		patch["metadata"] = map[string]interface{}{"resourceVersion": rv}
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	return client.RawPatch(types.MergePatchType, data), nil
+}
+
+// indexOfCondition returns the index of the condition with the given type in entries, or -1.
+func indexOfCondition(entries []interface{}, conditionType string) int {
+	for i, entry := range entries {
+		if m, ok := entry.(map[string]interface{}); ok && m["type"] == conditionType {
+			return i
+		}
+	}
+	return -1
+}
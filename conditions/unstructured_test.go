@@ -0,0 +1,138 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Unstructured conditions", func() {
+	var u *unstructured.Unstructured
+	transitionTime := metav1.NewTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	BeforeEach(func() {
+		u = &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata": map[string]interface{}{
+				"name":            "widget",
+				"resourceVersion": "42",
+			},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{
+						"type":               "Ready",
+						"status":             "False",
+						"reason":             "Starting",
+						"message":            "",
+						"lastTransitionTime": "2021-01-01T00:00:00Z",
+						"lastProbeTime":      "2021-01-01T00:00:00Z",
+					},
+				},
+			},
+		}}
+	})
+
+	It("should find conditions", func() {
+		c, err := FindUnstructuredCondition(u, "Ready")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c).NotTo(BeNil())
+		Expect(c.Status).To(Equal(metav1.ConditionFalse))
+		Expect(c.LastTransitionTime.Equal(&transitionTime)).To(BeTrue())
+
+		c, err = FindUnstructuredCondition(u, "Degraded")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c).To(BeNil())
+	})
+
+	It("should add missing conditions", func() {
+		changed, err := SetUnstructuredCondition(u, metav1.Condition{Type: "Degraded", Status: metav1.ConditionFalse, Reason: "AsExpected"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		conditions, err := UnstructuredConditions(u)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conditions).To(HaveLen(2))
+		Expect(conditions[1].Type).To(Equal("Degraded"))
+		Expect(conditions[1].LastTransitionTime.IsZero()).To(BeFalse())
+	})
+
+	It("should only update the transition time when the status changes", func() {
+		changed, err := SetUnstructuredCondition(u, metav1.Condition{Type: "Ready", Status: metav1.ConditionFalse, Reason: "Waiting", ObservedGeneration: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		c, _ := FindUnstructuredCondition(u, "Ready")
+		Expect(c.Reason).To(Equal("Waiting"))
+		Expect(c.ObservedGeneration).To(Equal(int64(2)))
+		Expect(c.LastTransitionTime.Equal(&transitionTime)).To(BeTrue())
+
+		changed, err = SetUnstructuredCondition(u, metav1.Condition{Type: "Ready", Status: metav1.ConditionFalse, Reason: "Waiting", ObservedGeneration: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+
+		changed, err = SetUnstructuredCondition(u, metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Running"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		c, _ = FindUnstructuredCondition(u, "Ready")
+		Expect(c.Status).To(Equal(metav1.ConditionTrue))
+		Expect(c.ObservedGeneration).To(BeZero())
+		Expect(c.LastTransitionTime.After(transitionTime.Time)).To(BeTrue())
+	})
+
+	It("should preserve unknown condition fields", func() {
+		_, err := SetUnstructuredCondition(u, metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Running"})
+		Expect(err).NotTo(HaveOccurred())
+		entries, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+		Expect(entries[0]).To(HaveKeyWithValue("lastProbeTime", "2021-01-01T00:00:00Z"))
+	})
+
+	It("should remove conditions", func() {
+		removed, err := RemoveUnstructuredCondition(u, "Ready")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed).To(BeTrue())
+		conditions, err := UnstructuredConditions(u)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conditions).To(BeEmpty())
+
+		removed, err = RemoveUnstructuredCondition(u, "Ready")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed).To(BeFalse())
+	})
+
+	It("should generate a patch of the conditions only", func() {
+		patch, err := UnstructuredConditionsPatch(u)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patch.Type()).To(Equal(types.MergePatchType))
+		data, err := patch.Data(u)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{
+			"metadata": {"resourceVersion": "42"},
+			"status": {"conditions": [{
+				"type": "Ready",
+				"status": "False",
+				"reason": "Starting",
+				"message": "",
+				"lastTransitionTime": "2021-01-01T00:00:00Z",
+				"lastProbeTime": "2021-01-01T00:00:00Z"
+			}]}
+		}`))
+	})
+})
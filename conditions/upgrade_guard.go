@@ -0,0 +1,260 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// UpgradeGuardExpiryAnnotation is set on the OperatorCondition while an UpgradeGuard is held.
+	// Its value is an RFC3339 timestamp after which the guard is considered abandoned, for example
+	// because the operator crashed before releasing it.
+	UpgradeGuardExpiryAnnotation = "operator-lib.operatorframework.io/upgrade-guard-expiry"
+
+	// UpgradeGuardReleasedReason is the reason set on the Upgradeable condition when the last
+	// holder of an UpgradeGuard releases it.
+	UpgradeGuardReleasedReason = "UpgradeGuardReleased"
+	// UpgradeGuardExpiredReason is the reason set on the Upgradeable condition when an abandoned
+	// UpgradeGuard is released after its expiry.
+	UpgradeGuardExpiredReason = "UpgradeGuardExpired"
+
+	// DefaultUpgradeGuardLeaseDuration is the default amount of time an UpgradeGuard is held
+	// before being considered abandoned, unless it is renewed.
+	DefaultUpgradeGuardLeaseDuration = 10 * time.Minute
+)
+
+var (
+	// ErrUpgradeGuardNotHeld indicates that Release or Renew was called on an UpgradeGuard
+	// that is not currently acquired.
+	ErrUpgradeGuardNotHeld = fmt.Errorf("upgrade guard is not held")
+)
+
+// UpgradeGuard blocks OLM upgrades of the operator while disruptive work, such as a
+// reconcile or a data migration, is in progress. It reference-counts its holders: the first
+// Acquire sets the Upgradeable condition to False and the last Release sets it back to True.
+//
+// While held, the guard records an expiry in the UpgradeGuardExpiryAnnotation annotation of the
+// OperatorCondition. Holders of long-running operations should call Renew before the lease
+// expires. If the operator exits without releasing the guard, ReleaseExpired, typically called at
+// startup, restores Upgradeable=True once the lease has expired.
+type UpgradeGuard struct {
+	client         client.Client
+	namespacedName types.NamespacedName
+	leaseDuration  time.Duration
+	clock          clock.PassiveClock
+
+	mu      sync.Mutex
+	holders int
+}
+
+// UpgradeGuardOption configures an UpgradeGuard.
+type UpgradeGuardOption func(*UpgradeGuard)
+
+// WithLeaseDuration sets the amount of time an UpgradeGuard is held before being considered
+// abandoned. It defaults to DefaultUpgradeGuardLeaseDuration.
+func WithLeaseDuration(d time.Duration) UpgradeGuardOption {
+	return func(g *UpgradeGuard) {
+		g.leaseDuration = d
+	}
+}
+
+// WithUpgradeGuardClock sets the clock used to compute lease expiries.
+func WithUpgradeGuardClock(c clock.PassiveClock) UpgradeGuardOption {
+	return func(g *UpgradeGuard) {
+		g.clock = c
+	}
+}
+
+// NewUpgradeGuard creates an UpgradeGuard for the operator's OperatorCondition. The
+// OperatorCondition's name and namespace are determined by the Factory's GetNamespacedName.
+func (f InClusterFactory) NewUpgradeGuard(opts ...UpgradeGuardOption) (*UpgradeGuard, error) {
+	objKey, err := f.GetNamespacedName()
+	if err != nil {
+		return nil, err
+	}
+
+	g := &UpgradeGuard{
+		client:         f.Client,
+		namespacedName: *objKey,
+		leaseDuration:  DefaultUpgradeGuardLeaseDuration,
+		clock:          clock.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g, nil
+}
+
+// Acquire takes a reference on the guard. If the guard was not held, the Upgradeable condition
+// is set to False with the provided reason, which must be a valid condition reason. The guard is
+// not acquired if the reason is invalid or the update fails.
+func (g *UpgradeGuard) Acquire(ctx context.Context, reason string) error {
+	if !reasonPattern.MatchString(reason) {
+		return fmt.Errorf("error acquiring upgrade guard: invalid reason %q", reason)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.holders == 0 {
+		cond := metav1.Condition{
+			Type:    apiv2.Upgradeable,
+			Status:  metav1.ConditionFalse,
+			Reason:  reason,
+			Message: "The operator is performing an operation that must complete before it can be upgraded",
+		}
+		if err := g.update(ctx, &cond, g.expiry()); err != nil {
+			return err
+		}
+	}
+	g.holders++
+	return nil
+}
+
+// Release drops a reference on the guard. When the last reference is dropped, the Upgradeable
+// condition is set back to True. It returns ErrUpgradeGuardNotHeld if the guard is not held.
+func (g *UpgradeGuard) Release(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.holders == 0 {
+		return ErrUpgradeGuardNotHeld
+	}
+	if g.holders == 1 {
+		cond := metav1.Condition{
+			Type:   apiv2.Upgradeable,
+			Status: metav1.ConditionTrue,
+			Reason: UpgradeGuardReleasedReason,
+		}
+		if err := g.update(ctx, &cond, ""); err != nil {
+			return err
+		}
+	}
+	g.holders--
+	return nil
+}
+
+// Renew extends the expiry of a held guard by its lease duration. It returns
+// ErrUpgradeGuardNotHeld if the guard is not held.
+func (g *UpgradeGuard) Renew(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.holders == 0 {
+		return ErrUpgradeGuardNotHeld
+	}
+	return g.update(ctx, nil, g.expiry())
+}
+
+// Held returns true if the guard currently has at least one holder in this process.
+func (g *UpgradeGuard) Held() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.holders > 0
+}
+
+// ReleaseExpired sets the Upgradeable condition back to True if the OperatorCondition carries an
+// expired UpgradeGuardExpiryAnnotation and the guard is not held by this process. It returns
+// true if an expired guard was released. Operators should call it at startup to recover from
+// a crash that happened while the guard was held.
+func (g *UpgradeGuard) ReleaseExpired(ctx context.Context) (bool, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.holders > 0 {
+		return false, nil
+	}
+
+	released := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		released = false
+		operatorCond := &apiv2.OperatorCondition{}
+		if err := g.client.Get(ctx, g.namespacedName, operatorCond); err != nil {
+			return wrapOLMError(err)
+		}
+
+		expiryString, ok := operatorCond.GetAnnotations()[UpgradeGuardExpiryAnnotation]
+		if !ok {
+			return nil
+		}
+		expiry, err := time.Parse(time.RFC3339, expiryString)
+		if err == nil && g.clock.Now().Before(expiry) {
+			return nil
+		}
+
+		meta.SetStatusCondition(&operatorCond.Spec.Conditions, metav1.Condition{
+			Type:   apiv2.Upgradeable,
+			Status: metav1.ConditionTrue,
+			Reason: UpgradeGuardExpiredReason,
+		})
+		annotations := operatorCond.GetAnnotations()
+		delete(annotations, UpgradeGuardExpiryAnnotation)
+		operatorCond.SetAnnotations(annotations)
+
+		if err := g.client.Update(ctx, operatorCond); err != nil {
+			recordWriteError(err)
+			return err
+		}
+		released = true
+		return nil
+	})
+	return released, err
+}
+
+func (g *UpgradeGuard) expiry() string {
+	return g.clock.Now().Add(g.leaseDuration).UTC().Format(time.RFC3339)
+}
+
+// update sets cond, if not nil, on the OperatorCondition, and sets the expiry annotation to
+// expiry, or removes it if expiry is empty. Conflicts are retried.
+func (g *UpgradeGuard) update(ctx context.Context, cond *metav1.Condition, expiry string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		operatorCond := &apiv2.OperatorCondition{}
+		if err := g.client.Get(ctx, g.namespacedName, operatorCond); err != nil {
+			return wrapOLMError(err)
+		}
+
+		if cond != nil {
+			meta.SetStatusCondition(&operatorCond.Spec.Conditions, *cond)
+		}
+
+		annotations := operatorCond.GetAnnotations()
+		if expiry == "" {
+			delete(annotations, UpgradeGuardExpiryAnnotation)
+		} else {
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[UpgradeGuardExpiryAnnotation] = expiry
+		}
+		operatorCond.SetAnnotations(annotations)
+
+		err := g.client.Update(ctx, operatorCond)
+		recordWriteError(err)
+		return err
+	})
+}
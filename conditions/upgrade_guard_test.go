@@ -0,0 +1,170 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("UpgradeGuard", func() {
+	ctx := context.TODO()
+	objKey := types.NamespacedName{Name: "operator-condition-test", Namespace: "default"}
+
+	var (
+		cl    client.Client
+		clock *clocktesting.FakePassiveClock
+		guard *UpgradeGuard
+	)
+
+	getOperatorCondition := func() *apiv2.OperatorCondition {
+		op := &apiv2.OperatorCondition{}
+		Expect(cl.Get(ctx, objKey, op)).To(Succeed())
+		return op
+	}
+
+	BeforeEach(func() {
+		Expect(os.Setenv(operatorCondEnvVar, objKey.Name)).To(Succeed())
+		readNamespace = func() (string, error) {
+			return objKey.Namespace, nil
+		}
+
+		sch := runtime.NewScheme()
+		Expect(apiv2.AddToScheme(sch)).To(Succeed())
+		cl = fake.NewClientBuilder().WithScheme(sch).WithObjects(&apiv2.OperatorCondition{
+			ObjectMeta: metav1.ObjectMeta{Name: objKey.Name, Namespace: objKey.Namespace},
+		}).Build()
+
+		clock = clocktesting.NewFakePassiveClock(time.Now())
+
+		var err error
+		guard, err = InClusterFactory{Client: cl}.NewUpgradeGuard(WithLeaseDuration(time.Minute), WithUpgradeGuardClock(clock))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should error when the namespacedName cannot be found", func() {
+		Expect(os.Unsetenv(operatorCondEnvVar)).To(Succeed())
+		g, err := InClusterFactory{Client: cl}.NewUpgradeGuard()
+		Expect(err).To(HaveOccurred())
+		Expect(g).To(BeNil())
+	})
+
+	It("should block upgrades until the last holder releases the guard", func() {
+		By("acquiring the guard twice")
+		Expect(guard.Acquire(ctx, "MigrationInProgress")).To(Succeed())
+		Expect(guard.Acquire(ctx, "ReconcileInProgress")).To(Succeed())
+		Expect(guard.Held()).To(BeTrue())
+
+		op := getOperatorCondition()
+		cond := meta.FindStatusCondition(op.Spec.Conditions, apiv2.Upgradeable)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal("MigrationInProgress"))
+		Expect(op.GetAnnotations()).To(HaveKey(UpgradeGuardExpiryAnnotation))
+
+		By("releasing the guard once")
+		Expect(guard.Release(ctx)).To(Succeed())
+		op = getOperatorCondition()
+		Expect(meta.IsStatusConditionFalse(op.Spec.Conditions, apiv2.Upgradeable)).To(BeTrue())
+
+		By("releasing the guard a second time")
+		Expect(guard.Release(ctx)).To(Succeed())
+		Expect(guard.Held()).To(BeFalse())
+		op = getOperatorCondition()
+		cond = meta.FindStatusCondition(op.Spec.Conditions, apiv2.Upgradeable)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(UpgradeGuardReleasedReason))
+		Expect(op.GetAnnotations()).NotTo(HaveKey(UpgradeGuardExpiryAnnotation))
+	})
+
+	It("should error when releasing or renewing a guard that is not held", func() {
+		Expect(guard.Release(ctx)).To(MatchError(ErrUpgradeGuardNotHeld))
+		Expect(guard.Renew(ctx)).To(MatchError(ErrUpgradeGuardNotHeld))
+	})
+
+	It("should extend the expiry when renewed", func() {
+		Expect(guard.Acquire(ctx, "MigrationInProgress")).To(Succeed())
+		before := getOperatorCondition().GetAnnotations()[UpgradeGuardExpiryAnnotation]
+
+		clock.SetTime(clock.Now().Add(30 * time.Second))
+		Expect(guard.Renew(ctx)).To(Succeed())
+		after := getOperatorCondition().GetAnnotations()[UpgradeGuardExpiryAnnotation]
+		Expect(after).NotTo(Equal(before))
+	})
+
+	It("should not acquire the guard with an invalid reason", func() {
+		Expect(guard.Acquire(ctx, "")).To(MatchError(ContainSubstring("invalid reason")))
+		Expect(guard.Acquire(ctx, "not a reason")).To(MatchError(ContainSubstring("invalid reason")))
+		Expect(guard.Held()).To(BeFalse())
+		Expect(meta.FindStatusCondition(getOperatorCondition().Spec.Conditions, apiv2.Upgradeable)).To(BeNil())
+	})
+
+	It("should not acquire the guard when the OperatorCondition does not exist", func() {
+		Expect(os.Setenv(operatorCondEnvVar, "NON_EXISTING_COND")).To(Succeed())
+		g, err := InClusterFactory{Client: cl}.NewUpgradeGuard()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(g.Acquire(ctx, "MigrationInProgress")).NotTo(Succeed())
+		Expect(g.Held()).To(BeFalse())
+	})
+
+	Describe("ReleaseExpired", func() {
+		BeforeEach(func() {
+			By("simulating a guard left behind by a crashed operator")
+			crashed, err := InClusterFactory{Client: cl}.NewUpgradeGuard(WithLeaseDuration(time.Minute), WithUpgradeGuardClock(clock))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crashed.Acquire(ctx, "MigrationInProgress")).To(Succeed())
+		})
+
+		It("should not release a guard that has not expired", func() {
+			released, err := guard.ReleaseExpired(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(released).To(BeFalse())
+			Expect(meta.IsStatusConditionFalse(getOperatorCondition().Spec.Conditions, apiv2.Upgradeable)).To(BeTrue())
+		})
+
+		It("should release a guard that has expired", func() {
+			clock.SetTime(clock.Now().Add(2 * time.Minute))
+			released, err := guard.ReleaseExpired(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(released).To(BeTrue())
+
+			op := getOperatorCondition()
+			cond := meta.FindStatusCondition(op.Spec.Conditions, apiv2.Upgradeable)
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(UpgradeGuardExpiredReason))
+			Expect(op.GetAnnotations()).NotTo(HaveKey(UpgradeGuardExpiryAnnotation))
+		})
+
+		It("should not release a guard held by this process", func() {
+			Expect(guard.Acquire(ctx, "ReconcileInProgress")).To(Succeed())
+			clock.SetTime(clock.Now().Add(2 * time.Minute))
+			released, err := guard.ReleaseExpired(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(released).To(BeFalse())
+		})
+	})
+})
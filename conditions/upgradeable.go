@@ -0,0 +1,60 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrUpgradeInProgress is returned by IfUpgradeAllowed when an upgrade of the operator may be
+// in progress, because the Upgradeable condition was overridden to True.
+var ErrUpgradeInProgress = errors.New("operator upgrade in progress")
+
+// IfUpgradeAllowed runs fn, typically disruptive logic such as a data migration, unless an
+// upgrade of the operator may be in progress, in which case an error wrapping
+// ErrUpgradeInProgress is returned without running fn.
+//
+// An upgrade may be in progress when the effective Upgradeable condition of the operator's
+// OperatorCondition comes from an override set to True, see Effective: OLM then proceeds with
+// pending upgrades whatever the operator reports. If the operator is not managed by OLM, i.e. the
+// OperatorCondition API is not served or the name of its OperatorCondition is not set, fn is
+// run. Other errors getting the OperatorCondition are returned without running fn. The
+// OperatorCondition's name and namespace are determined by InClusterFactory.GetNamespacedName.
+func IfUpgradeAllowed(ctx context.Context, cl client.Client, fn func(context.Context) error) error {
+	return InClusterFactory{Client: cl}.IfUpgradeAllowed(ctx, fn)
+}
+
+// IfUpgradeAllowed runs fn unless an upgrade of the operator may be in progress, see the
+// IfUpgradeAllowed function.
+func (f InClusterFactory) IfUpgradeAllowed(ctx context.Context, fn func(context.Context) error) error {
+	operatorCond, err := f.getOperatorCondition(ctx)
+	if isNotManagedByOLM(err) {
+		return fn(ctx)
+	} else if err != nil {
+		return err
+	}
+
+	e := Effective(operatorCond, apiv2.Upgradeable)
+	if e != nil && e.Origin == OriginOverride && e.Status == metav1.ConditionTrue {
+		return fmt.Errorf("%w: %s overridden to %s: %s", ErrUpgradeInProgress, e.Type, e.Status, e.Message)
+	}
+	return fn(ctx)
+}
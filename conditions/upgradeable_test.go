@@ -0,0 +1,126 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("IfUpgradeAllowed", func() {
+	ctx := context.TODO()
+	objKey := types.NamespacedName{Name: "operator-condition-test", Namespace: "default"}
+
+	var (
+		operatorCond *apiv2.OperatorCondition
+		called       bool
+		fn           func(context.Context) error
+	)
+
+	newClient := func() client.WithWatch {
+		sch := runtime.NewScheme()
+		Expect(apiv2.AddToScheme(sch)).To(Succeed())
+		return fake.NewClientBuilder().WithScheme(sch).WithObjects(operatorCond).Build()
+	}
+
+	BeforeEach(func() {
+		Expect(os.Setenv(operatorCondEnvVar, objKey.Name)).To(Succeed())
+		readNamespace = func() (string, error) {
+			return objKey.Namespace, nil
+		}
+
+		operatorCond = &apiv2.OperatorCondition{
+			ObjectMeta: metav1.ObjectMeta{Name: objKey.Name, Namespace: objKey.Namespace},
+			Spec: apiv2.OperatorConditionSpec{
+				Conditions: []metav1.Condition{{Type: apiv2.Upgradeable, Status: metav1.ConditionFalse, Reason: "Migrating"}},
+			},
+		}
+		called = false
+		fn = func(context.Context) error {
+			called = true
+			return nil
+		}
+	})
+
+	It("should run fn when Upgradeable is not overridden", func() {
+		Expect(IfUpgradeAllowed(ctx, newClient(), fn)).To(Succeed())
+		Expect(called).To(BeTrue())
+	})
+
+	It("should run fn when the Upgradeable condition does not exist", func() {
+		operatorCond.Spec.Conditions = nil
+		Expect(IfUpgradeAllowed(ctx, newClient(), fn)).To(Succeed())
+		Expect(called).To(BeTrue())
+	})
+
+	It("should run fn when Upgradeable is overridden to False", func() {
+		operatorCond.Spec.Overrides = []metav1.Condition{{Type: apiv2.Upgradeable, Status: metav1.ConditionFalse, Reason: "Override"}}
+		Expect(IfUpgradeAllowed(ctx, newClient(), fn)).To(Succeed())
+		Expect(called).To(BeTrue())
+	})
+
+	It("should return ErrUpgradeInProgress when Upgradeable is overridden to True", func() {
+		operatorCond.Spec.Overrides = []metav1.Condition{{
+			Type: apiv2.Upgradeable, Status: metav1.ConditionTrue, Reason: "Override", Message: "forced upgrade",
+		}}
+		err := IfUpgradeAllowed(ctx, newClient(), fn)
+		Expect(err).To(MatchError(ErrUpgradeInProgress))
+		Expect(err).To(MatchError(ContainSubstring("forced upgrade")))
+		Expect(called).To(BeFalse())
+	})
+
+	It("should return the error of fn", func() {
+		fn = func(context.Context) error {
+			return apierrors.NewConflict(apiv2.Resource("operatorconditions"), objKey.Name, nil)
+		}
+		err := IfUpgradeAllowed(ctx, newClient(), fn)
+		Expect(apierrors.IsConflict(err)).To(BeTrue())
+	})
+
+	It("should run fn when the OperatorCondition API is not served", func() {
+		cl := interceptor.NewClient(newClient(), interceptor.Funcs{
+			Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+				return &meta.NoKindMatchError{GroupKind: operatorConditionGVK.GroupKind(), SearchedVersions: []string{"v2"}}
+			},
+		})
+		Expect(IfUpgradeAllowed(ctx, cl, fn)).To(Succeed())
+		Expect(called).To(BeTrue())
+	})
+
+	It("should run fn if the operator is not managed by OLM", func() {
+		Expect(os.Unsetenv(operatorCondEnvVar)).To(Succeed())
+		Expect(IfUpgradeAllowed(ctx, newClient(), fn)).To(Succeed())
+		Expect(called).To(BeTrue())
+	})
+
+	It("should return an error without running fn if the OperatorCondition does not exist", func() {
+		Expect(os.Setenv(operatorCondEnvVar, "missing")).To(Succeed())
+		err := IfUpgradeAllowed(ctx, newClient(), fn)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(called).To(BeFalse())
+	})
+})
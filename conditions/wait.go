@@ -0,0 +1,52 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// waitInterval is the interval at which WaitFor polls the condition.
+var waitInterval = time.Second
+
+// WaitFor polls cond until it reaches the given status, the timeout expires or the context
+// is done. This can be used in operator startup sequences, e.g. to wait until OLM has seen
+// Upgradeable=False before starting a migration.
+// A missing condition and errors getting it are retried, except for ErrOLMNotAvailable and
+// ErrNotManagedByOLM, which are returned immediately. When the timeout expires, the returned error wraps
+// context.DeadlineExceeded and includes the last error getting the condition, if any.
+func WaitFor(ctx context.Context, cond Condition, status metav1.ConditionStatus, timeout time.Duration) error {
+	var lastErr error
+	err := wait.PollUntilContextTimeout(ctx, waitInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		c, err := cond.Get(ctx)
+		if isNotManagedByOLM(err) {
+			return false, err
+		}
+		lastErr = err
+		return err == nil && c.Status == status, nil
+	})
+	if err == nil || isNotManagedByOLM(err) {
+		return err
+	}
+	if lastErr != nil {
+		return fmt.Errorf("condition did not reach status %s: %w: %v", status, err, lastErr)
+	}
+	return fmt.Errorf("condition did not reach status %s: %w", status, err)
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// sequenceCondition returns the results of Get in order, repeating the last one.
+type sequenceCondition struct {
+	results []func() (*metav1.Condition, error)
+	calls   int
+}
+
+func (c *sequenceCondition) Get(_ context.Context) (*metav1.Condition, error) {
+	i := c.calls
+	if i >= len(c.results) {
+		i = len(c.results) - 1
+	}
+	c.calls++
+	return c.results[i]()
+}
+
+func (c *sequenceCondition) Set(_ context.Context, _ metav1.ConditionStatus, _ ...Option) error {
+	return nil
+}
+
+func withStatus(status metav1.ConditionStatus) func() (*metav1.Condition, error) {
+	return func() (*metav1.Condition, error) {
+		return &metav1.Condition{Type: string(conditionFoo), Status: status}, nil
+	}
+}
+
+func withError(err error) func() (*metav1.Condition, error) {
+	return func() (*metav1.Condition, error) {
+		return nil, err
+	}
+}
+
+var _ = Describe("WaitFor", func() {
+	BeforeEach(func() {
+		interval := waitInterval
+		waitInterval = time.Millisecond
+		DeferCleanup(func() { waitInterval = interval })
+	})
+
+	It("should return once the condition reaches the status", func() {
+		cond := &sequenceCondition{results: []func() (*metav1.Condition, error){
+			withError(fmt.Errorf("conditionType %v not found", conditionFoo)),
+			withStatus(metav1.ConditionTrue),
+			withStatus(metav1.ConditionFalse),
+		}}
+		Expect(WaitFor(context.TODO(), cond, metav1.ConditionFalse, time.Second)).To(Succeed())
+		Expect(cond.calls).To(Equal(3))
+	})
+
+	It("should return an error when the timeout expires", func() {
+		cond := &sequenceCondition{results: []func() (*metav1.Condition, error){
+			withError(fmt.Errorf("conditionType %v not found", conditionFoo)),
+		}}
+		err := WaitFor(context.TODO(), cond, metav1.ConditionFalse, 20*time.Millisecond)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+	})
+
+	It("should return immediately when OLM is not available", func() {
+		cond := &sequenceCondition{results: []func() (*metav1.Condition, error){
+			withError(ErrOLMNotAvailable),
+		}}
+		err := WaitFor(context.TODO(), cond, metav1.ConditionFalse, time.Second)
+		Expect(err).To(MatchError(ErrOLMNotAvailable))
+		Expect(cond.calls).To(Equal(1))
+	})
+
+	It("should return immediately when the operator is not managed by OLM", func() {
+		cond := &sequenceCondition{results: []func() (*metav1.Condition, error){
+			withError(ErrNotManagedByOLM),
+		}}
+		err := WaitFor(context.TODO(), cond, metav1.ConditionFalse, time.Second)
+		Expect(err).To(MatchError(ErrNotManagedByOLM))
+		Expect(cond.calls).To(Equal(1))
+	})
+})
@@ -0,0 +1,124 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dedupe coordinates concurrent operations on external resources.
+//
+// Operators whose controllers manage objects of an external system, such as cloud resources,
+// often have several controllers, or several workers of a controller, reconciling the same
+// backend object at the same time. A Group ensures that at most one operation per external
+// resource identifier is in flight: callers asking for an operation on an identifier that is
+// already being operated on wait for the in-flight operation and receive its result, instead of
+// issuing a duplicate call to the external API.
+package dedupe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("dedupe")
+
+// ErrOperationPanicked is returned to the callers waiting for an operation that panicked.
+var ErrOperationPanicked = errors.New("operation panicked")
+
+// Group deduplicates concurrent operations on external resources identified by keys of type K,
+// returning results of type V. The zero value is ready to use. A Group must not be copied after
+// first use.
+type Group[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*call[V]
+}
+
+type call[V any] struct {
+	done    chan struct{}
+	val     V
+	err     error
+	waiters int
+}
+
+// Do runs fn for key, unless an operation for key is already in flight, in which case it waits
+// for that operation and returns its result. shared is true if the result was, or may be,
+// returned to several callers. fn runs with the context of the caller that started it, so that
+// its cancellation cancels the operation for all callers. A caller whose own context is done
+// before the operation completes stops waiting and gets the context's error; the operation
+// goes on for the others. Results are not cached: a call for key after the operation completed
+// runs fn again.
+func (g *Group[K, V]) Do(ctx context.Context, key K, fn func(ctx context.Context) (V, error)) (v V, shared bool, err error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = map[K]*call[V]{}
+	}
+	if c, ok := g.calls[key]; ok {
+		c.waiters++
+		g.mu.Unlock()
+		log.V(1).Info("Waiting for in-flight operation", "key", key)
+		select {
+		case <-c.done:
+			return c.val, true, c.err
+		case <-ctx.Done():
+			return v, true, ctx.Err()
+		}
+	}
+	c := &call[V]{done: make(chan struct{})}
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	g.run(ctx, key, c, fn)
+
+	g.mu.Lock()
+	shared = c.waiters > 0
+	g.mu.Unlock()
+	return c.val, shared, c.err
+}
+
+// run runs fn and records its result in c. If fn panics, waiters get ErrOperationPanicked and
+// the panic is propagated to the caller that started the operation.
+func (g *Group[K, V]) run(ctx context.Context, key K, c *call[V], fn func(ctx context.Context) (V, error)) {
+	completed := false
+	defer func() {
+		if !completed {
+			c.err = fmt.Errorf("%w: key %v", ErrOperationPanicked, key)
+		}
+		g.mu.Lock()
+		if g.calls[key] == c {
+			delete(g.calls, key)
+		}
+		g.mu.Unlock()
+		close(c.done)
+	}()
+	c.val, c.err = fn(ctx)
+	completed = true
+}
+
+// InFlight returns true if an operation for key is in flight.
+func (g *Group[K, V]) InFlight(key K) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	_, ok := g.calls[key]
+	return ok
+}
+
+// Forget makes the next call to Do for key start a new operation, even if an operation for key
+// is in flight. Callers already waiting for the in-flight operation still receive its result.
+// It can be used when the in-flight operation is known to be outdated, e.g. after the external
+// resource was changed.
+func (g *Group[K, V]) Forget(key K) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.calls, key)
+}
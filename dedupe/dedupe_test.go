@@ -0,0 +1,201 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dedupe
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Group", func() {
+	var g *Group[string, string]
+
+	BeforeEach(func() {
+		g = &Group[string, string]{}
+	})
+
+	It("should run the operation and return its result", func() {
+		v, shared, err := g.Do(context.Background(), "vpc-1", func(context.Context) (string, error) {
+			return "created", nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(Equal("created"))
+		Expect(shared).To(BeFalse())
+		Expect(g.InFlight("vpc-1")).To(BeFalse())
+	})
+
+	It("should share the result of an in-flight operation with concurrent callers", func() {
+		var calls atomic.Int32
+		release := make(chan struct{})
+		fn := func(context.Context) (string, error) {
+			calls.Add(1)
+			<-release
+			return "created", nil
+		}
+
+		var wg sync.WaitGroup
+		results := make([]string, 5)
+		shared := make([]bool, 5)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				v, s, err := g.Do(context.Background(), "vpc-1", fn)
+				Expect(err).NotTo(HaveOccurred())
+				results[i], shared[i] = v, s
+			}(i)
+		}
+		Eventually(func() int {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			if c, ok := g.calls["vpc-1"]; ok {
+				return c.waiters
+			}
+			return 0
+		}).Should(Equal(4))
+		close(release)
+		wg.Wait()
+
+		Expect(calls.Load()).To(BeEquivalentTo(1))
+		Expect(results).To(HaveEach("created"))
+		Expect(shared).To(HaveEach(BeTrue()))
+	})
+
+	It("should run operations on different keys concurrently", func() {
+		release := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			_, _, _ = g.Do(context.Background(), "vpc-1", func(context.Context) (string, error) {
+				<-release
+				return "", nil
+			})
+			close(done)
+		}()
+		Eventually(func() bool { return g.InFlight("vpc-1") }).Should(BeTrue())
+
+		v, _, err := g.Do(context.Background(), "vpc-2", func(context.Context) (string, error) {
+			return "other", nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(Equal("other"))
+		close(release)
+		Eventually(done).Should(BeClosed())
+	})
+
+	It("should share errors", func() {
+		release := make(chan struct{})
+		errs := make(chan error, 2)
+		for i := 0; i < 2; i++ {
+			go func() {
+				_, _, err := g.Do(context.Background(), "vpc-1", func(context.Context) (string, error) {
+					<-release
+					return "", errors.New("quota exceeded")
+				})
+				errs <- err
+			}()
+		}
+		Eventually(func() int {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			if c, ok := g.calls["vpc-1"]; ok {
+				return c.waiters
+			}
+			return 0
+		}).Should(Equal(1))
+		close(release)
+		Expect(<-errs).To(MatchError("quota exceeded"))
+		Expect(<-errs).To(MatchError("quota exceeded"))
+	})
+
+	It("should stop waiting when the context of a waiter is done", func() {
+		release := make(chan struct{})
+		defer close(release)
+		go func() {
+			_, _, _ = g.Do(context.Background(), "vpc-1", func(context.Context) (string, error) {
+				<-release
+				return "", nil
+			})
+		}()
+		Eventually(func() bool { return g.InFlight("vpc-1") }).Should(BeTrue())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _, err := g.Do(ctx, "vpc-1", func(context.Context) (string, error) {
+			Fail("operation should not run")
+			return "", nil
+		})
+		Expect(err).To(MatchError(context.Canceled))
+	})
+
+	It("should report panics to waiters", func() {
+		release := make(chan struct{})
+		panicked := make(chan struct{})
+		go func() {
+			defer func() {
+				_ = recover()
+				close(panicked)
+			}()
+			_, _, _ = g.Do(context.Background(), "vpc-1", func(context.Context) (string, error) {
+				<-release
+				panic("boom")
+			})
+		}()
+		Eventually(func() bool { return g.InFlight("vpc-1") }).Should(BeTrue())
+
+		errs := make(chan error)
+		go func() {
+			_, _, err := g.Do(context.Background(), "vpc-1", func(context.Context) (string, error) {
+				return "", nil
+			})
+			errs <- err
+		}()
+		Eventually(func() int {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			return g.calls["vpc-1"].waiters
+		}).Should(Equal(1))
+		close(release)
+		Eventually(panicked).Should(BeClosed())
+		Expect(<-errs).To(MatchError(ErrOperationPanicked))
+		Expect(g.InFlight("vpc-1")).To(BeFalse())
+	})
+
+	It("should start a new operation after Forget", func() {
+		release := make(chan struct{})
+		go func() {
+			_, _, _ = g.Do(context.Background(), "vpc-1", func(context.Context) (string, error) {
+				<-release
+				return "stale", nil
+			})
+		}()
+		Eventually(func() bool { return g.InFlight("vpc-1") }).Should(BeTrue())
+
+		g.Forget("vpc-1")
+		v, shared, err := g.Do(context.Background(), "vpc-1", func(context.Context) (string, error) {
+			return "fresh", nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(Equal("fresh"))
+		Expect(shared).To(BeFalse())
+		close(release)
+	})
+})
@@ -0,0 +1,240 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ensure implements the "ensure child" loop of operators: given the desired state of a
+// child resource, create it if it does not exist, or update it if its desired state changed.
+//
+// Ensure marks the child as owned by its parent, with an ownerReference if both are in the same
+// namespace or the parent is cluster-scoped, and with the owner annotations of the handler package
+// otherwise, so that it can be watched with handler.EnqueueRequestForAnnotation. A hash of the
+// desired state is recorded in the HashAnnotation of the child, and the child is only updated when
+// the hash changes. Changes made to the child by others are therefore not reverted until its desired
+// state changes.
+package ensure
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/operator-framework/operator-lib/handler"
+)
+
+var log = logf.Log.WithName("ensure")
+
+// HashAnnotation records the hash of the desired state of a child resource managed by Ensure.
+const HashAnnotation = "operator-lib.operatorframework.io/desired-hash"
+
+// Option configures Ensure.
+type Option func(*options)
+
+type options struct {
+	fieldOwner string
+}
+
+// WithFieldOwner sets the field manager of the create and update requests sent by Ensure.
+func WithFieldOwner(name string) Option {
+	return func(o *options) {
+		o.fieldOwner = name
+	}
+}
+
+// Ensure creates desired, owned by owner, if it does not exist, or updates it if its desired state
+// changed since it was last ensured. It returns true if the child was created or updated.
+// The fields of desired other than metadata and status replace those of the existing child, and
+// its labels, annotations and ownerReferences are merged into those of the existing child.
+// On return, desired holds the state of the child in the cluster if it was created or updated.
+func Ensure(ctx context.Context, c client.Client, owner, desired client.Object, opts ...Option) (bool, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if err := setOwner(c, owner, desired); err != nil {
+		return false, err
+	}
+	hash, err := Hash(desired)
+	if err != nil {
+		return false, err
+	}
+	setAnnotation(desired, HashAnnotation, hash)
+
+	existing, ok := desired.DeepCopyObject().(client.Object)
+	if !ok {
+		return false, fmt.Errorf("%T is not a client.Object", desired)
+	}
+	key := client.ObjectKeyFromObject(desired)
+	if err := c.Get(ctx, key, existing); apierrors.IsNotFound(err) {
+		if err := c.Create(ctx, desired, o.createOptions()...); err != nil {
+			return false, fmt.Errorf("error creating %s: %w", key, err)
+		}
+		log.V(1).Info("Created child resource", "object", key, "owner", client.ObjectKeyFromObject(owner))
+		return true, nil
+	} else if err != nil {
+		return false, fmt.Errorf("error getting %s: %w", key, err)
+	}
+
+	if existing.GetAnnotations()[HashAnnotation] == hash {
+		return false, nil
+	}
+
+	if err := merge(existing, desired); err != nil {
+		return false, err
+	}
+	if err := c.Update(ctx, desired, o.updateOptions()...); err != nil {
+		return false, fmt.Errorf("error updating %s: %w", key, err)
+	}
+	log.V(1).Info("Updated child resource", "object", key, "owner", client.ObjectKeyFromObject(owner))
+	return true, nil
+}
+
+// Hash returns the hash of the desired state of obj: all of its fields except status and metadata,
+// other than its labels, annotations and ownerReferences. The HashAnnotation is ignored.
+func Hash(obj client.Object) (string, error) {
+	objContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", err
+	}
+	// The content of unstructured objects is not copied by the converter, so copy the
+	// top-level fields before replacing some of them.
+	content := make(map[string]interface{}, len(objContent))
+	for k, v := range objContent {
+		if k != "status" {
+			content[k] = v
+		}
+	}
+
+	annotations := map[string]string{}
+	for k, v := range obj.GetAnnotations() {
+		if k != HashAnnotation {
+			annotations[k] = v
+		}
+	}
+	content["metadata"] = map[string]interface{}{
+		"labels":          obj.GetLabels(),
+		"annotations":     annotations,
+		"ownerReferences": obj.GetOwnerReferences(),
+	}
+
+	data, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// setOwner marks desired as owned by owner.
+func setOwner(c client.Client, owner, desired client.Object) error {
+	if owner.GetNamespace() == "" || owner.GetNamespace() == desired.GetNamespace() {
+		return controllerutil.SetControllerReference(owner, desired, c.Scheme())
+	}
+
+	gvk, err := apiutil.GVKForObject(owner, c.Scheme())
+	if err != nil {
+		return err
+	}
+	typedOwner := &metav1.PartialObjectMetadata{}
+	typedOwner.SetGroupVersionKind(gvk)
+	typedOwner.SetName(owner.GetName())
+	typedOwner.SetNamespace(owner.GetNamespace())
+	return handler.SetOwnerAnnotations(typedOwner, desired)
+}
+
+// merge prepares desired to be sent as an update of existing.
+func merge(existing, desired client.Object) error {
+	labels := existing.GetLabels()
+	for k, v := range desired.GetLabels() {
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[k] = v
+	}
+	annotations := existing.GetAnnotations()
+	for k, v := range desired.GetAnnotations() {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[k] = v
+	}
+	refs := existing.GetOwnerReferences()
+	for _, ref := range desired.GetOwnerReferences() {
+		refs = upsertOwnerReference(refs, ref)
+	}
+
+	existingContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing)
+	if err != nil {
+		return err
+	}
+	desiredContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+	if err != nil {
+		return err
+	}
+	desiredContent["metadata"] = existingContent["metadata"]
+	if status, ok := existingContent["status"]; ok {
+		desiredContent["status"] = status
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(desiredContent, desired); err != nil {
+		return err
+	}
+
+	desired.SetLabels(labels)
+	desired.SetAnnotations(annotations)
+	desired.SetOwnerReferences(refs)
+	return nil
+}
+
+// upsertOwnerReference adds ref to refs, replacing a reference to the same object.
+func upsertOwnerReference(refs []metav1.OwnerReference, ref metav1.OwnerReference) []metav1.OwnerReference {
+	for i := range refs {
+		if refs[i].UID == ref.UID {
+			refs[i] = ref
+			return refs
+		}
+	}
+	return append(refs, ref)
+}
+
+func setAnnotation(obj client.Object, key, value string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	obj.SetAnnotations(annotations)
+}
+
+func (o *options) createOptions() []client.CreateOption {
+	if o.fieldOwner == "" {
+		return nil
+	}
+	return []client.CreateOption{client.FieldOwner(o.fieldOwner)}
+}
+
+func (o *options) updateOptions() []client.UpdateOption {
+	if o.fieldOwner == "" {
+		return nil
+	}
+	return []client.UpdateOption{client.FieldOwner(o.fieldOwner)}
+}
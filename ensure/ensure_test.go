@@ -0,0 +1,157 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ensure
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/operator-framework/operator-lib/handler"
+)
+
+var _ = Describe("Ensure", func() {
+	var (
+		ctx   = context.TODO()
+		c     client.Client
+		owner *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		c = fake.NewClientBuilder().Build()
+		owner = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      "owner",
+			Namespace: "default",
+			UID:       "owner-uid",
+		}}
+	})
+
+	desiredSecret := func(value string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "child",
+				Namespace: "default",
+				Labels:    map[string]string{"app": "child"},
+			},
+			StringData: map[string]string{"key": value},
+		}
+	}
+
+	It("should create the child with an owner reference", func() {
+		changed, err := Ensure(ctx, c, owner, desiredSecret("a"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		child := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "child"}, child)).To(Succeed())
+		Expect(child.OwnerReferences).To(HaveLen(1))
+		Expect(child.OwnerReferences[0].UID).To(BeEquivalentTo("owner-uid"))
+		Expect(*child.OwnerReferences[0].Controller).To(BeTrue())
+		Expect(child.Annotations).To(HaveKey(HashAnnotation))
+	})
+
+	It("should only update the child when its desired state changes", func() {
+		_, err := Ensure(ctx, c, owner, desiredSecret("a"))
+		Expect(err).NotTo(HaveOccurred())
+
+		By("leaving the child unchanged if the desired state did not change")
+		child := &corev1.Secret{}
+		key := client.ObjectKey{Namespace: "default", Name: "child"}
+		Expect(c.Get(ctx, key, child)).To(Succeed())
+		child.Labels["other"] = "value"
+		child.Finalizers = []string{"example.com/finalizer"}
+		Expect(c.Update(ctx, child)).To(Succeed())
+		resourceVersion := child.ResourceVersion
+
+		changed, err := Ensure(ctx, c, owner, desiredSecret("a"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+		Expect(c.Get(ctx, key, child)).To(Succeed())
+		Expect(child.ResourceVersion).To(Equal(resourceVersion))
+
+		By("updating the child if the desired state changed")
+		changed, err = Ensure(ctx, c, owner, desiredSecret("b"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(c.Get(ctx, key, child)).To(Succeed())
+		Expect(child.StringData).To(HaveKeyWithValue("key", "b"))
+		Expect(child.Labels).To(Equal(map[string]string{"app": "child", "other": "value"}))
+		Expect(child.Finalizers).To(ConsistOf("example.com/finalizer"))
+		Expect(child.OwnerReferences).To(HaveLen(1))
+	})
+
+	It("should use owner annotations across namespaces", func() {
+		desired := desiredSecret("a")
+		desired.Namespace = "other"
+		changed, err := Ensure(ctx, c, owner, desired)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		child := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: "other", Name: "child"}, child)).To(Succeed())
+		Expect(child.OwnerReferences).To(BeEmpty())
+		Expect(child.Annotations).To(HaveKeyWithValue(handler.NamespacedNameAnnotation, "default/owner"))
+		Expect(child.Annotations).To(HaveKeyWithValue(handler.TypeAnnotation, "ConfigMap"))
+	})
+
+	It("should support unstructured children", func() {
+		desired := func(value string) *unstructured.Unstructured {
+			u := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "child", "namespace": "default"},
+				"data":       map[string]interface{}{"key": value},
+			}}
+			return u
+		}
+		changed, err := Ensure(ctx, c, owner, desired("a"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		changed, err = Ensure(ctx, c, owner, desired("a"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+
+		changed, err = Ensure(ctx, c, owner, desired("b"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		child := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "child"}, child)).To(Succeed())
+		Expect(child.Data).To(HaveKeyWithValue("key", "b"))
+	})
+
+	It("should ignore status and server-set metadata in the hash", func() {
+		a, b := desiredSecret("a"), desiredSecret("a")
+		b.ResourceVersion = "42"
+		b.UID = "uid"
+		hashA, err := Hash(a)
+		Expect(err).NotTo(HaveOccurred())
+		hashB, err := Hash(b)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hashA).To(Equal(hashB))
+
+		b.StringData["key"] = "b"
+		hashB, err = Hash(b)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hashA).NotTo(Equal(hashB))
+	})
+})
@@ -0,0 +1,263 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package events provides a structured Kubernetes event recorder for operators. It wraps a
+// record.EventRecorder with message templates, per-reason rate limits, deduplication and
+// correlation of events to the custom resource that triggered them.
+package events
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"text/template"
+	"time"
+
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("events")
+
+const (
+	// TriggerKindAnnotation is set on events recorded for an object other than the custom resource
+	// that triggered them, and contains the group and kind of the triggering resource.
+	TriggerKindAnnotation = "operator-lib.operatorframework.io/trigger-kind"
+	// TriggerNameAnnotation contains the `<namespace>/<name>` of the triggering resource, or `<name>`
+	// for cluster-scoped resources.
+	TriggerNameAnnotation = "operator-lib.operatorframework.io/trigger-name"
+	// TriggerUIDAnnotation contains the UID of the triggering resource.
+	TriggerUIDAnnotation = "operator-lib.operatorframework.io/trigger-uid"
+)
+
+// DefaultMaxDedupEntries is the number of recent events remembered for deduplication when
+// Options.MaxDedupEntries is not set.
+const DefaultMaxDedupEntries = 4096
+
+// Recorder records Kubernetes events for objects.
+// It is an interface so that controllers can be unit tested with a fake implementation,
+// or with a Recorder created by NewRecorder from a record.FakeRecorder.
+type Recorder interface {
+	// Event records an event of eventType for obj with the given reason and message.
+	Event(ctx context.Context, obj client.Object, eventType, reason, message string)
+
+	// Eventf is like Event, but formats the message with fmt.Sprintf.
+	Eventf(ctx context.Context, obj client.Object, eventType, reason, messageFmt string, args ...interface{})
+
+	// EventFromTemplate records an event whose message is rendered from the template registered
+	// for reason in Options.Templates, executed with data. It returns an error if there is no
+	// template for reason or if it fails to execute.
+	EventFromTemplate(ctx context.Context, obj client.Object, eventType, reason string, data interface{}) error
+}
+
+// RateLimit defines the rate at which events with a given reason may be recorded.
+type RateLimit struct {
+	// QPS is the sustained number of events per second.
+	QPS float32
+	// Burst is the maximum number of events that can be recorded at once.
+	Burst int
+}
+
+// Options configures a Recorder created by NewRecorder.
+type Options struct {
+	// Templates maps event reasons to text/template message templates used by EventFromTemplate.
+	Templates map[string]string
+
+	// RateLimits maps event reasons to the rate at which they may be recorded. Events exceeding the
+	// rate are dropped.
+	RateLimits map[string]RateLimit
+
+	// DefaultRateLimit applies to reasons not found in RateLimits. If nil, those reasons are not
+	// rate limited.
+	DefaultRateLimit *RateLimit
+
+	// DedupWindow is the amount of time during which an event identical to one already recorded for
+	// the same object, with the same type, reason and message, is dropped. Zero disables deduplication.
+	DedupWindow time.Duration
+
+	// MaxDedupEntries is the number of recent events remembered for deduplication. Once it is
+	// reached, the least recently seen events are forgotten, and may be recorded again within
+	// DedupWindow. Defaults to DefaultMaxDedupEntries.
+	MaxDedupEntries int
+
+	// Clock is used for deduplication and rate limiting. It defaults to the real clock.
+	Clock clock.PassiveClock
+}
+
+type recorder struct {
+	recorder record.EventRecorder
+	opts     Options
+
+	templates map[string]*template.Template
+
+	mu       sync.Mutex
+	limiters map[string]flowcontrol.PassiveRateLimiter
+	// seen holds the last time each recent event was recorded, by deduplication key
+	seen *lru.Cache
+}
+
+var _ Recorder = &recorder{}
+
+// NewRecorder returns a Recorder that records events with rec, for example one obtained from
+// manager.GetEventRecorderFor. It returns an error if one of the templates cannot be parsed.
+func NewRecorder(rec record.EventRecorder, opts Options) (Recorder, error) {
+	if opts.Clock == nil {
+		opts.Clock = clock.RealClock{}
+	}
+	if opts.MaxDedupEntries <= 0 {
+		opts.MaxDedupEntries = DefaultMaxDedupEntries
+	}
+
+	r := &recorder{
+		recorder:  rec,
+		opts:      opts,
+		templates: make(map[string]*template.Template, len(opts.Templates)),
+		limiters:  make(map[string]flowcontrol.PassiveRateLimiter),
+		seen:      lru.New(opts.MaxDedupEntries),
+	}
+
+	for reason, text := range opts.Templates {
+		tmpl, err := template.New(reason).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing template for reason %q: %w", reason, err)
+		}
+		r.templates[reason] = tmpl
+	}
+
+	return r, nil
+}
+
+// Event implements Recorder.
+func (r *recorder) Event(ctx context.Context, obj client.Object, eventType, reason, message string) {
+	r.record(ctx, obj, eventType, reason, message)
+}
+
+// Eventf implements Recorder.
+func (r *recorder) Eventf(ctx context.Context, obj client.Object, eventType, reason, messageFmt string, args ...interface{}) {
+	r.record(ctx, obj, eventType, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// EventFromTemplate implements Recorder.
+func (r *recorder) EventFromTemplate(ctx context.Context, obj client.Object, eventType, reason string, data interface{}) error {
+	tmpl, ok := r.templates[reason]
+	if !ok {
+		return fmt.Errorf("no template registered for reason %q", reason)
+	}
+
+	var message bytes.Buffer
+	if err := tmpl.Execute(&message, data); err != nil {
+		return fmt.Errorf("error rendering template for reason %q: %w", reason, err)
+	}
+
+	r.record(ctx, obj, eventType, reason, message.String())
+	return nil
+}
+
+func (r *recorder) record(ctx context.Context, obj client.Object, eventType, reason, message string) {
+	if !r.allow(obj, eventType, reason, message) {
+		log.V(1).Info("Dropping event", "object", client.ObjectKeyFromObject(obj), "reason", reason)
+		return
+	}
+
+	trigger := TriggerFrom(ctx)
+	if trigger == nil || isSameObject(trigger, obj) {
+		r.recorder.Event(obj, eventType, reason, message)
+		return
+	}
+	r.recorder.AnnotatedEventf(obj, triggerAnnotations(trigger), eventType, reason, "%s", message)
+}
+
+// allow returns false if the event is a duplicate of a recent event, or if its reason is rate limited.
+func (r *recorder) allow(obj client.Object, eventType, reason, message string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.opts.Clock.Now()
+	// typed objects read from the cache usually have an empty GroupVersionKind, their Go type
+	// tells their kind apart instead
+	key := fmt.Sprintf("%s/%T/%s/%s/%s/%s/%s/%s", obj.GetObjectKind().GroupVersionKind(), obj, obj.GetUID(),
+		obj.GetNamespace(), obj.GetName(), eventType, reason, message)
+	if r.opts.DedupWindow > 0 {
+		if last, ok := r.seen.Get(key); ok && now.Sub(last.(time.Time)) < r.opts.DedupWindow {
+			return false
+		}
+	}
+
+	if limiter := r.limiterFor(reason); limiter != nil && !limiter.TryAccept() {
+		return false
+	}
+
+	if r.opts.DedupWindow > 0 {
+		r.seen.Add(key, now)
+	}
+	return true
+}
+
+func (r *recorder) limiterFor(reason string) flowcontrol.PassiveRateLimiter {
+	if limiter, ok := r.limiters[reason]; ok {
+		return limiter
+	}
+
+	limit, ok := r.opts.RateLimits[reason]
+	if !ok {
+		if r.opts.DefaultRateLimit == nil {
+			return nil
+		}
+		limit = *r.opts.DefaultRateLimit
+	}
+
+	limiter := flowcontrol.NewTokenBucketPassiveRateLimiterWithClock(limit.QPS, limit.Burst, r.opts.Clock)
+	r.limiters[reason] = limiter
+	return limiter
+}
+
+type triggerKey struct{}
+
+// WithTrigger returns a copy of ctx carrying the custom resource that triggered the current
+// reconciliation. Events recorded with the returned context for other objects are annotated
+// with TriggerKindAnnotation, TriggerNameAnnotation and TriggerUIDAnnotation.
+func WithTrigger(ctx context.Context, trigger client.Object) context.Context {
+	return context.WithValue(ctx, triggerKey{}, trigger)
+}
+
+// TriggerFrom returns the triggering resource stored in ctx by WithTrigger, or nil.
+func TriggerFrom(ctx context.Context) client.Object {
+	trigger, _ := ctx.Value(triggerKey{}).(client.Object)
+	return trigger
+}
+
+func isSameObject(a, b client.Object) bool {
+	if a.GetUID() != "" || b.GetUID() != "" {
+		return a.GetUID() == b.GetUID()
+	}
+	return client.ObjectKeyFromObject(a) == client.ObjectKeyFromObject(b) &&
+		a.GetObjectKind().GroupVersionKind().GroupKind() == b.GetObjectKind().GroupVersionKind().GroupKind()
+}
+
+func triggerAnnotations(trigger client.Object) map[string]string {
+	name := trigger.GetName()
+	if trigger.GetNamespace() != "" {
+		name = trigger.GetNamespace() + "/" + name
+	}
+	return map[string]string{
+		TriggerKindAnnotation: trigger.GetObjectKind().GroupVersionKind().GroupKind().String(),
+		TriggerNameAnnotation: name,
+		TriggerUIDAnnotation:  string(trigger.GetUID()),
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("Recorder", func() {
+	ctx := context.TODO()
+
+	var (
+		fake  *record.FakeRecorder
+		clock *clocktesting.FakePassiveClock
+		pod   *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		fake = record.NewFakeRecorder(10)
+		clock = clocktesting.NewFakePassiveClock(time.Now())
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "churro", Namespace: "default", UID: "pod-uid"},
+		}
+	})
+
+	Describe("NewRecorder", func() {
+		It("should return an error when a template cannot be parsed", func() {
+			r, err := NewRecorder(fake, Options{Templates: map[string]string{"Bad": "{{ .Foo "}})
+			Expect(err).To(MatchError(ContainSubstring(`error parsing template for reason "Bad"`)))
+			Expect(r).To(BeNil())
+		})
+	})
+
+	Describe("Event", func() {
+		It("should record events", func() {
+			r, err := NewRecorder(fake, Options{})
+			Expect(err).NotTo(HaveOccurred())
+
+			r.Event(ctx, pod, corev1.EventTypeNormal, "Created", "created pod")
+			r.Eventf(ctx, pod, corev1.EventTypeWarning, "Failed", "failed %d times", 2)
+			Expect(fake.Events).To(Receive(Equal("Normal Created created pod")))
+			Expect(fake.Events).To(Receive(Equal("Warning Failed failed 2 times")))
+		})
+	})
+
+	Describe("EventFromTemplate", func() {
+		var r Recorder
+		BeforeEach(func() {
+			var err error
+			r, err = NewRecorder(fake, Options{Templates: map[string]string{
+				"Scaled": "scaled from {{ .From }} to {{ .To }} replicas",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should render the template registered for the reason", func() {
+			Expect(r.EventFromTemplate(ctx, pod, corev1.EventTypeNormal, "Scaled", map[string]int{"From": 1, "To": 3})).To(Succeed())
+			Expect(fake.Events).To(Receive(Equal("Normal Scaled scaled from 1 to 3 replicas")))
+		})
+
+		It("should return an error when no template is registered for the reason", func() {
+			Expect(r.EventFromTemplate(ctx, pod, corev1.EventTypeNormal, "Unknown", nil)).To(MatchError(ContainSubstring("no template registered")))
+			Expect(fake.Events).To(BeEmpty())
+		})
+
+		It("should return an error when the template cannot be rendered", func() {
+			Expect(r.EventFromTemplate(ctx, pod, corev1.EventTypeNormal, "Scaled", map[string]int{"From": 1})).To(HaveOccurred())
+			Expect(fake.Events).To(BeEmpty())
+		})
+	})
+
+	Describe("deduplication", func() {
+		var r Recorder
+		BeforeEach(func() {
+			var err error
+			r, err = NewRecorder(fake, Options{DedupWindow: time.Minute, Clock: clock})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should drop identical events within the window", func() {
+			r.Event(ctx, pod, corev1.EventTypeNormal, "Created", "created pod")
+			r.Event(ctx, pod, corev1.EventTypeNormal, "Created", "created pod")
+			Expect(fake.Events).To(HaveLen(1))
+
+			By("recording events with a different message")
+			r.Event(ctx, pod, corev1.EventTypeNormal, "Created", "created pod again")
+			Expect(fake.Events).To(HaveLen(2))
+
+			By("recording the same event after the window")
+			clock.SetTime(clock.Now().Add(2 * time.Minute))
+			r.Event(ctx, pod, corev1.EventTypeNormal, "Created", "created pod")
+			Expect(fake.Events).To(HaveLen(3))
+		})
+
+		It("should not drop identical events of objects of other kinds", func() {
+			unsaved := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "churro", Namespace: "default"}}
+			r.Event(ctx, unsaved, corev1.EventTypeNormal, "Created", "created")
+			r.Event(ctx, &corev1.ConfigMap{ObjectMeta: unsaved.ObjectMeta}, corev1.EventTypeNormal, "Created", "created")
+			deployment := &appsv1.Deployment{ObjectMeta: unsaved.ObjectMeta}
+			deployment.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("Deployment"))
+			r.Event(ctx, deployment, corev1.EventTypeNormal, "Created", "created")
+			Expect(fake.Events).To(HaveLen(3))
+		})
+
+		It("should forget the least recently seen events beyond the maximum", func() {
+			r, err := NewRecorder(fake, Options{DedupWindow: time.Minute, MaxDedupEntries: 2, Clock: clock})
+			Expect(err).NotTo(HaveOccurred())
+
+			r.Event(ctx, pod, corev1.EventTypeNormal, "Created", "first")
+			r.Event(ctx, pod, corev1.EventTypeNormal, "Created", "second")
+			r.Event(ctx, pod, corev1.EventTypeNormal, "Created", "third")
+			Expect(fake.Events).To(HaveLen(3))
+
+			By("recording the forgotten event again")
+			r.Event(ctx, pod, corev1.EventTypeNormal, "Created", "first")
+			Expect(fake.Events).To(HaveLen(4))
+
+			By("recording a remembered event again")
+			r.Event(ctx, pod, corev1.EventTypeNormal, "Created", "third")
+			Expect(fake.Events).To(HaveLen(4))
+		})
+	})
+
+	Describe("rate limiting", func() {
+		It("should drop events exceeding the rate limit of their reason", func() {
+			r, err := NewRecorder(fake, Options{
+				RateLimits: map[string]RateLimit{"Failed": {QPS: 0.1, Burst: 2}},
+				Clock:      clock,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			for i := 0; i < 5; i++ {
+				r.Eventf(ctx, pod, corev1.EventTypeWarning, "Failed", "failure %d", i)
+				r.Eventf(ctx, pod, corev1.EventTypeNormal, "Retried", "retry %d", i)
+			}
+			Expect(fake.Events).To(HaveLen(7))
+		})
+
+		It("should apply the default rate limit to other reasons", func() {
+			r, err := NewRecorder(fake, Options{
+				DefaultRateLimit: &RateLimit{QPS: 0.1, Burst: 1},
+				Clock:            clock,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			for i := 0; i < 5; i++ {
+				r.Eventf(ctx, pod, corev1.EventTypeNormal, "Retried", "retry %d", i)
+			}
+			Expect(fake.Events).To(HaveLen(1))
+		})
+	})
+
+	Describe("trigger correlation", func() {
+		var (
+			r     Recorder
+			owner *appsv1.Deployment
+		)
+		BeforeEach(func() {
+			var err error
+			r, err = NewRecorder(fake, Options{})
+			Expect(err).NotTo(HaveOccurred())
+
+			owner = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default", UID: "owner-uid"},
+			}
+			owner.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("Deployment"))
+		})
+
+		It("should annotate events recorded for other objects with the trigger", func() {
+			r.Event(WithTrigger(ctx, owner), pod, corev1.EventTypeNormal, "Created", "created pod")
+
+			var evt string
+			Expect(fake.Events).To(Receive(&evt))
+			Expect(evt).To(ContainSubstring(TriggerKindAnnotation + ":Deployment.apps"))
+			Expect(evt).To(ContainSubstring(TriggerNameAnnotation + ":default/owner"))
+			Expect(evt).To(ContainSubstring(TriggerUIDAnnotation + ":owner-uid"))
+		})
+
+		It("should not annotate events recorded for the trigger itself", func() {
+			r.Event(WithTrigger(ctx, owner), owner, corev1.EventTypeNormal, "Reconciled", "reconciled")
+			Expect(fake.Events).To(Receive(Equal("Normal Reconciled reconciled")))
+		})
+
+		It("should return the trigger stored in the context", func() {
+			Expect(TriggerFrom(ctx)).To(BeNil())
+			Expect(TriggerFrom(WithTrigger(ctx, owner))).To(Equal(owner))
+		})
+	})
+})
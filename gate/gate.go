@@ -0,0 +1,275 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gate lets controllers declare dependencies that must be satisfied before
+// they start reconciling, such as "CRD Bar exists and controller Baz reports ready".
+//
+// A Gate is a manager.Runnable that polls its dependencies until all of them are
+// satisfied, then opens. Controllers can be held back until the gate opens either by
+// wrapping them with Runnable, which blocks their start, or by adding Predicate to
+// their watches, which drops events while the gate is closed. The gate_blocked metric
+// reports which dependencies each gate is blocked on, once registered with RegisterMetrics.
+package gate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/operator-framework/operator-lib/gate/internal/metrics"
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+var log = logf.Log.WithName("gate")
+
+// DefaultPollInterval is the interval at which a Gate checks its dependencies by default.
+const DefaultPollInterval = 5 * time.Second
+
+// CheckFunc reports whether a dependency is satisfied. Errors are logged and the
+// dependency is considered unsatisfied until a later check succeeds.
+type CheckFunc func(ctx context.Context) (bool, error)
+
+// Gate opens once all of its dependencies are satisfied. It stays open afterwards.
+// A Gate must be added to the manager for its dependencies to be checked.
+type Gate struct {
+	name         string
+	dependencies []dependency
+	interval     time.Duration
+
+	openOnce sync.Once
+	open     chan struct{}
+}
+
+type dependency struct {
+	name  string
+	check CheckFunc
+}
+
+// Option configures a Gate.
+type Option func(*Gate)
+
+// DependsOn adds a dependency, identified by name in logs and metrics, to the Gate.
+func DependsOn(name string, check CheckFunc) Option {
+	return func(g *Gate) {
+		g.dependencies = append(g.dependencies, dependency{name: name, check: check})
+	}
+}
+
+// WithPollInterval sets the interval at which the Gate checks its dependencies.
+func WithPollInterval(interval time.Duration) Option {
+	return func(g *Gate) {
+		g.interval = interval
+	}
+}
+
+var _ manager.Runnable = &Gate{}
+var _ manager.LeaderElectionRunnable = &Gate{}
+
+// New returns a closed Gate with the given name and options.
+func New(name string, opts ...Option) *Gate {
+	g := &Gate{
+		name:     name,
+		interval: DefaultPollInterval,
+		open:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	for _, dep := range g.dependencies {
+		metrics.GateBlocked.WithLabelValues(g.name, dep.name).Set(1)
+	}
+	return g
+}
+
+// Name returns the name of the Gate.
+func (g *Gate) Name() string {
+	return g.name
+}
+
+// IsOpen returns true if all dependencies of the Gate have been satisfied.
+func (g *Gate) IsOpen() bool {
+	select {
+	case <-g.open:
+		return true
+	default:
+		return false
+	}
+}
+
+// Wait blocks until the Gate opens or the context is done, in which case the
+// context's error is returned.
+func (g *Gate) Wait(ctx context.Context) error {
+	select {
+	case <-g.open:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Start implements manager.Runnable. It checks the dependencies of the Gate until all of
+// them are satisfied and opens the Gate, or until the context is done.
+func (g *Gate) Start(ctx context.Context) error {
+	err := wait.PollUntilContextCancel(ctx, g.interval, true, func(ctx context.Context) (bool, error) {
+		return g.check(ctx), nil
+	})
+	if err != nil && !wait.Interrupted(err) {
+		return err
+	}
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Gates are checked on
+// all replicas, so that they are open by the time a replica becomes the leader.
+func (g *Gate) NeedLeaderElection() bool {
+	return false
+}
+
+// check evaluates all dependencies, opening the Gate if they are satisfied.
+func (g *Gate) check(ctx context.Context) bool {
+	satisfied := true
+	for _, dep := range g.dependencies {
+		ok, err := dep.check(ctx)
+		if err != nil {
+			log.Error(err, "Failed to check dependency", "gate", g.name, "dependency", dep.name)
+			ok = false
+		}
+		if ok {
+			metrics.GateBlocked.WithLabelValues(g.name, dep.name).Set(0)
+		} else {
+			metrics.GateBlocked.WithLabelValues(g.name, dep.name).Set(1)
+			log.V(1).Info("Gate blocked on dependency", "gate", g.name, "dependency", dep.name)
+			satisfied = false
+		}
+	}
+	if satisfied {
+		g.openOnce.Do(func() {
+			log.Info("Gate opened", "gate", g.name)
+			close(g.open)
+		})
+	}
+	return satisfied
+}
+
+// Runnable returns a manager.Runnable that waits for the Gate to open before starting r.
+// This can be used with controllers created with controller.NewUnmanaged to delay their
+// start until their dependencies are satisfied.
+func Runnable(g *Gate, r manager.Runnable) manager.Runnable {
+	return &gatedRunnable{gate: g, runnable: r}
+}
+
+type gatedRunnable struct {
+	gate     *Gate
+	runnable manager.Runnable
+}
+
+// Start implements manager.Runnable.
+func (r *gatedRunnable) Start(ctx context.Context) error {
+	if err := r.gate.Wait(ctx); err != nil {
+		if errors.Is(err, ctx.Err()) {
+			return nil
+		}
+		return err
+	}
+	return r.runnable.Start(ctx)
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, deferring to the
+// wrapped runnable.
+func (r *gatedRunnable) NeedLeaderElection() bool {
+	if ler, ok := r.runnable.(manager.LeaderElectionRunnable); ok {
+		return ler.NeedLeaderElection()
+	}
+	return true
+}
+
+// Predicate returns a predicate that drops all events while the Gate is closed.
+func Predicate[T client.Object](g *Gate) predicate.TypedPredicate[T] {
+	return predicate.NewTypedPredicateFuncs(func(T) bool {
+		if g.IsOpen() {
+			return true
+		}
+		metrics.GateDroppedEvents.WithLabelValues(g.name).Inc()
+		libmetrics.RecordDroppedEvent(libmetrics.SubsystemGate, libmetrics.DropReasonGateClosed)
+		return false
+	})
+}
+
+// KindExists returns a CheckFunc that is satisfied once the given kind is served by the
+// API server, e.g. after its CRD has been established.
+func KindExists(mapper meta.RESTMapper, gvk schema.GroupVersionKind) CheckFunc {
+	return func(_ context.Context) (bool, error) {
+		_, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+}
+
+// IsOpen returns a CheckFunc that is satisfied once the other Gate is open.
+func IsOpen(other *Gate) CheckFunc {
+	return func(_ context.Context) (bool, error) {
+		return other.IsOpen(), nil
+	}
+}
+
+// Signal can be set by a controller to report that it is ready, e.g. after its first
+// successful reconciliation, so that gates depending on it can open.
+type Signal struct {
+	set atomic.Bool
+}
+
+// Set marks the Signal as set.
+func (s *Signal) Set() {
+	s.set.Store(true)
+}
+
+// IsSet returns true if the Signal has been set.
+func (s *Signal) IsSet() bool {
+	return s.set.Load()
+}
+
+// IsSet returns a CheckFunc that is satisfied once the Signal is set.
+func IsSet(s *Signal) CheckFunc {
+	return func(_ context.Context) (bool, error) {
+		return s.IsSet(), nil
+	}
+}
+
+// RegisterMetrics registers the gate metrics with reg, e.g. controller-runtime's
+// metrics.Registry. The metrics report the dependencies each gate is blocked on and the events
+// dropped while gates are closed. The metrics shared by the library are registered as well, see
+// the metrics package.
+func RegisterMetrics(reg prometheus.Registerer) error {
+	if err := metrics.Register(reg); err != nil {
+		return fmt.Errorf("error registering gate metrics: %w", err)
+	}
+	if err := libmetrics.Register(reg); err != nil {
+		return fmt.Errorf("error registering operator-lib metrics: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gate
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/operator-framework/operator-lib/gate/internal/metrics"
+)
+
+var _ = Describe("Gate", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("should open once all dependencies are satisfied", func() {
+		crd := &Signal{}
+		controller := &Signal{}
+		g := New("foo-open", DependsOn("crd", IsSet(crd)), DependsOn("controller", IsSet(controller)),
+			WithPollInterval(time.Millisecond))
+		Expect(g.Name()).To(Equal("foo-open"))
+		go func() { _ = g.Start(ctx) }()
+
+		Consistently(g.IsOpen).WithTimeout(20 * time.Millisecond).Should(BeFalse())
+		Expect(testutil.ToFloat64(metrics.GateBlocked.WithLabelValues("foo-open", "crd"))).To(Equal(1.0))
+
+		crd.Set()
+		Eventually(func() float64 {
+			return testutil.ToFloat64(metrics.GateBlocked.WithLabelValues("foo-open", "crd"))
+		}).Should(Equal(0.0))
+		Expect(g.IsOpen()).To(BeFalse())
+		Expect(testutil.ToFloat64(metrics.GateBlocked.WithLabelValues("foo-open", "controller"))).To(Equal(1.0))
+
+		controller.Set()
+		Eventually(g.IsOpen).Should(BeTrue())
+		Expect(g.Wait(ctx)).To(Succeed())
+		Expect(testutil.ToFloat64(metrics.GateBlocked.WithLabelValues("foo-open", "controller"))).To(Equal(0.0))
+	})
+
+	It("should treat dependency errors as unsatisfied", func() {
+		failing := true
+		g := New("foo-error", DependsOn("flaky", func(context.Context) (bool, error) {
+			if failing {
+				return true, errors.New("TEST")
+			}
+			return true, nil
+		}))
+		Expect(g.check(ctx)).To(BeFalse())
+		Expect(g.IsOpen()).To(BeFalse())
+
+		failing = false
+		Expect(g.check(ctx)).To(BeTrue())
+		Expect(g.IsOpen()).To(BeTrue())
+	})
+
+	It("should depend on other gates", func() {
+		other := New("bar")
+		g := New("foo-gate", DependsOn("bar", IsOpen(other)))
+		Expect(g.check(ctx)).To(BeFalse())
+
+		Expect(other.check(ctx)).To(BeTrue())
+		Expect(g.check(ctx)).To(BeTrue())
+	})
+
+	It("should return the context error from Wait when the gate stays closed", func() {
+		g := New("foo-wait", DependsOn("never", IsSet(&Signal{})))
+		cancel()
+		Expect(g.Wait(ctx)).To(MatchError(context.Canceled))
+		Expect(g.Start(ctx)).To(Succeed())
+	})
+
+	Describe("Runnable", func() {
+		It("should only start the runnable once the gate is open", func() {
+			sig := &Signal{}
+			g := New("foo-runnable", DependsOn("sig", IsSet(sig)))
+			started := make(chan struct{})
+			r := Runnable(g, manager.RunnableFunc(func(context.Context) error {
+				close(started)
+				return nil
+			}))
+			Expect(r.(manager.LeaderElectionRunnable).NeedLeaderElection()).To(BeTrue())
+
+			done := make(chan error)
+			go func() { done <- r.Start(ctx) }()
+			Consistently(started).WithTimeout(20 * time.Millisecond).ShouldNot(BeClosed())
+
+			sig.Set()
+			g.check(ctx)
+			Eventually(started).Should(BeClosed())
+			Eventually(done).Should(Receive(BeNil()))
+		})
+
+		It("should return without starting the runnable when the context is done", func() {
+			g := New("foo-runnable-cancel", DependsOn("never", IsSet(&Signal{})))
+			r := Runnable(g, manager.RunnableFunc(func(context.Context) error {
+				Fail("runnable should not be started")
+				return nil
+			}))
+			cancel()
+			Expect(r.Start(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("Predicate", func() {
+		It("should drop events while the gate is closed", func() {
+			sig := &Signal{}
+			g := New("foo-predicate", DependsOn("sig", IsSet(sig)))
+			p := Predicate[client.Object](g)
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+
+			Expect(p.Create(event.CreateEvent{Object: pod})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: pod, ObjectNew: pod})).To(BeFalse())
+			Expect(testutil.ToFloat64(metrics.GateDroppedEvents.WithLabelValues("foo-predicate"))).To(Equal(2.0))
+
+			sig.Set()
+			g.check(ctx)
+			Expect(p.Create(event.CreateEvent{Object: pod})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: pod})).To(BeTrue())
+			Expect(testutil.ToFloat64(metrics.GateDroppedEvents.WithLabelValues("foo-predicate"))).To(Equal(2.0))
+		})
+	})
+
+	Describe("RegisterMetrics", func() {
+		It("should export the gate metrics with the registry, once", func() {
+			reg := prometheus.NewRegistry()
+			Expect(RegisterMetrics(reg)).To(Succeed())
+			Expect(RegisterMetrics(reg)).To(Succeed())
+
+			New("foo-metrics", DependsOn("sig", IsSet(&Signal{})))
+			Expect(testutil.GatherAndCount(reg, "gate_blocked")).To(BeNumerically(">", 0))
+		})
+	})
+
+	Describe("KindExists", func() {
+		It("should be satisfied once the kind is mapped", func() {
+			gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Bar"}
+			mapper := meta.NewDefaultRESTMapper(nil)
+			check := KindExists(mapper, gvk)
+
+			ok, err := check(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+
+			mapper.Add(gvk, meta.RESTScopeNamespace)
+			ok, err = check(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
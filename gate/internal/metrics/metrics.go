@@ -0,0 +1,52 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// GateBlocked is set to 1 for each dependency of a gate that is not satisfied yet,
+// with information {"gate", "dependency"}
+var GateBlocked = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "gate_blocked",
+	Help: "Whether a gate is blocked on a dependency (1) or not (0)",
+}, []string{"gate", "dependency"})
+
+// GateDroppedEvents counts events dropped by a gate predicate while the gate was closed,
+// with information {"gate"}
+var GateDroppedEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "gate_dropped_events_total",
+	Help: "Total number of events dropped because a gate was closed",
+}, []string{"gate"})
+
+// Register registers the gate metrics with reg. Metrics that are already registered
+// with reg are skipped.
+func Register(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{
+		GateBlocked,
+		GateDroppedEvents,
+	} {
+		if err := reg.Register(c); err != nil {
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if !errors.As(err, &alreadyRegistered) {
+				return err
+			}
+		}
+	}
+	return nil
+}
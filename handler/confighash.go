@@ -0,0 +1,203 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crtHandler "sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+// ConfigHashAnnotation is set by StampConfigHash on pod templates to the hash of the
+// ConfigMaps and Secrets their pods use, so that a change to them rolls the pods out.
+const ConfigHashAnnotation = "operator-lib.operatorframework.io/config-hash"
+
+// ConfigReferenceIndexField is the name of the field index registered by IndexConfigReferences.
+const ConfigReferenceIndexField = ".operator-lib.operatorframework.io/config-references"
+
+// ConfigReference identifies a ConfigMap or Secret in the namespace of the object referencing it.
+type ConfigReference struct {
+	// Kind is either "ConfigMap" or "Secret".
+	Kind string
+	// Name is the name of the ConfigMap or Secret.
+	Name string
+}
+
+// ConfigMapReference returns a ConfigReference to the ConfigMap with the given name.
+func ConfigMapReference(name string) ConfigReference {
+	return ConfigReference{Kind: "ConfigMap", Name: name}
+}
+
+// SecretReference returns a ConfigReference to the Secret with the given name.
+func SecretReference(name string) ConfigReference {
+	return ConfigReference{Kind: "Secret", Name: name}
+}
+
+// String returns the reference in the "Kind/name" form used as value of ConfigReferenceIndexField.
+func (r ConfigReference) String() string {
+	return r.Kind + "/" + r.Name
+}
+
+// ConfigHash returns a hash of the content of the ConfigMaps and Secrets referenced by refs in
+// namespace, read with reader. The hash does not depend on the order of refs. ConfigMaps and
+// Secrets that do not exist are hashed as such, so that their creation changes the hash.
+func ConfigHash(ctx context.Context, reader client.Reader, namespace string, refs ...ConfigReference) (string, error) {
+	sorted := make([]ConfigReference, len(refs))
+	copy(sorted, refs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].String() < sorted[j].String()
+	})
+
+	h := sha256.New()
+	for _, ref := range sorted {
+		var data map[string][]byte
+		switch ref.Kind {
+		case "ConfigMap":
+			cm := &corev1.ConfigMap{}
+			err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, cm)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return "", fmt.Errorf("error getting %s: %w", ref, err)
+			} else if err == nil {
+				data = make(map[string][]byte, len(cm.Data)+len(cm.BinaryData))
+				for k, v := range cm.Data {
+					data[k] = []byte(v)
+				}
+				for k, v := range cm.BinaryData {
+					data[k] = v
+				}
+			}
+		case "Secret":
+			secret := &corev1.Secret{}
+			err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, secret)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return "", fmt.Errorf("error getting %s: %w", ref, err)
+			} else if err == nil {
+				data = secret.Data
+				if data == nil {
+					data = map[string][]byte{}
+				}
+			}
+		default:
+			return "", fmt.Errorf("unsupported config reference kind %q", ref.Kind)
+		}
+		hashConfigData(h, ref, data)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashConfigData writes ref and data, or a marker if data is nil, to h, with each value prefixed
+// by its length so that different contents cannot produce the same input.
+func hashConfigData(h io.Writer, ref ConfigReference, data map[string][]byte) {
+	write := func(b []byte) {
+		_, _ = fmt.Fprintf(h, "%d:", len(b))
+		_, _ = h.Write(b)
+	}
+	write([]byte(ref.String()))
+	if data == nil {
+		write([]byte("<missing>"))
+		return
+	}
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	_, _ = fmt.Fprintf(h, "%d;", len(keys))
+	for _, k := range keys {
+		write([]byte(k))
+		write(data[k])
+	}
+}
+
+// StampConfigHash sets ConfigHashAnnotation on template to hash, typically computed with
+// ConfigHash, so that the pods of an operand are rolled out when their configuration changes.
+// It returns true if the annotation changed.
+func StampConfigHash(template *corev1.PodTemplateSpec, hash string) bool {
+	if template.Annotations[ConfigHashAnnotation] == hash {
+		return false
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[ConfigHashAnnotation] = hash
+	return true
+}
+
+// IndexConfigReferences registers a field index with indexer, typically the manager's field
+// indexer, that indexes the objects of obj's type by the ConfigMaps and Secrets refs returns for
+// them. It must be called before the manager starts, and allows EnqueueRequestsForConfig to find
+// the objects referencing a ConfigMap or Secret.
+func IndexConfigReferences(ctx context.Context, indexer client.FieldIndexer, obj client.Object, refs func(client.Object) []ConfigReference) error {
+	return indexer.IndexField(ctx, obj, ConfigReferenceIndexField, func(o client.Object) []string {
+		var values []string
+		for _, ref := range refs(o) {
+			values = append(values, ref.String())
+		}
+		return values
+	})
+}
+
+// EnqueueRequestsForConfig returns an event handler for ConfigMaps or Secrets that enqueues a
+// Request for every object of list's type in the same namespace that references the changed
+// ConfigMap or Secret, as indexed by IndexConfigReferences. Objects are listed with reader,
+// typically the manager's cache. Together with ConfigHash and StampConfigHash, this covers the
+// "restart on config change" pattern:
+//
+//	if err := handler.IndexConfigReferences(ctx, mgr.GetFieldIndexer(), &myv1.MyApp{}, myAppConfigs); err != nil {
+//		return err
+//	}
+//	err := ctrl.NewControllerManagedBy(mgr).For(&myv1.MyApp{}).
+//		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsForConfig[client.Object](mgr.GetCache(), &myv1.MyAppList{})).
+//		Complete(r)
+//
+// If the objects cannot be listed, the error is logged and no requests are enqueued.
+func EnqueueRequestsForConfig[T client.Object](reader client.Reader, list client.ObjectList) crtHandler.TypedEventHandler[T, reconcile.Request] {
+	return crtHandler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, obj T) []reconcile.Request {
+		var kind string
+		switch any(obj).(type) {
+		case *corev1.ConfigMap:
+			kind = "ConfigMap"
+		case *corev1.Secret:
+			kind = "Secret"
+		default:
+			kind = obj.GetObjectKind().GroupVersionKind().Kind
+		}
+		ref := ConfigReference{Kind: kind, Name: obj.GetName()}
+
+		keys, err := ListerFor(reader, list, client.InNamespace(obj.GetNamespace()),
+			client.MatchingFields{ConfigReferenceIndexField: ref.String()})(ctx)
+		if err != nil {
+			log.Error(err, "Unable to list objects referencing config", "config", ref, "namespace", obj.GetNamespace())
+			libmetrics.RecordError(libmetrics.SubsystemHandler, "list_failed")
+			return nil
+		}
+		reqs := make([]reconcile.Request, 0, len(keys))
+		for _, key := range keys {
+			reqs = append(reqs, reconcile.Request{NamespacedName: key})
+		}
+		return reqs
+	})
+}
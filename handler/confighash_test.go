@@ -0,0 +1,147 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Config hash", func() {
+	ctx := context.TODO()
+
+	var (
+		c         client.Client
+		configMap *corev1.ConfigMap
+		secret    *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app-config"},
+			Data:       map[string]string{"log-level": "info"},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app-credentials"},
+			Data:       map[string][]byte{"password": []byte("churro")},
+		}
+		c = fake.NewClientBuilder().WithObjects(configMap, secret).Build()
+	})
+
+	Describe("ConfigHash", func() {
+		It("should not depend on the order of references", func() {
+			h1, err := ConfigHash(ctx, c, "default", ConfigMapReference("app-config"), SecretReference("app-credentials"))
+			Expect(err).NotTo(HaveOccurred())
+			h2, err := ConfigHash(ctx, c, "default", SecretReference("app-credentials"), ConfigMapReference("app-config"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(h1).To(Equal(h2))
+		})
+
+		It("should change when the content of a reference changes", func() {
+			before, err := ConfigHash(ctx, c, "default", ConfigMapReference("app-config"), SecretReference("app-credentials"))
+			Expect(err).NotTo(HaveOccurred())
+
+			secret.Data["password"] = []byte("tortilla")
+			Expect(c.Update(ctx, secret)).To(Succeed())
+			after, err := ConfigHash(ctx, c, "default", ConfigMapReference("app-config"), SecretReference("app-credentials"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after).NotTo(Equal(before))
+		})
+
+		It("should change when a missing reference is created", func() {
+			before, err := ConfigHash(ctx, c, "default", ConfigMapReference("extra-config"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "extra-config"}})).To(Succeed())
+			after, err := ConfigHash(ctx, c, "default", ConfigMapReference("extra-config"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after).NotTo(Equal(before))
+		})
+
+		It("should return an error for unsupported kinds", func() {
+			_, err := ConfigHash(ctx, c, "default", ConfigReference{Kind: "Pod", Name: "churro"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("StampConfigHash", func() {
+		It("should set the annotation on the pod template", func() {
+			deployment := &appsv1.Deployment{}
+			Expect(StampConfigHash(&deployment.Spec.Template, "abc")).To(BeTrue())
+			Expect(deployment.Spec.Template.Annotations).To(HaveKeyWithValue(ConfigHashAnnotation, "abc"))
+			Expect(StampConfigHash(&deployment.Spec.Template, "abc")).To(BeFalse())
+			Expect(StampConfigHash(&deployment.Spec.Template, "def")).To(BeTrue())
+		})
+	})
+
+	Describe("EnqueueRequestsForConfig", func() {
+		It("should enqueue the objects referencing the changed config", func() {
+			refs := func(obj client.Object) []ConfigReference {
+				var refs []ConfigReference
+				for _, vol := range obj.(*corev1.Pod).Spec.Volumes {
+					if vol.ConfigMap != nil {
+						refs = append(refs, ConfigMapReference(vol.ConfigMap.Name))
+					}
+					if vol.Secret != nil {
+						refs = append(refs, SecretReference(vol.Secret.SecretName))
+					}
+				}
+				return refs
+			}
+			withConfigMap := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "with-config"},
+				Spec: corev1.PodSpec{Volumes: []corev1.Volume{{Name: "config", VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "app-config"}},
+				}}}},
+			}
+			withSecret := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "with-secret"},
+				Spec: corev1.PodSpec{Volumes: []corev1.Volume{{Name: "secret", VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: "app-config"},
+				}}}},
+			}
+			otherNamespace := withConfigMap.DeepCopy()
+			otherNamespace.Namespace = "other"
+
+			cl := fake.NewClientBuilder().WithObjects(withConfigMap, withSecret, otherNamespace).
+				WithIndex(&corev1.Pod{}, ConfigReferenceIndexField, func(obj client.Object) []string {
+					var values []string
+					for _, ref := range refs(obj) {
+						values = append(values, ref.String())
+					}
+					return values
+				}).Build()
+
+			q := &controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+			h := EnqueueRequestsForConfig[client.Object](cl, &corev1.PodList{})
+			h.Update(ctx, event.UpdateEvent{ObjectOld: configMap, ObjectNew: configMap}, q)
+			Expect(q.Len()).To(Equal(1))
+			req, _ := q.Get()
+			Expect(req.NamespacedName).To(Equal(types.NamespacedName{Namespace: "default", Name: "with-config"}))
+		})
+	})
+})
@@ -0,0 +1,166 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// CorrelationIDAnnotation is set by StampCorrelationID on the dependents created by a
+// reconciliation, to the correlation ID of that reconciliation.
+const CorrelationIDAnnotation = "operator-lib.operatorframework.io/correlation-id"
+
+// DefaultMaxCorrelatedRequests is the number of requests a CorrelationTracker remembers the
+// correlation ID of when no bound is provided.
+const DefaultMaxCorrelatedRequests = 4096
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a copy of ctx carrying the correlation ID id.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFrom returns the correlation ID carried by ctx, if any.
+func CorrelationIDFrom(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(correlationIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// StampCorrelationID sets CorrelationIDAnnotation on obj, typically a dependent about to be
+// created, to the correlation ID carried by ctx, and returns it. Reconcilers wrapped with
+// CorrelationTracker.Reconciler always receive a correlation ID, so that all the dependents of a
+// reconciliation get the same one. If ctx carries none, obj is left unchanged and an empty string
+// is returned.
+func StampCorrelationID(ctx context.Context, obj client.Object) string {
+	id, ok := CorrelationIDFrom(ctx)
+	if !ok {
+		return ""
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[CorrelationIDAnnotation] = id
+	obj.SetAnnotations(annotations)
+	return id
+}
+
+// CorrelationTracker links the requests enqueued for events of objects carrying a
+// CorrelationIDAnnotation to the reconciliation of those requests. Event handlers wrapped
+// with Correlate remember the correlation ID of the object of each event for the requests they
+// enqueue, and reconcilers wrapped with Reconciler receive it in their context, see
+// CorrelationIDFrom, and in their logger. Together with StampCorrelationID, this allows tracing
+// which reconciliation created a dependent, and which dependent event triggered a reconciliation,
+// e.g. through EnqueueRequestForAnnotation.
+//
+// When several events with different correlation IDs are coalesced into one request, the
+// reconciliation receives the correlation ID of the last one.
+type CorrelationTracker struct {
+	ids *lru.Cache
+}
+
+// NewCorrelationTracker returns a CorrelationTracker that remembers the correlation IDs of at
+// most maxRequests requests that have not been reconciled yet, forgetting the least recently
+// enqueued first. If maxRequests is not positive, DefaultMaxCorrelatedRequests is used.
+func NewCorrelationTracker(maxRequests int) *CorrelationTracker {
+	if maxRequests <= 0 {
+		maxRequests = DefaultMaxCorrelatedRequests
+	}
+	return &CorrelationTracker{ids: lru.New(maxRequests)}
+}
+
+// Correlate returns an event handler that passes all events to h, and remembers the correlation ID
+// of the object of each event for the requests h enqueues.
+func Correlate[T client.Object](t *CorrelationTracker, h handler.TypedEventHandler[T, reconcile.Request]) handler.TypedEventHandler[T, reconcile.Request] {
+	return handler.TypedFuncs[T, reconcile.Request]{
+		CreateFunc: func(ctx context.Context, evt event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			h.Create(ctx, evt, t.queue(q, evt.Object))
+		},
+		UpdateFunc: func(ctx context.Context, evt event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			var obj client.Object = evt.ObjectNew
+			if obj == nil {
+				obj = evt.ObjectOld
+			}
+			h.Update(ctx, evt, t.queue(q, obj))
+		},
+		DeleteFunc: func(ctx context.Context, evt event.TypedDeleteEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			h.Delete(ctx, evt, t.queue(q, evt.Object))
+		},
+		GenericFunc: func(ctx context.Context, evt event.TypedGenericEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			h.Generic(ctx, evt, t.queue(q, evt.Object))
+		},
+	}
+}
+
+// Reconciler returns a reconciler that calls r with a correlation ID in its context and logger:
+// the correlation ID remembered for the request, if any, or a new one generated once for the
+// reconciliation otherwise.
+func (t *CorrelationTracker) Reconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		id := string(uuid.NewUUID())
+		if v, ok := t.ids.Get(req); ok {
+			t.ids.Remove(req)
+			id = v.(string)
+		}
+		ctx = WithCorrelationID(ctx, id)
+		ctx = logf.IntoContext(ctx, logf.FromContext(ctx).WithValues("correlationID", id))
+		return r.Reconcile(ctx, req)
+	})
+}
+
+// queue returns q, wrapped to remember the correlation ID of obj for the requests added to it.
+func (t *CorrelationTracker) queue(q workqueue.TypedRateLimitingInterface[reconcile.Request], obj client.Object) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	if obj == nil {
+		return q
+	}
+	id, ok := obj.GetAnnotations()[CorrelationIDAnnotation]
+	if !ok || id == "" {
+		return q
+	}
+	return &correlatingQueue{TypedRateLimitingInterface: q, tracker: t, id: id}
+}
+
+// correlatingQueue remembers its correlation ID for the requests added to it.
+type correlatingQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	tracker *CorrelationTracker
+	id      string
+}
+
+func (q *correlatingQueue) Add(req reconcile.Request) {
+	q.tracker.ids.Add(req, q.id)
+	q.TypedRateLimitingInterface.Add(req)
+}
+
+func (q *correlatingQueue) AddAfter(req reconcile.Request, duration time.Duration) {
+	q.tracker.ids.Add(req, q.id)
+	q.TypedRateLimitingInterface.AddAfter(req, duration)
+}
+
+func (q *correlatingQueue) AddRateLimited(req reconcile.Request) {
+	q.tracker.ids.Add(req, q.id)
+	q.TypedRateLimitingInterface.AddRateLimited(req)
+}
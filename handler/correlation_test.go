@@ -0,0 +1,140 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Correlation IDs", func() {
+	ctx := context.TODO()
+
+	Describe("StampCorrelationID", func() {
+		It("should stamp the correlation ID of the context", func() {
+			cm := &corev1.ConfigMap{}
+			Expect(StampCorrelationID(WithCorrelationID(ctx, "abc"), cm)).To(Equal("abc"))
+			Expect(cm.GetAnnotations()).To(HaveKeyWithValue(CorrelationIDAnnotation, "abc"))
+		})
+
+		It("should keep the annotations of the object if the context has no correlation ID", func() {
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"foo": "bar"}}}
+			Expect(StampCorrelationID(ctx, cm)).To(BeEmpty())
+			Expect(cm.GetAnnotations()).To(Equal(map[string]string{"foo": "bar"}))
+		})
+	})
+
+	Describe("CorrelationTracker", func() {
+		var (
+			q       workqueue.TypedRateLimitingInterface[reconcile.Request]
+			tracker *CorrelationTracker
+			owner   *corev1.Pod
+			seen    map[reconcile.Request]string
+			stamped []*corev1.ConfigMap
+			r       reconcile.Reconciler
+		)
+
+		BeforeEach(func() {
+			q = &controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+			tracker = NewCorrelationTracker(0)
+			owner = &corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "owner"},
+			}
+			seen = map[reconcile.Request]string{}
+			stamped = nil
+			r = tracker.Reconciler(reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+				id, _ := CorrelationIDFrom(ctx)
+				seen[req] = id
+				for i := 0; i < 2; i++ {
+					cm := &corev1.ConfigMap{}
+					StampCorrelationID(ctx, cm)
+					stamped = append(stamped, cm)
+				}
+				return reconcile.Result{}, nil
+			}))
+		})
+
+		newDependent := func() *corev1.ConfigMap {
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "dependent"}}
+			Expect(SetOwnerAnnotations(owner, cm)).To(Succeed())
+			return cm
+		}
+
+		ownerRequest := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "owner"}}
+
+		It("should pass the correlation ID of the dependent to the reconciliation of its owner", func() {
+			h := Correlate[client.Object](tracker, &EnqueueRequestForAnnotation[client.Object]{Type: schema.GroupKind{Kind: "Pod"}})
+
+			dependent := newDependent()
+			StampCorrelationID(WithCorrelationID(ctx, "abc"), dependent)
+			h.Create(ctx, event.CreateEvent{Object: dependent}, q)
+			Expect(q.Len()).To(Equal(1))
+
+			req, _ := q.Get()
+			Expect(req).To(Equal(ownerRequest))
+			Expect(r.Reconcile(ctx, req)).To(Equal(reconcile.Result{}))
+			Expect(seen).To(HaveKeyWithValue(ownerRequest, "abc"))
+
+			By("forgetting the correlation ID once the request was reconciled")
+			Expect(r.Reconcile(ctx, req)).To(Equal(reconcile.Result{}))
+			Expect(seen[ownerRequest]).NotTo(BeEmpty())
+			Expect(seen[ownerRequest]).NotTo(Equal("abc"))
+		})
+
+		It("should use the correlation ID of the last coalesced event", func() {
+			h := Correlate[client.Object](tracker, &EnqueueRequestForAnnotation[client.Object]{Type: schema.GroupKind{Kind: "Pod"}})
+
+			first, second := newDependent(), newDependent()
+			StampCorrelationID(WithCorrelationID(ctx, "first"), first)
+			StampCorrelationID(WithCorrelationID(ctx, "second"), second)
+			h.Create(ctx, event.CreateEvent{Object: first}, q)
+			h.Update(ctx, event.UpdateEvent{ObjectOld: first, ObjectNew: second}, q)
+			Expect(q.Len()).To(Equal(1))
+
+			req, _ := q.Get()
+			Expect(r.Reconcile(ctx, req)).To(Equal(reconcile.Result{}))
+			Expect(seen).To(HaveKeyWithValue(ownerRequest, "second"))
+		})
+
+		It("should generate one correlation ID per reconciliation for events of objects without one", func() {
+			h := Correlate[client.Object](tracker, &EnqueueRequestForAnnotation[client.Object]{Type: schema.GroupKind{Kind: "Pod"}})
+
+			h.Create(ctx, event.CreateEvent{Object: newDependent()}, q)
+			req, _ := q.Get()
+			Expect(r.Reconcile(ctx, req)).To(Equal(reconcile.Result{}))
+			id := seen[ownerRequest]
+			Expect(id).NotTo(BeEmpty())
+			Expect(stamped).To(HaveLen(2))
+			for _, cm := range stamped {
+				Expect(cm.GetAnnotations()).To(HaveKeyWithValue(CorrelationIDAnnotation, id))
+			}
+
+			Expect(r.Reconcile(ctx, req)).To(Equal(reconcile.Result{}))
+			Expect(seen[ownerRequest]).NotTo(Equal(id))
+		})
+	})
+})
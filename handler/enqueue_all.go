@@ -0,0 +1,132 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	crtHandler "sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+// ListFunc returns the keys of all the objects that should be reconciled.
+type ListFunc func(ctx context.Context) ([]types.NamespacedName, error)
+
+// ListerFor returns a ListFunc that lists the objects of list's type using reader, typically the
+// manager's cache, restricted by the provided list options such as client.MatchingLabels.
+func ListerFor(reader client.Reader, list client.ObjectList, opts ...client.ListOption) ListFunc {
+	return func(ctx context.Context) ([]types.NamespacedName, error) {
+		objList := list.DeepCopyObject().(client.ObjectList)
+		if err := reader.List(ctx, objList, opts...); err != nil {
+			return nil, err
+		}
+
+		items, err := meta.ExtractList(objList)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]types.NamespacedName, 0, len(items))
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				return nil, fmt.Errorf("unexpected list item of type %T", item)
+			}
+			keys = append(keys, client.ObjectKeyFromObject(obj))
+		}
+		return keys, nil
+	}
+}
+
+// EnqueueAllOption configures the event handler returned by EnqueueAllOf.
+type EnqueueAllOption func(*enqueueAllOf)
+
+// WithBatching spreads the requests enqueued for a single event over time: the first size
+// requests are enqueued immediately, the next size requests after interval, and so on.
+// This protects the API server and the controller from a thundering herd when a change to a
+// shared object affects a large fleet of primary resources.
+func WithBatching(size int, interval time.Duration) EnqueueAllOption {
+	return func(e *enqueueAllOf) {
+		e.batchSize = size
+		e.batchInterval = interval
+	}
+}
+
+// EnqueueAllOf returns an event handler that enqueues a Request for every object returned by list
+// whenever an event occurs. It is intended for watches on shared objects that affect all instances of a
+// primary resource, such as a global ConfigMap, a Secret, or a singleton configuration CR:
+//
+//	cfgHandler := handler.EnqueueAllOf[*corev1.ConfigMap](
+//		handler.ListerFor(mgr.GetCache(), &myv1.MyAppList{}, client.MatchingLabels{"tier": "prod"}),
+//		handler.WithBatching(50, time.Second),
+//	)
+//
+// Requests that are already queued are deduplicated by the workqueue, so bursts of changes to the shared
+// object do not multiply the number of reconciles. If list returns an error, it is logged and no
+// requests are enqueued.
+func EnqueueAllOf[T client.Object](list ListFunc, opts ...EnqueueAllOption) crtHandler.TypedEventHandler[T, reconcile.Request] {
+	e := &enqueueAllOf{list: list}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return crtHandler.TypedFuncs[T, reconcile.Request]{
+		CreateFunc: func(ctx context.Context, _ event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			e.enqueueAll(ctx, q)
+		},
+		UpdateFunc: func(ctx context.Context, _ event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			e.enqueueAll(ctx, q)
+		},
+		DeleteFunc: func(ctx context.Context, _ event.TypedDeleteEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			e.enqueueAll(ctx, q)
+		},
+		GenericFunc: func(ctx context.Context, _ event.TypedGenericEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			e.enqueueAll(ctx, q)
+		},
+	}
+}
+
+type enqueueAllOf struct {
+	list          ListFunc
+	batchSize     int
+	batchInterval time.Duration
+}
+
+func (e *enqueueAllOf) enqueueAll(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	keys, err := e.list(ctx)
+	if err != nil {
+		log.Error(err, "Unable to list objects to enqueue")
+		libmetrics.RecordError(libmetrics.SubsystemHandler, "list_failed")
+		return
+	}
+
+	for i, key := range keys {
+		req := reconcile.Request{NamespacedName: key}
+		if e.batchSize <= 0 || e.batchInterval <= 0 || i < e.batchSize {
+			q.Add(req)
+			continue
+		}
+		q.AddAfter(req, time.Duration(i/e.batchSize)*e.batchInterval)
+	}
+}
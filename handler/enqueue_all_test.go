@@ -0,0 +1,125 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// delayRecordingQueue records the delay of the items added with AddAfter.
+type delayRecordingQueue struct {
+	controllertest.Queue
+	delays map[reconcile.Request]time.Duration
+}
+
+func (q *delayRecordingQueue) AddAfter(item reconcile.Request, duration time.Duration) {
+	q.delays[item] = duration
+	q.Queue.AddAfter(item, duration)
+}
+
+var _ = Describe("EnqueueAllOf", func() {
+	ctx := context.TODO()
+
+	var (
+		q      *delayRecordingQueue
+		cl     client.Client
+		config *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		q = &delayRecordingQueue{
+			Queue:  controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()},
+			delays: map[reconcile.Request]time.Duration{},
+		}
+
+		builder := fake.NewClientBuilder()
+		for i := 0; i < 5; i++ {
+			tier := "prod"
+			if i%2 == 1 {
+				tier = "dev"
+			}
+			builder.WithObjects(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("app-%d", i),
+				Namespace: "default",
+				Labels:    map[string]string{"tier": tier},
+			}})
+		}
+		cl = builder.Build()
+
+		config = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "global", Namespace: "operator"}}
+	})
+
+	It("should enqueue a Request for every listed object on each event type", func() {
+		h := EnqueueAllOf[client.Object](ListerFor(cl, &corev1.PodList{}))
+
+		h.Create(ctx, event.CreateEvent{Object: config}, q)
+		Expect(q.Len()).To(Equal(5))
+
+		for _, evt := range []func(){
+			func() { h.Update(ctx, event.UpdateEvent{ObjectOld: config, ObjectNew: config}, q) },
+			func() { h.Delete(ctx, event.DeleteEvent{Object: config}, q) },
+			func() { h.Generic(ctx, event.GenericEvent{Object: config}, q) },
+		} {
+			q.Queue = controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+			evt()
+			Expect(q.Len()).To(Equal(5))
+		}
+	})
+
+	It("should only enqueue the objects matching the list options", func() {
+		h := EnqueueAllOf[client.Object](ListerFor(cl, &corev1.PodList{}, client.MatchingLabels{"tier": "prod"}))
+
+		h.Create(ctx, event.CreateEvent{Object: config}, q)
+		Expect(q.Len()).To(Equal(3))
+
+		i, _ := q.Get()
+		Expect(i).To(Equal(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "app-0"}}))
+	})
+
+	It("should spread requests over time in batches", func() {
+		h := EnqueueAllOf[client.Object](ListerFor(cl, &corev1.PodList{}), WithBatching(2, time.Second))
+
+		h.Create(ctx, event.CreateEvent{Object: config}, q)
+		Expect(q.Len()).To(Equal(5))
+		Expect(q.delays).To(Equal(map[reconcile.Request]time.Duration{
+			{NamespacedName: types.NamespacedName{Namespace: "default", Name: "app-2"}}: time.Second,
+			{NamespacedName: types.NamespacedName{Namespace: "default", Name: "app-3"}}: time.Second,
+			{NamespacedName: types.NamespacedName{Namespace: "default", Name: "app-4"}}: 2 * time.Second,
+		}))
+	})
+
+	It("should not enqueue anything if listing fails", func() {
+		h := EnqueueAllOf[client.Object](func(context.Context) ([]types.NamespacedName, error) {
+			return nil, fmt.Errorf("TEST")
+		})
+
+		h.Create(ctx, event.CreateEvent{Object: config}, q)
+		Expect(q.Len()).To(Equal(0))
+	})
+})
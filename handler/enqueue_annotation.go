@@ -41,6 +41,25 @@ const (
 	// resource containing this annotation changes. Valid values are of the form `<Kind>` for resource in the
 	// core group, and `<Kind>.<group>` for all other resources.
 	TypeAnnotation = "operator-sdk/primary-resource-type"
+	// PreviousNamespacedNameAnnotation is an annotation whose value encodes the name and namespace of the
+	// resource that owned an object before its ownership was transferred with TransferOwnerAnnotations. It
+	// uses the same format as NamespacedNameAnnotation.
+	PreviousNamespacedNameAnnotation = "operator-lib.operatorframework.io/previous-primary-resource"
+)
+
+// OwnerTransferPolicy controls which owners are enqueued by EnqueueRequestForAnnotation when an UpdateEvent
+// shows that the owner annotations of an object changed from one owner to another.
+type OwnerTransferPolicy string
+
+const (
+	// OwnerTransferEnqueueBoth enqueues both the previous and the new owner. This is the default.
+	OwnerTransferEnqueueBoth OwnerTransferPolicy = ""
+	// OwnerTransferEnqueueNew only enqueues the new owner; the previous owner is not notified of the transfer.
+	OwnerTransferEnqueueNew OwnerTransferPolicy = "New"
+	// OwnerTransferEnqueueMarked always enqueues the new owner, and only enqueues the previous owner when the
+	// new object carries a PreviousNamespacedNameAnnotation naming it, i.e. when the handoff was recorded with
+	// TransferOwnerAnnotations.
+	OwnerTransferEnqueueMarked OwnerTransferPolicy = "Marked"
 )
 
 // EnqueueRequestForAnnotation enqueues Request containing the Name and Namespace specified in the
@@ -81,40 +100,108 @@ const (
 // if a parent creates a child resource across scopes not supported by owner references, it becomes the
 // responsibility of the reconciler to clean up the child resource. Hence, the resource utilizing this handler
 // SHOULD ALWAYS BE IMPLEMENTED WITH A FINALIZER.
+//
+// When an UpdateEvent shows that the annotations of an object moved from one owner to another, both owners
+// are enqueued by default so that the previous owner can observe that it lost the object. Set OwnerTransfer
+// to change this behavior, for example during migrations where previous owners are stale and should not be
+// reconciled.
+//
+// Set RateLimiter, ex. to NewOwnerRateLimiter, to delay the requests of owners whose dependents change
+// very often, without delaying the requests of other owners.
+//
+// Set IgnoreRecreated to ignore the Create, Update and Generic events of dependents recreated with the
+// same name but a new UID, see IsRecreated, until their owner stamps them again with StampDependentUID.
+// The Delete event of the original dependent still enqueues the owner, which can then adopt or delete
+// the recreated dependent.
+//
+// Set Owners, see NewOwnerChecker, to stop enqueueing requests for owners that no longer exist,
+// e.g. for the Delete events of the dependents of a deleted owner, which would otherwise cause
+// reconciliations failing with NotFound. Requests of missing owners are dropped, or added to
+// MissingOwnerQueue if it is set, and counted in the handler_missing_owner_requests_total metric,
+// see RegisterMetrics. Owners that are being deleted still exist, and are enqueued so that their
+// finalizers can run.
 type EnqueueRequestForAnnotation[T client.Object] struct {
 	Type schema.GroupKind
+
+	// OwnerTransfer determines which owners are enqueued when the owner of an object changes.
+	// Defaults to OwnerTransferEnqueueBoth.
+	OwnerTransfer OwnerTransferPolicy
+
+	// RateLimiter, if set, determines how long each request waits before being added to the queue.
+	RateLimiter workqueue.TypedRateLimiter[reconcile.Request]
+
+	// IgnoreRecreated ignores events of dependents that were recreated without being stamped again
+	// by their owner.
+	IgnoreRecreated bool
+
+	// Owners, if set, is used to check that owners exist before enqueueing their requests.
+	Owners *OwnerChecker
+
+	// MissingOwnerQueue, if set, receives the requests of owners that do not exist, ex. to clean up
+	// their dependents, instead of dropping them. Requires Owners.
+	MissingOwnerQueue workqueue.TypedInterface[reconcile.Request]
 }
 
 var _ crtHandler.TypedEventHandler[client.Object, reconcile.Request] = &EnqueueRequestForAnnotation[client.Object]{}
 
 // Create implements EventHandler
-func (e *EnqueueRequestForAnnotation[T]) Create(_ context.Context, evt event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+func (e *EnqueueRequestForAnnotation[T]) Create(ctx context.Context, evt event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if isRecreated(e.IgnoreRecreated, evt.Object) {
+		return
+	}
 	if ok, req := e.getAnnotationRequests(evt.Object); ok {
-		q.Add(req)
+		e.enqueue(ctx, rateLimitQueue(q, e.RateLimiter), req)
 	}
 }
 
 // Update implements EventHandler
-func (e *EnqueueRequestForAnnotation[T]) Update(_ context.Context, evt event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
-	if ok, req := e.getAnnotationRequests(evt.ObjectOld); ok {
-		q.Add(req)
+func (e *EnqueueRequestForAnnotation[T]) Update(ctx context.Context, evt event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if isRecreated(e.IgnoreRecreated, evt.ObjectNew) {
+		return
+	}
+	oldOk, oldReq := e.getAnnotationRequests(evt.ObjectOld)
+	newOk, newReq := e.getAnnotationRequests(evt.ObjectNew)
+	q = rateLimitQueue(q, e.RateLimiter)
+
+	if oldOk && (!newOk || oldReq == newReq || e.shouldEnqueuePreviousOwner(evt.ObjectNew, oldReq)) {
+		e.enqueue(ctx, q, oldReq)
 	}
-	if ok, req := e.getAnnotationRequests(evt.ObjectNew); ok {
-		q.Add(req)
+	if newOk {
+		e.enqueue(ctx, q, newReq)
+	}
+}
+
+// shouldEnqueuePreviousOwner reports whether the previous owner of an object whose ownership was transferred
+// should be enqueued, according to the handler's OwnerTransferPolicy.
+func (e *EnqueueRequestForAnnotation[T]) shouldEnqueuePreviousOwner(object metav1.Object, previous reconcile.Request) bool {
+	switch e.OwnerTransfer {
+	case OwnerTransferEnqueueNew:
+		return false
+	case OwnerTransferEnqueueMarked:
+		previousString, ok := object.GetAnnotations()[PreviousNamespacedNameAnnotation]
+		if !ok || strings.TrimSpace(previousString) == "" {
+			return false
+		}
+		return parseNamespacedName(previousString) == previous.NamespacedName
+	default:
+		return true
 	}
 }
 
 // Delete implements EventHandler
-func (e *EnqueueRequestForAnnotation[T]) Delete(_ context.Context, evt event.TypedDeleteEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+func (e *EnqueueRequestForAnnotation[T]) Delete(ctx context.Context, evt event.TypedDeleteEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	if ok, req := e.getAnnotationRequests(evt.Object); ok {
-		q.Add(req)
+		e.enqueue(ctx, rateLimitQueue(q, e.RateLimiter), req)
 	}
 }
 
 // Generic implements EventHandler
-func (e *EnqueueRequestForAnnotation[T]) Generic(_ context.Context, evt event.TypedGenericEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+func (e *EnqueueRequestForAnnotation[T]) Generic(ctx context.Context, evt event.TypedGenericEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if isRecreated(e.IgnoreRecreated, evt.Object) {
+		return
+	}
 	if ok, req := e.getAnnotationRequests(evt.Object); ok {
-		q.Add(req)
+		e.enqueue(ctx, rateLimitQueue(q, e.RateLimiter), req)
 	}
 }
 
@@ -179,3 +266,25 @@ func SetOwnerAnnotations(owner, object client.Object) error {
 
 	return nil
 }
+
+// TransferOwnerAnnotations moves object to newOwner in the same way as SetOwnerAnnotations, and records the
+// owner found in the existing annotations of object in PreviousNamespacedNameAnnotation. This marks the
+// handoff so that an EnqueueRequestForAnnotation configured with OwnerTransferEnqueueMarked notifies the
+// previous owner. If object has no owner annotations, any stale PreviousNamespacedNameAnnotation is removed.
+func TransferOwnerAnnotations(newOwner, object client.Object) error {
+	previous := object.GetAnnotations()[NamespacedNameAnnotation]
+
+	if err := SetOwnerAnnotations(newOwner, object); err != nil {
+		return err
+	}
+
+	annotations := object.GetAnnotations()
+	if strings.TrimSpace(previous) == "" || previous == annotations[NamespacedNameAnnotation] {
+		delete(annotations, PreviousNamespacedNameAnnotation)
+	} else {
+		annotations[PreviousNamespacedNameAnnotation] = previous
+	}
+	object.SetAnnotations(annotations)
+
+	return nil
+}
@@ -359,6 +359,106 @@ var _ = Describe("EnqueueRequestForAnnotation", func() {
 		})
 	})
 
+	Describe("Update with an owner transfer", func() {
+		var newPod *corev1.Pod
+		var newOwner *corev1.Pod
+
+		BeforeEach(func() {
+			newOwner = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "newOwnerNs",
+					Name:      "newOwnerName",
+				},
+			}
+			newOwner.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Kind: "Pod"})
+
+			newPod = pod.DeepCopy()
+			Expect(SetOwnerAnnotations(newOwner, newPod)).To(Succeed())
+		})
+
+		It("should enqueue both owners by default", func() {
+			evt := event.UpdateEvent{
+				ObjectOld: pod,
+				ObjectNew: newPod,
+			}
+			instance.Update(ctx, evt, q)
+			Expect(q.Len()).To(Equal(2))
+		})
+		It("should only enqueue the new owner with OwnerTransferEnqueueNew", func() {
+			instance.OwnerTransfer = OwnerTransferEnqueueNew
+			evt := event.UpdateEvent{
+				ObjectOld: pod,
+				ObjectNew: newPod,
+			}
+			instance.Update(ctx, evt, q)
+			Expect(q.Len()).To(Equal(1))
+
+			i, _ := q.Get()
+			Expect(i).To(Equal(reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: newOwner.Namespace,
+					Name:      newOwner.Name,
+				},
+			}))
+		})
+		It("should still enqueue the owner with OwnerTransferEnqueueNew when ownership does not change", func() {
+			instance.OwnerTransfer = OwnerTransferEnqueueNew
+			evt := event.UpdateEvent{
+				ObjectOld: pod,
+				ObjectNew: pod.DeepCopy(),
+			}
+			instance.Update(ctx, evt, q)
+			Expect(q.Len()).To(Equal(1))
+		})
+		It("should not enqueue the previous owner with OwnerTransferEnqueueMarked when the handoff is not marked", func() {
+			instance.OwnerTransfer = OwnerTransferEnqueueMarked
+			evt := event.UpdateEvent{
+				ObjectOld: pod,
+				ObjectNew: newPod,
+			}
+			instance.Update(ctx, evt, q)
+			Expect(q.Len()).To(Equal(1))
+
+			i, _ := q.Get()
+			Expect(i).To(Equal(reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: newOwner.Namespace,
+					Name:      newOwner.Name,
+				},
+			}))
+		})
+		It("should enqueue both owners with OwnerTransferEnqueueMarked when the handoff is marked", func() {
+			instance.OwnerTransfer = OwnerTransferEnqueueMarked
+			newPod = pod.DeepCopy()
+			Expect(TransferOwnerAnnotations(newOwner, newPod)).To(Succeed())
+
+			evt := event.UpdateEvent{
+				ObjectOld: pod,
+				ObjectNew: newPod,
+			}
+			instance.Update(ctx, evt, q)
+			Expect(q.Len()).To(Equal(2))
+		})
+		It("should enqueue the previous owner when the new object has no owner annotations", func() {
+			instance.OwnerTransfer = OwnerTransferEnqueueNew
+			newPod.Annotations = map[string]string{}
+			evt := event.UpdateEvent{
+				ObjectOld: pod,
+				ObjectNew: newPod,
+			}
+			instance.Update(ctx, evt, q)
+			Expect(q.Len()).To(Equal(1))
+
+			i, _ := q.Get()
+			Expect(i).To(Equal(reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: podOwner.Namespace,
+					Name:      podOwner.Name,
+				},
+			}))
+		})
+	})
+
 	Describe("Generic", func() {
 		It("should enqueue a Request with the annotations of the object in case of GenericEvent", func() {
 			evt := event.GenericEvent{
@@ -377,6 +477,41 @@ var _ = Describe("EnqueueRequestForAnnotation", func() {
 		})
 	})
 
+	Describe("TransferOwnerAnnotations", func() {
+		It("should record the previous owner when the owner changes", func() {
+			newOwner := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "newOwnerNs",
+					Name:      "newOwnerName",
+				},
+			}
+			newOwner.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Kind: "Pod"})
+
+			Expect(TransferOwnerAnnotations(newOwner, pod)).To(Succeed())
+			Expect(pod.GetAnnotations()).To(HaveKeyWithValue(NamespacedNameAnnotation, "newOwnerNs/newOwnerName"))
+			Expect(pod.GetAnnotations()).To(HaveKeyWithValue(PreviousNamespacedNameAnnotation, "podOwnerNs/podOwnerName"))
+		})
+		It("should not record a previous owner when the object has none", func() {
+			nd := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+					Annotations: map[string]string{
+						PreviousNamespacedNameAnnotation: "stale/owner",
+					},
+				},
+			}
+
+			Expect(TransferOwnerAnnotations(podOwner, nd)).To(Succeed())
+			Expect(nd.GetAnnotations()).To(HaveKeyWithValue(NamespacedNameAnnotation, "podOwnerNs/podOwnerName"))
+			Expect(nd.GetAnnotations()).NotTo(HaveKey(PreviousNamespacedNameAnnotation))
+		})
+		It("should return an error when the owner Name is not set", func() {
+			ownerNew := &corev1.Pod{}
+			ownerNew.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Kind: "Pod"})
+			Expect(TransferOwnerAnnotations(ownerNew, pod)).ToNot(Succeed())
+		})
+	})
+
 	Describe("SetWatchOwnerAnnotation", func() {
 		It("should add the watch owner annotations without losing existing ones", func() {
 			nd := &corev1.Node{
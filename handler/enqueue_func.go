@@ -0,0 +1,88 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/operator-framework/operator-lib/handler/metrics"
+)
+
+// EnqueueToFunc returns an event handler that adds the work items returned by fn for the object
+// of each event to the queue. Work items can be of any comparable type, which lets the handlers
+// and decorators of this package feed queues of work other than reconcile.Requests, ex. actions
+// on external systems. Combine it with Filter and Instrument to reuse the pause predicates and
+// the resource metrics of this package.
+func EnqueueToFunc[T client.Object, W comparable](fn func(client.Object) []W) handler.TypedEventHandler[T, W] {
+	return handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, obj T) []W {
+		return fn(obj)
+	})
+}
+
+// Filter returns an event handler that passes the events accepted by pred to h, regardless of
+// the type of work items h enqueues. This lets predicates, ex. those of the predicate package,
+// be applied to event handlers of queues other than the ones of controllers.
+func Filter[T client.Object, W comparable](pred predicate.TypedPredicate[T], h handler.TypedEventHandler[T, W]) handler.TypedEventHandler[T, W] {
+	return handler.TypedFuncs[T, W]{
+		CreateFunc: func(ctx context.Context, evt event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[W]) {
+			if pred.Create(evt) {
+				h.Create(ctx, evt, q)
+			}
+		},
+		UpdateFunc: func(ctx context.Context, evt event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[W]) {
+			if pred.Update(evt) {
+				h.Update(ctx, evt, q)
+			}
+		},
+		DeleteFunc: func(ctx context.Context, evt event.TypedDeleteEvent[T], q workqueue.TypedRateLimitingInterface[W]) {
+			if pred.Delete(evt) {
+				h.Delete(ctx, evt, q)
+			}
+		},
+		GenericFunc: func(ctx context.Context, evt event.TypedGenericEvent[T], q workqueue.TypedRateLimitingInterface[W]) {
+			if pred.Generic(evt) {
+				h.Generic(ctx, evt, q)
+			}
+		},
+	}
+}
+
+// Instrument returns an event handler that maintains the resource metrics of
+// InstrumentedEnqueueRequestForObject and passes all events to h, regardless of the type of
+// work items h enqueues.
+func Instrument[T client.Object, W comparable](h handler.TypedEventHandler[T, W]) handler.TypedEventHandler[T, W] {
+	return handler.TypedFuncs[T, W]{
+		CreateFunc: func(ctx context.Context, evt event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[W]) {
+			setResourceMetric(metrics.ResourceCreatedAt, evt.Object)
+			h.Create(ctx, evt, q)
+		},
+		UpdateFunc: func(ctx context.Context, evt event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[W]) {
+			setResourceMetric(metrics.ResourceCreatedAt, evt.ObjectOld)
+			setResourceMetric(metrics.ResourceCreatedAt, evt.ObjectNew)
+			h.Update(ctx, evt, q)
+		},
+		DeleteFunc: func(ctx context.Context, evt event.TypedDeleteEvent[T], q workqueue.TypedRateLimitingInterface[W]) {
+			deleteResourceMetric(metrics.ResourceCreatedAt, evt.Object)
+			h.Delete(ctx, evt, q)
+		},
+		GenericFunc: h.Generic,
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/operator-framework/operator-lib/handler/metrics"
+	"github.com/operator-framework/operator-lib/predicate"
+)
+
+// bucketItem is a work item of an external system, unrelated to reconcile.Request.
+type bucketItem struct {
+	bucket string
+	action string
+}
+
+var _ = Describe("EnqueueToFunc", func() {
+	ctx := context.TODO()
+
+	var q workqueue.TypedRateLimitingInterface[bucketItem]
+	var pod *corev1.Pod
+
+	toBuckets := func(obj client.Object) []bucketItem {
+		return []bucketItem{
+			{bucket: obj.GetName(), action: "sync"},
+			{bucket: obj.GetName() + "-backup", action: "sync"},
+		}
+	}
+
+	BeforeEach(func() {
+		q = &controllertest.TypedQueue[bucketItem]{TypedInterface: workqueue.NewTyped[bucketItem]()}
+		pod = &corev1.Pod{
+			TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "biznamespace",
+				Name:              "bizname",
+				CreationTimestamp: metav1.Now(),
+			},
+		}
+	})
+
+	It("should enqueue the work items returned by the function", func() {
+		h := EnqueueToFunc[client.Object](toBuckets)
+		h.Create(ctx, event.CreateEvent{Object: pod}, q)
+
+		Expect(q.Len()).To(Equal(2))
+		item, _ := q.Get()
+		Expect(item).To(Equal(bucketItem{bucket: "bizname", action: "sync"}))
+	})
+
+	It("should only pass events accepted by the predicate with Filter", func() {
+		pause, err := predicate.NewPause[client.Object]("my.app/paused")
+		Expect(err).NotTo(HaveOccurred())
+		h := Filter(pause, EnqueueToFunc[client.Object](toBuckets))
+
+		pod.SetAnnotations(map[string]string{"my.app/paused": "true"})
+		h.Create(ctx, event.CreateEvent{Object: pod}, q)
+		Expect(q.Len()).To(Equal(0))
+
+		pod.SetAnnotations(nil)
+		h.Update(ctx, event.UpdateEvent{ObjectOld: pod, ObjectNew: pod}, q)
+		Expect(q.Len()).To(Equal(2))
+	})
+
+	It("should maintain the resource metrics with Instrument", func() {
+		h := Instrument(EnqueueToFunc[client.Object](toBuckets))
+		labels := getResourceLabels(pod)
+
+		h.Create(ctx, event.CreateEvent{Object: pod}, q)
+		Expect(q.Len()).To(Equal(2))
+		Expect(testutil.ToFloat64(metrics.ResourceCreatedAt.With(labels))).To(Equal(float64(pod.CreationTimestamp.UTC().Unix())))
+
+		h.Delete(ctx, event.DeleteEvent{Object: pod}, q)
+		Expect(metrics.ResourceCreatedAt.Delete(labels)).To(BeFalse())
+	})
+})
@@ -0,0 +1,117 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	"github.com/operator-framework/operator-lib/handler/internal/metrics"
+)
+
+// NewEventLagHandler wraps h and measures, for every create, update and delete event, the time
+// between the last change of the object and the invocation of the handler. The last change is the
+// latest of the object's creation timestamp, deletion timestamp and managedFields timestamps.
+// Observations are exported with the GVK of the watched objects, which must be given since typed
+// objects read from the cache usually have an empty TypeMeta:
+//
+//	handler_event_lag_seconds{"group", "version", "kind", "event"}
+//
+// The histogram is exported once registered with RegisterMetrics.
+// A growing lag indicates that the cache or the API server is overloaded, delaying reconciliations.
+// Since timestamps have a resolution of one second and are set by the API server, the lag is only
+// meaningful in the order of seconds and is affected by clock skew, negative values are recorded as 0.
+//
+// Events that do not follow a change of the object are not observed: create events of objects
+// last changed before the handler was created, which are sent when the cache is first synced, and
+// update events that carry an unchanged resourceVersion, which are sent on resyncs.
+func NewEventLagHandler[T client.Object, W comparable](gvk schema.GroupVersionKind, h handler.TypedEventHandler[T, W]) handler.TypedEventHandler[T, W] {
+	return &eventLagHandler[T, W]{gvk: gvk, handler: h, now: time.Now, started: time.Now()}
+}
+
+type eventLagHandler[T client.Object, W comparable] struct {
+	gvk     schema.GroupVersionKind
+	handler handler.TypedEventHandler[T, W]
+	now     func() time.Time
+	// started is the time the handler was created at
+	started time.Time
+}
+
+// Create implements EventHandler.
+func (h *eventLagHandler[T, W]) Create(ctx context.Context, evt event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[W]) {
+	h.observe("create", evt.Object, h.started)
+	h.handler.Create(ctx, evt, q)
+}
+
+// Update implements EventHandler.
+func (h *eventLagHandler[T, W]) Update(ctx context.Context, evt event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[W]) {
+	if resourceVersionChanged(evt.ObjectOld, evt.ObjectNew) {
+		h.observe("update", evt.ObjectNew, time.Time{})
+	}
+	h.handler.Update(ctx, evt, q)
+}
+
+// Delete implements EventHandler.
+func (h *eventLagHandler[T, W]) Delete(ctx context.Context, evt event.TypedDeleteEvent[T], q workqueue.TypedRateLimitingInterface[W]) {
+	h.observe("delete", evt.Object, time.Time{})
+	h.handler.Delete(ctx, evt, q)
+}
+
+// Generic implements EventHandler. Generic events do not originate from a change of the
+// object, so no lag is recorded.
+func (h *eventLagHandler[T, W]) Generic(ctx context.Context, evt event.TypedGenericEvent[T], q workqueue.TypedRateLimitingInterface[W]) {
+	h.handler.Generic(ctx, evt, q)
+}
+
+// observe records the lag of an event of eventType for obj, if obj was last changed after since.
+func (h *eventLagHandler[T, W]) observe(eventType string, obj client.Object, since time.Time) {
+	if obj == nil {
+		return
+	}
+	last := lastChange(obj)
+	if last.IsZero() || !last.After(since) {
+		return
+	}
+	lag := h.now().Sub(last).Seconds()
+	if lag < 0 {
+		lag = 0
+	}
+	metrics.EventLag.WithLabelValues(h.gvk.Group, h.gvk.Version, h.gvk.Kind, eventType).Observe(lag)
+}
+
+// resourceVersionChanged returns false if oldObj and newObj have the same resourceVersion.
+func resourceVersionChanged(oldObj, newObj client.Object) bool {
+	return oldObj == nil || newObj == nil || oldObj.GetResourceVersion() != newObj.GetResourceVersion()
+}
+
+// lastChange returns the time of the last known change of obj.
+func lastChange(obj client.Object) time.Time {
+	last := obj.GetCreationTimestamp().Time
+	if ts := obj.GetDeletionTimestamp(); ts != nil && ts.After(last) {
+		last = ts.Time
+	}
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Time != nil && entry.Time.After(last) {
+			last = entry.Time.Time
+		}
+	}
+	return last
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	crHandler "sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/operator-framework/operator-lib/handler/internal/metrics"
+)
+
+var _ = Describe("NewEventLagHandler", func() {
+	ctx := context.TODO()
+	gvk := corev1.SchemeGroupVersion.WithKind("Pod")
+
+	var q workqueue.TypedRateLimitingInterface[reconcile.Request]
+	var h *eventLagHandler[client.Object, reconcile.Request]
+	var pod *corev1.Pod
+	var now time.Time
+
+	histogram := func(eventType string) *dto.Histogram {
+		m := &dto.Metric{}
+		observer, err := metrics.EventLag.GetMetricWithLabelValues(gvk.Group, gvk.Version, gvk.Kind, eventType)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(observer.(interface{ Write(*dto.Metric) error }).Write(m)).To(Succeed())
+		return m.GetHistogram()
+	}
+
+	BeforeEach(func() {
+		metrics.EventLag.Reset()
+		q = &controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+		now = time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+		h = NewEventLagHandler[client.Object](gvk, &crHandler.EnqueueRequestForObject{}).(*eventLagHandler[client.Object, reconcile.Request])
+		h.now = func() time.Time { return now }
+		h.started = now.Add(-time.Hour)
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "biznamespace",
+				Name:              "bizname",
+				CreationTimestamp: metav1.NewTime(now.Add(-time.Minute)),
+			},
+		}
+	})
+
+	It("should observe the lag since creation on a CreateEvent", func() {
+		h.Create(ctx, event.CreateEvent{Object: pod}, q)
+
+		Expect(q.Len()).To(Equal(1))
+		Expect(histogram("create").GetSampleCount()).To(Equal(uint64(1)))
+		Expect(histogram("create").GetSampleSum()).To(Equal(time.Minute.Seconds()))
+	})
+
+	It("should observe the lag since the latest managedFields entry on an UpdateEvent", func() {
+		updated := metav1.NewTime(now.Add(-2 * time.Second))
+		newPod := pod.DeepCopy()
+		newPod.ResourceVersion = "2"
+		newPod.ManagedFields = []metav1.ManagedFieldsEntry{
+			{Manager: "a", Time: &metav1.Time{Time: now.Add(-30 * time.Second)}},
+			{Manager: "b", Time: &updated},
+		}
+		h.Update(ctx, event.UpdateEvent{ObjectOld: pod, ObjectNew: newPod}, q)
+
+		Expect(q.Len()).To(Equal(1))
+		Expect(histogram("update").GetSampleSum()).To(Equal(2.0))
+	})
+
+	It("should observe the lag since deletion on a DeleteEvent", func() {
+		deleted := metav1.NewTime(now.Add(-5 * time.Second))
+		pod.DeletionTimestamp = &deleted
+		h.Delete(ctx, event.DeleteEvent{Object: pod}, q)
+
+		Expect(histogram("delete").GetSampleSum()).To(Equal(5.0))
+	})
+
+	It("should record clock skew as no lag", func() {
+		pod.CreationTimestamp = metav1.NewTime(now.Add(time.Second))
+		h.Create(ctx, event.CreateEvent{Object: pod}, q)
+
+		Expect(histogram("create").GetSampleCount()).To(Equal(uint64(1)))
+		Expect(histogram("create").GetSampleSum()).To(Equal(0.0))
+	})
+
+	It("should not observe CreateEvents of objects that existed before the handler", func() {
+		h.started = now
+		h.Create(ctx, event.CreateEvent{Object: pod}, q)
+
+		Expect(q.Len()).To(Equal(1))
+		Expect(histogram("create").GetSampleCount()).To(Equal(uint64(0)))
+	})
+
+	It("should not observe UpdateEvents with an unchanged resourceVersion", func() {
+		pod.ResourceVersion = "1"
+		h.Update(ctx, event.UpdateEvent{ObjectOld: pod, ObjectNew: pod.DeepCopy()}, q)
+
+		Expect(q.Len()).To(Equal(1))
+		Expect(histogram("update").GetSampleCount()).To(Equal(uint64(0)))
+	})
+
+	It("should not observe GenericEvents", func() {
+		h.Generic(ctx, event.GenericEvent{Object: pod}, q)
+
+		Expect(q.Len()).To(Equal(1))
+		Expect(histogram("generic").GetSampleCount()).To(Equal(uint64(0)))
+	})
+})
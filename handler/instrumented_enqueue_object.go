@@ -17,13 +17,14 @@ package handler
 import (
 	"context"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	"github.com/operator-framework/operator-lib/handler/internal/metrics"
+	"github.com/operator-framework/operator-lib/handler/metrics"
 )
 
 // InstrumentedEnqueueRequestForObject wraps controller-runtime handler for
@@ -37,42 +38,77 @@ import (
 // To call the handler use:
 //
 //	&handler.InstrumentedEnqueueRequestForObject{}
+//
+// The metric is metrics.ResourceCreatedAt unless another gauge is set in ResourceCreatedAt,
+// and it can be disabled with DisableMetrics.
 type InstrumentedEnqueueRequestForObject[T client.Object] struct {
 	handler.TypedEnqueueRequestForObject[T]
+
+	// ResourceCreatedAt is the gauge set by the handler, see metrics.NewResourceCreatedAt.
+	// It defaults to metrics.ResourceCreatedAt.
+	ResourceCreatedAt *prometheus.GaugeVec
+
+	// DisableMetrics disables the metrics, making the handler behave like
+	// EnqueueRequestForObject.
+	DisableMetrics bool
+
+	// RateLimiter, if set, determines how long each request waits before being added to the
+	// queue, see NewOwnerRateLimiter.
+	RateLimiter workqueue.TypedRateLimiter[reconcile.Request]
 }
 
 // Create implements EventHandler, and creates the metrics.
 func (h InstrumentedEnqueueRequestForObject[T]) Create(ctx context.Context, e event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
-	setResourceMetric(e.Object)
-	h.TypedEnqueueRequestForObject.Create(ctx, e, q)
+	setResourceMetric(h.gauge(), e.Object)
+	h.TypedEnqueueRequestForObject.Create(ctx, e, rateLimitQueue(q, h.RateLimiter))
 }
 
 // Update implements EventHandler, and updates the metrics.
 func (h InstrumentedEnqueueRequestForObject[T]) Update(ctx context.Context, e event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
-	setResourceMetric(e.ObjectOld)
-	setResourceMetric(e.ObjectNew)
+	setResourceMetric(h.gauge(), e.ObjectOld)
+	setResourceMetric(h.gauge(), e.ObjectNew)
 
-	h.TypedEnqueueRequestForObject.Update(ctx, e, q)
+	h.TypedEnqueueRequestForObject.Update(ctx, e, rateLimitQueue(q, h.RateLimiter))
 }
 
 // Delete implements EventHandler, and deletes metrics.
 func (h InstrumentedEnqueueRequestForObject[T]) Delete(ctx context.Context, e event.TypedDeleteEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
-	deleteResourceMetric(e.Object)
-	h.TypedEnqueueRequestForObject.Delete(ctx, e, q)
+	deleteResourceMetric(h.gauge(), e.Object)
+	h.TypedEnqueueRequestForObject.Delete(ctx, e, rateLimitQueue(q, h.RateLimiter))
+}
+
+// Generic implements EventHandler.
+func (h InstrumentedEnqueueRequestForObject[T]) Generic(ctx context.Context, e event.TypedGenericEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.TypedEnqueueRequestForObject.Generic(ctx, e, rateLimitQueue(q, h.RateLimiter))
+}
+
+// gauge returns the gauge set by h, or nil if its metrics are disabled.
+func (h InstrumentedEnqueueRequestForObject[T]) gauge() *prometheus.GaugeVec {
+	switch {
+	case h.DisableMetrics:
+		return nil
+	case h.ResourceCreatedAt != nil:
+		return h.ResourceCreatedAt
+	default:
+		return metrics.ResourceCreatedAt
+	}
 }
 
-func setResourceMetric(obj client.Object) {
-	if obj != nil {
+func setResourceMetric(gauge *prometheus.GaugeVec, obj client.Object) {
+	if gauge != nil && obj != nil {
 		labels := getResourceLabels(obj)
-		m, _ := metrics.ResourceCreatedAt.GetMetricWith(labels)
+		m, err := gauge.GetMetricWith(labels)
+		if err != nil {
+			return
+		}
 		m.Set(float64(obj.GetCreationTimestamp().UTC().Unix()))
 	}
 }
 
-func deleteResourceMetric(obj client.Object) {
-	if obj != nil {
+func deleteResourceMetric(gauge *prometheus.GaugeVec, obj client.Object) {
+	if gauge != nil && obj != nil {
 		labels := getResourceLabels(obj)
-		_ = metrics.ResourceCreatedAt.Delete(labels)
+		_ = gauge.Delete(labels)
 	}
 }
 
@@ -30,7 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	"github.com/operator-framework/operator-lib/handler/internal/metrics"
+	"github.com/operator-framework/operator-lib/handler/metrics"
 )
 
 var _ = Describe("InstrumentedEnqueueRequestForObject", func() {
@@ -179,6 +179,63 @@ var _ = Describe("InstrumentedEnqueueRequestForObject", func() {
 		})
 	})
 
+	Describe("custom metrics", func() {
+		BeforeEach(func() {
+			metrics.ResourceCreatedAt.Reset()
+		})
+
+		It("should set the gauge of the handler", func() {
+			gauge := metrics.NewResourceCreatedAt(metrics.WithName("custom_created_at_seconds"),
+				metrics.WithConstLabels(prometheus.Labels{"operator": "test"}))
+			customRegistry := prometheus.NewRegistry()
+			customRegistry.MustRegister(gauge)
+			instance.ResourceCreatedAt = gauge
+
+			instance.Create(ctx, event.CreateEvent{Object: pod}, q)
+			Expect(q.Len()).To(Equal(1))
+
+			gauges, err := customRegistry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gauges).To(HaveLen(1))
+			Expect(gauges[0].GetName()).To(Equal("custom_created_at_seconds"))
+			assertMetrics(gauges[0], 1, []*corev1.Pod{pod})
+
+			gauges, err = registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gauges).To(BeEmpty())
+
+			instance.Delete(ctx, event.DeleteEvent{Object: pod}, q)
+			gauges, err = customRegistry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gauges).To(BeEmpty())
+		})
+
+		It("should not set metrics when they are disabled", func() {
+			instance.DisableMetrics = true
+
+			instance.Create(ctx, event.CreateEvent{Object: pod}, q)
+			Expect(q.Len()).To(Equal(1))
+
+			gauges, err := registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gauges).To(BeEmpty())
+		})
+
+		It("should register the default gauge with custom registries", func() {
+			customRegistry := prometheus.NewRegistry()
+			Expect(metrics.Register(customRegistry)).To(Succeed())
+			Expect(metrics.Register(customRegistry)).To(Succeed())
+
+			instance.Create(ctx, event.CreateEvent{Object: pod}, q)
+			gauges, err := customRegistry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gauges).To(HaveLen(1))
+			Expect(gauges[0].GetName()).To(Equal(metrics.DefaultResourceCreatedAtName))
+
+			instance.Delete(ctx, event.DeleteEvent{Object: pod}, q)
+		})
+	})
+
 	Describe("getResourceLabels", func() {
 		It("should fill out map with values from given objects", func() {
 			labelMap := getResourceLabels(pod)
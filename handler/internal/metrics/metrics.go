@@ -15,19 +15,45 @@
 package metrics
 
 import (
+	"errors"
+
 	"github.com/prometheus/client_golang/prometheus"
-	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
-// ResourceCreatedAt creates new prometheus metrics for primary resource,
-// with information {"name", "namespace", "group", "version", "kind"}
-var ResourceCreatedAt = prometheus.NewGaugeVec(prometheus.GaugeOpts{
-	Name: "resource_created_at_seconds",
-	Help: "Timestamp at which a resource was created",
-}, []string{"name", "namespace", "group", "version", "kind"})
+// EventLag observes the time between the last change of an object and the invocation
+// of an event handler for it, with information {"group", "version", "kind", "event"}
+var EventLag = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "handler_event_lag_seconds",
+	Help:    "Time between the last change of an object and the handling of its event",
+	Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+}, []string{"group", "version", "kind", "event"})
+
+// Actions reported in the "action" label of MissingOwnerRequests.
+const (
+	MissingOwnerActionDropped    = "dropped"
+	MissingOwnerActionRedirected = "redirected"
+)
+
+// MissingOwnerRequests counts the requests of annotation owners that no longer exist, which were
+// dropped or redirected instead of being enqueued, with information {"group", "kind", "action"}
+var MissingOwnerRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "handler_missing_owner_requests_total",
+	Help: "Number of requests of annotation owners that no longer exist, which were dropped or redirected",
+}, []string{"group", "kind", "action"})
 
-func init() {
-	metrics.Registry.MustRegister(
-		ResourceCreatedAt,
-	)
+// Register registers the handler metrics with reg. Metrics that are already registered
+// with reg are skipped.
+func Register(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{
+		EventLag,
+		MissingOwnerRequests,
+	} {
+		if err := reg.Register(c); err != nil {
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if !errors.As(err, &alreadyRegistered) {
+				return err
+			}
+		}
+	}
+	return nil
 }
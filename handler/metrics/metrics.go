@@ -0,0 +1,85 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics contains the public metrics of the handler package.
+//
+// ResourceCreatedAt is registered with the controller-runtime metrics registry by default, and
+// can additionally be registered with custom registries with Register. Operators that need a
+// different metric name or additional constant labels can create their own gauge with
+// NewResourceCreatedAt and set it on InstrumentedEnqueueRequestForObject.
+package metrics
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// DefaultResourceCreatedAtName is the name of ResourceCreatedAt.
+const DefaultResourceCreatedAtName = "resource_created_at_seconds"
+
+// ResourceCreatedAtLabels are the variable labels of the gauges created by NewResourceCreatedAt.
+var ResourceCreatedAtLabels = []string{"name", "namespace", "group", "version", "kind"}
+
+// ResourceCreatedAt creates new prometheus metrics for primary resource,
+// with information {"name", "namespace", "group", "version", "kind"}
+var ResourceCreatedAt = NewResourceCreatedAt()
+
+// Option configures a gauge created by NewResourceCreatedAt.
+type Option func(*prometheus.GaugeOpts)
+
+// WithName sets the name of the gauge. It defaults to DefaultResourceCreatedAtName.
+func WithName(name string) Option {
+	return func(opts *prometheus.GaugeOpts) {
+		opts.Name = name
+	}
+}
+
+// WithConstLabels adds labels with fixed values to the gauge, ex. to identify the operator
+// when several operators report to the same Prometheus.
+func WithConstLabels(labels prometheus.Labels) Option {
+	return func(opts *prometheus.GaugeOpts) {
+		opts.ConstLabels = labels
+	}
+}
+
+// NewResourceCreatedAt returns a gauge reporting the creation timestamp of resources, with
+// the ResourceCreatedAtLabels. The gauge is not registered.
+func NewResourceCreatedAt(opts ...Option) *prometheus.GaugeVec {
+	gaugeOpts := prometheus.GaugeOpts{
+		Name: DefaultResourceCreatedAtName,
+		Help: "Timestamp at which a resource was created",
+	}
+	for _, opt := range opts {
+		opt(&gaugeOpts)
+	}
+	return prometheus.NewGaugeVec(gaugeOpts, ResourceCreatedAtLabels)
+}
+
+// Register registers ResourceCreatedAt with reg. Registering it more than once with the same
+// registry is not an error.
+func Register(reg prometheus.Registerer) error {
+	if err := reg.Register(ResourceCreatedAt); err != nil {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if !errors.As(err, &alreadyRegistered) {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	metrics.Registry.MustRegister(ResourceCreatedAt)
+}
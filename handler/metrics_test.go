@@ -0,0 +1,35 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/operator-framework/operator-lib/handler/internal/metrics"
+)
+
+var _ = Describe("RegisterMetrics", func() {
+	It("should export the handler metrics with the registry, once", func() {
+		reg := prometheus.NewRegistry()
+		Expect(RegisterMetrics(reg)).To(Succeed())
+		Expect(RegisterMetrics(reg)).To(Succeed())
+
+		metrics.EventLag.WithLabelValues("", "v1", "Pod", "create").Observe(1)
+		Expect(testutil.GatherAndCount(reg, "handler_event_lag_seconds")).To(BeNumerically(">", 0))
+	})
+})
@@ -0,0 +1,133 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/operator-framework/operator-lib/handler/internal/metrics"
+)
+
+const (
+	// maxMissingOwners is the number of owners confirmed missing remembered by an OwnerChecker.
+	maxMissingOwners = 1024
+	// missingOwnerTTL is the time during which an owner confirmed missing is not read again from
+	// the API server.
+	missingOwnerTTL = 30 * time.Second
+)
+
+// OwnerChecker checks that the owners of an EnqueueRequestForAnnotation exist, see
+// NewOwnerChecker.
+type OwnerChecker struct {
+	cache     client.Reader
+	apiReader client.Reader
+	version   string
+	clock     clock.PassiveClock
+
+	// missing holds the time at which each owner was confirmed missing by apiReader
+	missing *lru.Cache
+}
+
+// missingOwnerKey identifies an owner in OwnerChecker.missing.
+type missingOwnerKey struct {
+	ownerType schema.GroupKind
+	key       client.ObjectKey
+}
+
+// NewOwnerChecker returns an OwnerChecker reading owners as metadata only, with version as the
+// version of the Type of the handler. Owners are read with cache, ex. the cache of the manager,
+// and owners it does not find are read again with apiReader, ex. the API reader of the manager,
+// before their requests are dropped, since the cache may not have observed owners created
+// recently. Owners confirmed missing by apiReader are not read from it again for 30 seconds,
+// so that the events of the many dependents of a deleted owner do not flood the API server.
+// An error is returned if a reader is nil or version is empty.
+func NewOwnerChecker(cache, apiReader client.Reader, version string) (*OwnerChecker, error) {
+	if cache == nil || apiReader == nil {
+		return nil, errors.New("error creating owner checker: cache and apiReader must be set")
+	}
+	if version == "" {
+		return nil, errors.New("error creating owner checker: version must not be empty")
+	}
+	return &OwnerChecker{
+		cache:     cache,
+		apiReader: apiReader,
+		version:   version,
+		clock:     clock.RealClock{},
+		missing:   lru.New(maxMissingOwners),
+	}, nil
+}
+
+// get reads the owner of type ownerType with key from the cache, and from the API server if it
+// is not found in the cache and was not recently confirmed missing.
+func (c *OwnerChecker) get(ctx context.Context, ownerType schema.GroupKind, key client.ObjectKey) error {
+	owner := &metav1.PartialObjectMetadata{}
+	owner.SetGroupVersionKind(ownerType.WithVersion(c.version))
+	err := c.cache.Get(ctx, key, owner)
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	missingKey := missingOwnerKey{ownerType: ownerType, key: key}
+	if confirmed, ok := c.missing.Get(missingKey); ok && c.clock.Since(confirmed.(time.Time)) < missingOwnerTTL {
+		return err
+	}
+	err = c.apiReader.Get(ctx, key, owner)
+	switch {
+	case apierrors.IsNotFound(err):
+		c.missing.Add(missingKey, c.clock.Now())
+	case err == nil:
+		c.missing.Remove(missingKey)
+	}
+	return err
+}
+
+// enqueue adds req to q, unless Owners is set and the owner of req does not exist, in which
+// case req is added to MissingOwnerQueue, if set, or dropped. Owners that cannot be read are
+// enqueued.
+func (e *EnqueueRequestForAnnotation[T]) enqueue(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request], req reconcile.Request) {
+	if e.Owners == nil {
+		q.Add(req)
+		return
+	}
+
+	err := e.Owners.get(ctx, e.Type, req.NamespacedName)
+	switch {
+	case err == nil:
+		q.Add(req)
+	case apierrors.IsNotFound(err):
+		if e.MissingOwnerQueue != nil {
+			log.V(1).Info("Redirecting request of missing owner", "owner", req.NamespacedName, "type", e.Type)
+			metrics.MissingOwnerRequests.WithLabelValues(e.Type.Group, e.Type.Kind, metrics.MissingOwnerActionRedirected).Inc()
+			e.MissingOwnerQueue.Add(req)
+			return
+		}
+		log.V(1).Info("Dropping request of missing owner", "owner", req.NamespacedName, "type", e.Type)
+		metrics.MissingOwnerRequests.WithLabelValues(e.Type.Group, e.Type.Kind, metrics.MissingOwnerActionDropped).Inc()
+	default:
+		log.V(1).Info("Unable to check that owner exists, enqueueing it", "owner", req.NamespacedName, "type", e.Type, "error", err.Error())
+		q.Add(req)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/operator-framework/operator-lib/handler/internal/metrics"
+)
+
+var _ = Describe("EnqueueRequestForAnnotation with an OwnerChecker", func() {
+	ctx := context.TODO()
+	podType := schema.GroupKind{Kind: "Pod"}
+
+	var (
+		q        workqueue.TypedRateLimitingInterface[reconcile.Request]
+		reader   client.Client
+		instance *EnqueueRequestForAnnotation[client.Object]
+	)
+
+	dependentOf := func(owner string) *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "dependent"}}
+		ownerPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: owner}}
+		ownerPod.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+		Expect(SetOwnerAnnotations(ownerPod, cm)).To(Succeed())
+		return cm
+	}
+	requestFor := func(owner string) reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: owner}}
+	}
+
+	BeforeEach(func() {
+		q = &controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+		now := metav1.Now()
+		reader = fake.NewClientBuilder().WithObjects(
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "live"}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "deleting", DeletionTimestamp: &now, Finalizers: []string{"example.com/cleanup"}}},
+		).Build()
+		owners, err := NewOwnerChecker(reader, reader, "v1")
+		Expect(err).NotTo(HaveOccurred())
+		instance = &EnqueueRequestForAnnotation[client.Object]{Type: podType, Owners: owners}
+	})
+
+	It("should not check owners without a version or a reader", func() {
+		_, err := NewOwnerChecker(reader, reader, "")
+		Expect(err).To(MatchError(ContainSubstring("version must not be empty")))
+		_, err = NewOwnerChecker(reader, nil, "v1")
+		Expect(err).To(MatchError(ContainSubstring("must be set")))
+	})
+
+	It("should enqueue owners missing from the cache that exist on the API server", func() {
+		cache := fake.NewClientBuilder().Build()
+		owners, err := NewOwnerChecker(cache, reader, "v1")
+		Expect(err).NotTo(HaveOccurred())
+		instance.Owners = owners
+
+		instance.Create(ctx, event.CreateEvent{Object: dependentOf("live")}, q)
+		instance.Create(ctx, event.CreateEvent{Object: dependentOf("gone")}, q)
+		Expect(q.Len()).To(Equal(1))
+		req, _ := q.Get()
+		Expect(req).To(Equal(requestFor("live")))
+	})
+
+	It("should not read owners recently confirmed missing from the API server again", func() {
+		apiGets := 0
+		apiReader := interceptor.NewClient(reader.(client.WithWatch), interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				apiGets++
+				return c.Get(ctx, key, obj, opts...)
+			},
+		})
+		owners, err := NewOwnerChecker(fake.NewClientBuilder().Build(), apiReader, "v1")
+		Expect(err).NotTo(HaveOccurred())
+		clock := clocktesting.NewFakePassiveClock(time.Now())
+		owners.clock = clock
+		instance.Owners = owners
+
+		for i := 0; i < 3; i++ {
+			instance.Delete(ctx, event.DeleteEvent{Object: dependentOf("gone")}, q)
+		}
+		Expect(q.Len()).To(Equal(0))
+		Expect(apiGets).To(Equal(1))
+
+		By("reading the owner again once the confirmation expired")
+		clock.SetTime(clock.Now().Add(missingOwnerTTL))
+		instance.Delete(ctx, event.DeleteEvent{Object: dependentOf("gone")}, q)
+		Expect(apiGets).To(Equal(2))
+	})
+
+	It("should enqueue owners that exist, even while they are being deleted", func() {
+		instance.Delete(ctx, event.DeleteEvent{Object: dependentOf("live")}, q)
+		instance.Delete(ctx, event.DeleteEvent{Object: dependentOf("deleting")}, q)
+		Expect(q.Len()).To(Equal(2))
+	})
+
+	It("should drop and count the requests of missing owners", func() {
+		dropped := metrics.MissingOwnerRequests.WithLabelValues("", "Pod", metrics.MissingOwnerActionDropped)
+		before := testutil.ToFloat64(dropped)
+
+		instance.Delete(ctx, event.DeleteEvent{Object: dependentOf("gone")}, q)
+		instance.Update(ctx, event.UpdateEvent{ObjectOld: dependentOf("gone"), ObjectNew: dependentOf("live")}, q)
+		Expect(q.Len()).To(Equal(1))
+		req, _ := q.Get()
+		Expect(req).To(Equal(requestFor("live")))
+		Expect(testutil.ToFloat64(dropped) - before).To(Equal(2.0))
+	})
+
+	It("should redirect the requests of missing owners", func() {
+		cleanup := workqueue.NewTyped[reconcile.Request]()
+		instance.MissingOwnerQueue = cleanup
+		redirected := metrics.MissingOwnerRequests.WithLabelValues("", "Pod", metrics.MissingOwnerActionRedirected)
+		before := testutil.ToFloat64(redirected)
+
+		instance.Create(ctx, event.CreateEvent{Object: dependentOf("gone")}, q)
+		instance.Generic(ctx, event.GenericEvent{Object: dependentOf("gone")}, q)
+		Expect(q.Len()).To(Equal(0))
+		Expect(cleanup.Len()).To(Equal(1))
+		req, _ := cleanup.Get()
+		Expect(req).To(Equal(requestFor("gone")))
+		Expect(testutil.ToFloat64(redirected) - before).To(Equal(2.0))
+	})
+
+	It("should enqueue owners that cannot be read", func() {
+		failing := interceptor.NewClient(reader.(client.WithWatch), interceptor.Funcs{
+			Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+				return errors.New("cache not synced")
+			},
+		})
+		owners, err := NewOwnerChecker(failing, reader, "v1")
+		Expect(err).NotTo(HaveOccurred())
+		instance.Owners = owners
+		instance.Delete(ctx, event.DeleteEvent{Object: dependentOf("gone")}, q)
+		Expect(q.Len()).To(Equal(1))
+	})
+})
@@ -0,0 +1,113 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	crtHandler "sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NamespaceOption configures the event handler returned by EnqueueRequestForNamespace.
+type NamespaceOption func(*enqueueNamespace)
+
+// WithNamespaceRequestName sets the name of the requests enqueued by EnqueueRequestForNamespace,
+// e.g. a sentinel such as "*" for controllers that also reconcile requests for single objects.
+// The name is empty by default.
+func WithNamespaceRequestName(name string) NamespaceOption {
+	return func(e *enqueueNamespace) {
+		e.name = name
+	}
+}
+
+// WithNamespaceCoalescing delays the requests enqueued by EnqueueRequestForNamespace by delay,
+// so that all the events of a namespace within delay collapse into a single reconcile, instead
+// of one reconcile per burst of events that arrive while the previous request is processed.
+func WithNamespaceCoalescing(delay time.Duration) NamespaceOption {
+	return func(e *enqueueNamespace) {
+		e.delay = delay
+	}
+}
+
+// EnqueueRequestForNamespace returns an event handler that enqueues a request keyed only by
+// the namespace of the object of each event, for controllers that reconcile a whole namespace,
+// such as per-namespace agents:
+//
+//	err := ctrl.NewControllerManagedBy(mgr).Named("namespace-agent").
+//		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestForNamespace[client.Object](
+//			handler.WithNamespaceCoalescing(time.Second))).
+//		Complete(r)
+//
+// The requests have an empty name by default, see WithNamespaceRequestName, and can be told apart
+// with IsNamespaceRequest. The workqueue deduplicates the requests of a namespace that are already
+// queued, so that bursts of events in a namespace collapse into one reconcile, and
+// WithNamespaceCoalescing widens the window in which they do. Events of cluster-scoped objects are
+// ignored.
+func EnqueueRequestForNamespace[T client.Object](opts ...NamespaceOption) crtHandler.TypedEventHandler[T, reconcile.Request] {
+	e := &enqueueNamespace{}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return crtHandler.TypedFuncs[T, reconcile.Request]{
+		CreateFunc: func(_ context.Context, evt event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			e.enqueue(q, evt.Object)
+		},
+		UpdateFunc: func(_ context.Context, evt event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			e.enqueue(q, evt.ObjectNew)
+		},
+		DeleteFunc: func(_ context.Context, evt event.TypedDeleteEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			e.enqueue(q, evt.Object)
+		},
+		GenericFunc: func(_ context.Context, evt event.TypedGenericEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			e.enqueue(q, evt.Object)
+		},
+	}
+}
+
+// NamespaceRequest returns the request enqueued by EnqueueRequestForNamespace, with the default
+// name, for namespace.
+func NamespaceRequest(namespace string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace}}
+}
+
+// IsNamespaceRequest returns true if req was enqueued by EnqueueRequestForNamespace with name as
+// request name, e.g. the empty default name.
+func IsNamespaceRequest(req reconcile.Request, name string) bool {
+	return req.Namespace != "" && req.Name == name
+}
+
+type enqueueNamespace struct {
+	name  string
+	delay time.Duration
+}
+
+func (e *enqueueNamespace) enqueue(q workqueue.TypedRateLimitingInterface[reconcile.Request], obj client.Object) {
+	if obj == nil || obj.GetNamespace() == "" {
+		return
+	}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: e.name}}
+	if e.delay > 0 {
+		q.AddAfter(req, e.delay)
+		return
+	}
+	q.Add(req)
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("EnqueueRequestForNamespace", func() {
+	ctx := context.TODO()
+
+	var q *delayRecordingQueue
+
+	BeforeEach(func() {
+		q = &delayRecordingQueue{
+			Queue:  controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()},
+			delays: map[reconcile.Request]time.Duration{},
+		}
+	})
+
+	configMap := func(namespace, name string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	}
+
+	It("should collapse the events of a namespace into one request", func() {
+		h := EnqueueRequestForNamespace[client.Object]()
+		h.Create(ctx, event.CreateEvent{Object: configMap("ns1", "a")}, q)
+		h.Update(ctx, event.UpdateEvent{ObjectOld: configMap("ns1", "b"), ObjectNew: configMap("ns1", "b")}, q)
+		h.Delete(ctx, event.DeleteEvent{Object: configMap("ns1", "c")}, q)
+		h.Generic(ctx, event.GenericEvent{Object: configMap("ns2", "a")}, q)
+
+		Expect(q.Len()).To(Equal(2))
+		req, _ := q.Get()
+		Expect(req).To(Equal(NamespaceRequest("ns1")))
+		Expect(IsNamespaceRequest(req, "")).To(BeTrue())
+		req, _ = q.Get()
+		Expect(req).To(Equal(NamespaceRequest("ns2")))
+		Expect(q.delays).To(BeEmpty())
+	})
+
+	It("should ignore cluster-scoped objects", func() {
+		h := EnqueueRequestForNamespace[client.Object]()
+		h.Create(ctx, event.CreateEvent{Object: &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}}, q)
+		h.Delete(ctx, event.DeleteEvent{}, q)
+		Expect(q.Len()).To(BeZero())
+	})
+
+	It("should use the request name and coalescing delay", func() {
+		h := EnqueueRequestForNamespace[client.Object](WithNamespaceRequestName("*"), WithNamespaceCoalescing(time.Second))
+		h.Create(ctx, event.CreateEvent{Object: configMap("ns1", "a")}, q)
+
+		expected := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "*"}}
+		Expect(q.delays).To(Equal(map[reconcile.Request]time.Duration{expected: time.Second}))
+		Expect(IsNamespaceRequest(expected, "*")).To(BeTrue())
+		Expect(IsNamespaceRequest(expected, "")).To(BeFalse())
+		Expect(IsNamespaceRequest(reconcile.Request{NamespacedName: types.NamespacedName{Name: "*"}}, "*")).To(BeFalse())
+	})
+
+	It("should collapse bursts within the coalescing delay", func() {
+		rq := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		defer rq.ShutDown()
+		h := EnqueueRequestForNamespace[client.Object](WithNamespaceCoalescing(50 * time.Millisecond))
+		for _, name := range []string{"a", "b", "c"} {
+			h.Create(ctx, event.CreateEvent{Object: configMap("ns1", name)}, rq)
+		}
+
+		Expect(rq.Len()).To(BeZero())
+		Eventually(rq.Len).Should(Equal(1))
+		Consistently(rq.Len, 100*time.Millisecond).Should(Equal(1))
+	})
+})
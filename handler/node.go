@@ -0,0 +1,68 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crtHandler "sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+// NodeNameIndexField is the name of the field index registered by IndexNodeNames.
+const NodeNameIndexField = ".operator-lib.operatorframework.io/node-names"
+
+// IndexNodeNames registers a field index with indexer, typically the manager's field indexer,
+// that indexes the objects of obj's type by the names of the Nodes nodeNames returns for them,
+// e.g. the Nodes a custom resource runs its workload on. It must be called before the manager
+// starts, and allows EnqueueRequestsForNode to find the objects affected by a Node.
+func IndexNodeNames(ctx context.Context, indexer client.FieldIndexer, obj client.Object, nodeNames func(client.Object) []string) error {
+	return indexer.IndexField(ctx, obj, NodeNameIndexField, nodeNames)
+}
+
+// EnqueueRequestsForNode returns an event handler for Nodes that enqueues a Request for every
+// object of list's type, in all namespaces, affected by the Node of the event, as indexed by
+// IndexNodeNames. Objects are listed with reader, typically the manager's cache. Nodes update
+// their status every few seconds, so the handler is meant to be used along with
+// predicate.NewNodeLifecyclePredicate, which only passes events about taints, cordoning, node
+// conditions and removal:
+//
+//	if err := handler.IndexNodeNames(ctx, mgr.GetFieldIndexer(), &myv1.MyApp{}, myAppNodes); err != nil {
+//		return err
+//	}
+//	err := ctrl.NewControllerManagedBy(mgr).For(&myv1.MyApp{}).
+//		Watches(&corev1.Node{}, handler.EnqueueRequestsForNode[client.Object](mgr.GetCache(), &myv1.MyAppList{}),
+//			builder.WithPredicates(predicate.NewNodeLifecyclePredicate[client.Object]())).
+//		Complete(r)
+//
+// If the objects cannot be listed, the error is logged and no requests are enqueued.
+func EnqueueRequestsForNode[T client.Object](reader client.Reader, list client.ObjectList) crtHandler.TypedEventHandler[T, reconcile.Request] {
+	return crtHandler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, node T) []reconcile.Request {
+		keys, err := ListerFor(reader, list, client.MatchingFields{NodeNameIndexField: node.GetName()})(ctx)
+		if err != nil {
+			log.Error(err, "Unable to list objects affected by node", "node", node.GetName())
+			libmetrics.RecordError(libmetrics.SubsystemHandler, "list_failed")
+			return nil
+		}
+		reqs := make([]reconcile.Request, 0, len(keys))
+		for _, key := range keys {
+			reqs = append(reqs, reconcile.Request{NamespacedName: key})
+		}
+		return reqs
+	})
+}
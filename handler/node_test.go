@@ -0,0 +1,64 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("EnqueueRequestsForNode", func() {
+	ctx := context.TODO()
+
+	It("should enqueue the objects affected by the node", func() {
+		pod := func(namespace, name, nodeName string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+				Spec:       corev1.PodSpec{NodeName: nodeName},
+			}
+		}
+		cl := fake.NewClientBuilder().
+			WithObjects(pod("default", "on-node", "worker-0"), pod("other", "on-node", "worker-0"), pod("default", "elsewhere", "worker-1")).
+			WithIndex(&corev1.Pod{}, NodeNameIndexField, func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).Build()
+
+		q := &controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+		h := EnqueueRequestsForNode[client.Object](cl, &corev1.PodList{})
+		h.Delete(ctx, event.DeleteEvent{Object: &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-0"}}}, q)
+		Expect(q.Len()).To(Equal(2))
+
+		var keys []types.NamespacedName
+		for q.Len() > 0 {
+			req, _ := q.Get()
+			keys = append(keys, req.NamespacedName)
+		}
+		Expect(keys).To(ConsistOf(
+			types.NamespacedName{Namespace: "default", Name: "on-node"},
+			types.NamespacedName{Namespace: "other", Name: "on-node"},
+		))
+	})
+})
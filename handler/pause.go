@@ -15,6 +15,8 @@
 package handler
 
 import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -34,5 +36,46 @@ import (
 // to a webhook:
 // https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#request
 func NewPause[T client.Object](key string) (handler.TypedEventHandler[T, reconcile.Request], error) {
-	return annotation.NewFalsyEventHandler[T](key, annotation.Options{Log: log})
+	return annotation.NewFalsyEventHandler[T](key, annotation.Options{Log: log, TrackPaused: true})
+}
+
+// NewPauseFunc returns an event handler that filters out objects for which paused returns true.
+// This supports pause semantics modeled as a field of the object, ex. spec.paused, instead of an annotation.
+// The same security considerations as NewPause apply to the field used to pause reconciliation.
+func NewPauseFunc[T client.Object](paused func(client.Object) bool) handler.TypedEventHandler[T, reconcile.Request] {
+	return annotation.NewFalsyEventHandlerForLookup[T]("pause func", annotation.BoolLookup(paused), annotation.Options{Log: log, TrackPaused: true})
+}
+
+// NewPauseJSONPath returns an event handler that filters out objects whose field at JSONPath path,
+// ex. "{.spec.paused}", has a truthy value. Typed objects are converted to unstructured to evaluate the path.
+// When the field is absent, null or has a falsy value, the watch constructed with this event handler
+// will see events for the object. Otherwise it will not add events for that object to the queue.
+func NewPauseJSONPath[T client.Object](path string) (handler.TypedEventHandler[T, reconcile.Request], error) {
+	lookup, err := annotation.JSONPathLookup(path)
+	if err != nil {
+		return nil, err
+	}
+	return annotation.NewFalsyEventHandlerForLookup[T](path, lookup, annotation.Options{Log: log, TrackPaused: true}), nil
+}
+
+// PausedObject identifies an object that is paused.
+type PausedObject struct {
+	// GVK is the kind of the object. Objects whose type information is not set and that are not
+	// known to client-go's scheme, such as custom resources, only have their Kind set to the
+	// name of their Go type.
+	GVK schema.GroupVersionKind
+	types.NamespacedName
+}
+
+// PausedObjects returns the objects that were paused when last seen by a pause event handler or
+// predicate of this library, sorted by GVK, namespace and name. Objects are no longer reported
+// once they are seen unpaused or deleted. This can be used to report "N resources are paused"
+// in a status. The operator_lib_paused_objects metric reports the number of paused objects per GVK.
+func PausedObjects() []PausedObject {
+	objs := annotation.PausedObjects()
+	out := make([]PausedObject, 0, len(objs))
+	for _, o := range objs {
+		out = append(out, PausedObject{GVK: o.GVK, NamespacedName: o.Key})
+	}
+	return out
 }
@@ -0,0 +1,119 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DefaultMaxRateLimitedOwners is the number of owners an owner rate limiter tracks when no
+// bound is provided.
+const DefaultMaxRateLimitedOwners = 4096
+
+// NewOwnerRateLimiter returns a rate limiter that lets the requests of each owner be enqueued
+// at most qps times per second, with bursts of up to burst requests. Requests of an owner that
+// exceed its rate are delayed, without delaying the requests of other owners. It is meant to be
+// set as the RateLimiter of EnqueueRequestForAnnotation or InstrumentedEnqueueRequestForObject,
+// to slow down extremely chatty owners individually.
+//
+// The rate limiter tracks at most maxOwners owners, forgetting the least recently enqueued first.
+// If maxOwners is not positive, DefaultMaxRateLimitedOwners is used.
+func NewOwnerRateLimiter(qps float64, burst, maxOwners int) workqueue.TypedRateLimiter[reconcile.Request] {
+	if maxOwners <= 0 {
+		maxOwners = DefaultMaxRateLimitedOwners
+	}
+	return &ownerRateLimiter{
+		limit:    rate.Limit(qps),
+		burst:    burst,
+		limiters: lru.New(maxOwners),
+	}
+}
+
+type ownerRateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu       sync.Mutex
+	limiters *lru.Cache
+}
+
+// ownerLimiter is the rate limiter of an owner, with the time its delayed request is pending
+// until, if any.
+type ownerLimiter struct {
+	limiter      *rate.Limiter
+	pendingUntil time.Time
+}
+
+// When implements workqueue.TypedRateLimiter. It returns how long req must wait to be enqueued.
+// A request that is already delayed does not consume another token: it waits for the rest of
+// its current delay, so that the delays of chatty owners do not grow without bound.
+func (r *ownerRateLimiter) When(req reconcile.Request) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var owner *ownerLimiter
+	if v, ok := r.limiters.Get(req); ok {
+		owner = v.(*ownerLimiter)
+	} else {
+		owner = &ownerLimiter{limiter: rate.NewLimiter(r.limit, r.burst)}
+		r.limiters.Add(req, owner)
+	}
+
+	now := time.Now()
+	if owner.pendingUntil.After(now) {
+		return owner.pendingUntil.Sub(now)
+	}
+	delay := owner.limiter.ReserveN(now, 1).DelayFrom(now)
+	owner.pendingUntil = now.Add(delay)
+	return delay
+}
+
+// Forget implements workqueue.TypedRateLimiter. It resets the rate of req.
+func (r *ownerRateLimiter) Forget(req reconcile.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.limiters.Remove(req)
+}
+
+// NumRequeues implements workqueue.TypedRateLimiter. Requests are never requeued by the
+// handlers, so it always returns 0.
+func (r *ownerRateLimiter) NumRequeues(reconcile.Request) int {
+	return 0
+}
+
+// rateLimitQueue returns q, wrapped to delay the requests added to it according to limiter,
+// or q if limiter is nil.
+func rateLimitQueue(q workqueue.TypedRateLimitingInterface[reconcile.Request], limiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	if limiter == nil {
+		return q
+	}
+	return &rateLimitedQueue{TypedRateLimitingInterface: q, limiter: limiter}
+}
+
+// rateLimitedQueue delays the requests added to it according to its rate limiter.
+type rateLimitedQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	limiter workqueue.TypedRateLimiter[reconcile.Request]
+}
+
+func (q *rateLimitedQueue) Add(req reconcile.Request) {
+	q.TypedRateLimitingInterface.AddAfter(req, q.limiter.When(req))
+}
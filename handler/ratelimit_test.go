@@ -0,0 +1,135 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// delayHistoryQueue records the delays of all the requests added to it.
+type delayHistoryQueue struct {
+	controllertest.Queue
+	delays map[reconcile.Request][]time.Duration
+}
+
+func (q *delayHistoryQueue) Add(req reconcile.Request) {
+	q.AddAfter(req, 0)
+}
+
+func (q *delayHistoryQueue) AddAfter(req reconcile.Request, duration time.Duration) {
+	q.delays[req] = append(q.delays[req], duration)
+	q.Queue.AddAfter(req, duration)
+}
+
+var _ = Describe("NewOwnerRateLimiter", func() {
+	ctx := context.TODO()
+
+	var (
+		q      *delayHistoryQueue
+		owner  reconcile.Request
+		other  reconcile.Request
+		object func(name string, owner reconcile.Request) *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		q = &delayHistoryQueue{
+			Queue:  controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()},
+			delays: map[reconcile.Request][]time.Duration{},
+		}
+		owner = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "chatty"}}
+		other = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "quiet"}}
+		object = func(name string, owner reconcile.Request) *corev1.Pod {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: owner.Namespace, Name: name}}
+			ownerPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: owner.Namespace, Name: owner.Name}}
+			ownerPod.SetGroupVersionKind(schema.GroupVersionKind{Kind: "Pod"})
+			Expect(SetOwnerAnnotations(ownerPod, pod)).To(Succeed())
+			return pod
+		}
+	})
+
+	It("should delay the requests of an owner exceeding its rate", func() {
+		limiter := NewOwnerRateLimiter(1, 2, 0)
+		Expect(limiter.When(owner)).To(BeZero())
+		Expect(limiter.When(owner)).To(BeZero())
+		Expect(limiter.When(owner)).To(BeNumerically(">", 500*time.Millisecond))
+		Expect(limiter.When(other)).To(BeZero())
+		Expect(limiter.NumRequeues(owner)).To(BeZero())
+
+		limiter.Forget(owner)
+		Expect(limiter.When(owner)).To(BeZero())
+	})
+
+	It("should not delay the pending request of an owner any further", func() {
+		limiter := NewOwnerRateLimiter(1, 1, 0)
+		Expect(limiter.When(owner)).To(BeZero())
+		delay := limiter.When(owner)
+		Expect(delay).To(BeNumerically(">", 500*time.Millisecond))
+		for i := 0; i < 10; i++ {
+			Expect(limiter.When(owner)).To(BeNumerically("<=", delay))
+		}
+	})
+
+	It("should forget the least recently enqueued owners", func() {
+		limiter := NewOwnerRateLimiter(1, 1, 1)
+		Expect(limiter.When(owner)).To(BeZero())
+		Expect(limiter.When(other)).To(BeZero())
+		Expect(limiter.When(owner)).To(BeZero())
+	})
+
+	It("should rate limit the requests of EnqueueRequestForAnnotation per owner", func() {
+		instance := EnqueueRequestForAnnotation[client.Object]{
+			Type:        schema.GroupKind{Kind: "Pod"},
+			RateLimiter: NewOwnerRateLimiter(1, 1, 0),
+		}
+		for _, name := range []string{"a", "b", "c"} {
+			instance.Create(ctx, event.CreateEvent{Object: object(name, owner)}, q)
+		}
+		instance.Delete(ctx, event.DeleteEvent{Object: object("d", other)}, q)
+
+		Expect(q.delays[owner]).To(HaveLen(3))
+		Expect(q.delays[owner][0]).To(BeZero())
+		Expect(q.delays[owner][1]).To(BeNumerically(">", 0))
+		Expect(q.delays[owner][2]).To(BeNumerically("<=", q.delays[owner][1]))
+		Expect(q.delays[other]).To(Equal([]time.Duration{0}))
+	})
+
+	It("should rate limit the requests of InstrumentedEnqueueRequestForObject", func() {
+		instance := InstrumentedEnqueueRequestForObject[client.Object]{
+			RateLimiter:    NewOwnerRateLimiter(1, 1, 0),
+			DisableMetrics: true,
+		}
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: owner.Namespace, Name: owner.Name}}
+		instance.Create(ctx, event.CreateEvent{Object: pod}, q)
+		instance.Update(ctx, event.UpdateEvent{ObjectOld: pod, ObjectNew: pod}, q)
+		instance.Generic(ctx, event.GenericEvent{Object: pod}, q)
+
+		Expect(q.delays[owner]).To(HaveLen(3))
+		Expect(q.delays[owner][0]).To(BeZero())
+		Expect(q.delays[owner][2]).To(BeNumerically("<=", q.delays[owner][1]))
+	})
+})
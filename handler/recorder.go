@@ -0,0 +1,171 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DefaultEnqueueRecordSize is the number of enqueued requests an EnqueueRecorder remembers when
+// no size is provided.
+const DefaultEnqueueRecordSize = 100
+
+// Types of the events reported in EnqueueRecord.EventType.
+const (
+	EventTypeCreate  = "Create"
+	EventTypeUpdate  = "Update"
+	EventTypeDelete  = "Delete"
+	EventTypeGeneric = "Generic"
+)
+
+// EnqueueRecord describes a request enqueued by an event handler wrapped with Record.
+type EnqueueRecord struct {
+	// Time is the time at which the request was enqueued
+	Time time.Time `json:"time"`
+	// EventType is the type of the event that enqueued the request, e.g. EventTypeUpdate
+	EventType string `json:"eventType"`
+	// Object is the key of the object of the event, if any
+	Object client.ObjectKey `json:"object"`
+	// Request is the enqueued request
+	Request reconcile.Request `json:"request"`
+}
+
+// EnqueueRecorder remembers the last requests enqueued by the event handlers of a controller
+// wrapped with Record, along with the event that enqueued them, to answer "why did my controller
+// wake up" in production. The records can be served as JSON on a debug endpoint, since an
+// EnqueueRecorder implements http.Handler and json.Marshaler, or logged on demand with Records.
+// It is safe for concurrent use.
+type EnqueueRecorder struct {
+	mu      sync.Mutex
+	records []EnqueueRecord
+	next    int
+	full    bool
+}
+
+// NewEnqueueRecorder returns an EnqueueRecorder remembering the last size enqueued requests,
+// DefaultEnqueueRecordSize if size is not positive.
+func NewEnqueueRecorder(size int) *EnqueueRecorder {
+	if size <= 0 {
+		size = DefaultEnqueueRecordSize
+	}
+	return &EnqueueRecorder{records: make([]EnqueueRecord, size)}
+}
+
+// Record returns an event handler that passes all events to h, and records the requests h
+// enqueues in r. An EnqueueRecorder is meant to be shared by the event handlers of a single
+// controller.
+func Record[T client.Object](r *EnqueueRecorder, h handler.TypedEventHandler[T, reconcile.Request]) handler.TypedEventHandler[T, reconcile.Request] {
+	return handler.TypedFuncs[T, reconcile.Request]{
+		CreateFunc: func(ctx context.Context, evt event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			h.Create(ctx, evt, r.queue(q, EventTypeCreate, evt.Object))
+		},
+		UpdateFunc: func(ctx context.Context, evt event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			var obj client.Object = evt.ObjectNew
+			if obj == nil {
+				obj = evt.ObjectOld
+			}
+			h.Update(ctx, evt, r.queue(q, EventTypeUpdate, obj))
+		},
+		DeleteFunc: func(ctx context.Context, evt event.TypedDeleteEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			h.Delete(ctx, evt, r.queue(q, EventTypeDelete, evt.Object))
+		},
+		GenericFunc: func(ctx context.Context, evt event.TypedGenericEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			h.Generic(ctx, evt, r.queue(q, EventTypeGeneric, evt.Object))
+		},
+	}
+}
+
+// Records returns the recorded requests, oldest first.
+func (r *EnqueueRecorder) Records() []EnqueueRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.full {
+		return append([]EnqueueRecord(nil), r.records[:r.next]...)
+	}
+	records := make([]EnqueueRecord, 0, len(r.records))
+	records = append(records, r.records[r.next:]...)
+	return append(records, r.records[:r.next]...)
+}
+
+// MarshalJSON implements json.Marshaler. The recorded requests are marshaled oldest first.
+func (r *EnqueueRecorder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Records())
+}
+
+// ServeHTTP implements http.Handler. It serves the recorded requests as JSON, oldest first.
+func (r *EnqueueRecorder) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	data, err := r.MarshalJSON()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// record records req, enqueued for an event of type eventType of the object with key object.
+func (r *EnqueueRecorder) record(eventType string, object client.ObjectKey, req reconcile.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records[r.next] = EnqueueRecord{Time: time.Now(), EventType: eventType, Object: object, Request: req}
+	r.next = (r.next + 1) % len(r.records)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// queue returns q, wrapped to record the requests added to it for an event of type eventType of
+// obj.
+func (r *EnqueueRecorder) queue(q workqueue.TypedRateLimitingInterface[reconcile.Request], eventType string, obj client.Object) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	var key client.ObjectKey
+	if obj != nil {
+		key = client.ObjectKeyFromObject(obj)
+	}
+	return &recordingQueue{TypedRateLimitingInterface: q, recorder: r, eventType: eventType, object: key}
+}
+
+// recordingQueue records the requests added to it.
+type recordingQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	recorder  *EnqueueRecorder
+	eventType string
+	object    client.ObjectKey
+}
+
+func (q *recordingQueue) Add(req reconcile.Request) {
+	q.recorder.record(q.eventType, q.object, req)
+	q.TypedRateLimitingInterface.Add(req)
+}
+
+func (q *recordingQueue) AddAfter(req reconcile.Request, duration time.Duration) {
+	q.recorder.record(q.eventType, q.object, req)
+	q.TypedRateLimitingInterface.AddAfter(req, duration)
+}
+
+func (q *recordingQueue) AddRateLimited(req reconcile.Request) {
+	q.recorder.record(q.eventType, q.object, req)
+	q.TypedRateLimitingInterface.AddRateLimited(req)
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("EnqueueRecorder", func() {
+	var (
+		ctx      context.Context
+		q        workqueue.TypedRateLimitingInterface[reconcile.Request]
+		recorder *EnqueueRecorder
+		h        handler.TypedEventHandler[client.Object, reconcile.Request]
+		pod      *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		q = &controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+		recorder = NewEnqueueRecorder(2)
+		h = Record(recorder, handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: "owner-of-" + obj.GetName()}}}
+		}))
+		pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "a"}}
+	})
+
+	It("should record the requests enqueued with their event", func() {
+		h.Create(ctx, event.TypedCreateEvent[client.Object]{Object: pod}, q)
+		h.Update(ctx, event.TypedUpdateEvent[client.Object]{ObjectOld: pod, ObjectNew: pod}, q)
+
+		records := recorder.Records()
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].EventType).To(Equal(EventTypeCreate))
+		Expect(records[1].EventType).To(Equal(EventTypeUpdate))
+		Expect(records[1].Object).To(Equal(client.ObjectKey{Namespace: "ns", Name: "a"}))
+		Expect(records[1].Request.Name).To(Equal("owner-of-a"))
+		Expect(records[1].Time).NotTo(BeTemporally("<", records[0].Time))
+		Expect(q.Len()).To(Equal(1))
+	})
+
+	It("should only remember the most recent requests", func() {
+		for _, name := range []string{"a", "b", "c"} {
+			obj := pod.DeepCopy()
+			obj.Name = name
+			h.Delete(ctx, event.TypedDeleteEvent[client.Object]{Object: obj}, q)
+		}
+		h.Generic(ctx, event.TypedGenericEvent[client.Object]{Object: pod}, q)
+
+		records := recorder.Records()
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].Object.Name).To(Equal("c"))
+		Expect(records[0].EventType).To(Equal(EventTypeDelete))
+		Expect(records[1].EventType).To(Equal(EventTypeGeneric))
+	})
+
+	It("should serve the records as JSON", func() {
+		h.Create(ctx, event.TypedCreateEvent[client.Object]{Object: pod}, q)
+
+		w := httptest.NewRecorder()
+		recorder.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/enqueued", nil))
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		var records []map[string]interface{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &records)).To(Succeed())
+		Expect(records).To(HaveLen(1))
+		Expect(records[0]).To(HaveKeyWithValue("eventType", EventTypeCreate))
+	})
+})
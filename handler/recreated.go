@@ -0,0 +1,63 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-lib/internal/annotation"
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+// DependentUIDAnnotation is set by StampDependentUID on a dependent to its own UID, so that a
+// dependent recreated with the same name, e.g. by a backup restore or a stale client racing with
+// a deletion, can be told apart from the dependent its owner created, see IsRecreated.
+const DependentUIDAnnotation = annotation.DependentUID
+
+// StampDependentUID sets DependentUIDAnnotation on obj, a dependent that has been created, to
+// the UID of obj. The owner is expected to stamp its dependents when it creates or adopts them,
+// and to update them afterwards. It returns true if the annotation changed.
+func StampDependentUID(obj client.Object) bool {
+	uid := string(obj.GetUID())
+	annotations := obj.GetAnnotations()
+	if uid == "" || annotations[DependentUIDAnnotation] == uid {
+		return false
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[DependentUIDAnnotation] = uid
+	obj.SetAnnotations(annotations)
+	return true
+}
+
+// IsRecreated returns true if obj carries a DependentUIDAnnotation that does not match its UID,
+// i.e. if obj was recreated from a copy of a dependent and has not been stamped by its owner
+// since. Objects without the annotation are never considered recreated.
+func IsRecreated(obj metav1.Object) bool {
+	return annotation.IsRecreated(obj)
+}
+
+// isRecreated returns true, and records a dropped event, if ignore is set and obj is recreated.
+func isRecreated(ignore bool, obj metav1.Object) bool {
+	if !ignore || !IsRecreated(obj) {
+		return false
+	}
+	log.V(1).Info("Ignoring event of recreated object", "namespace", obj.GetNamespace(), "name", obj.GetName(),
+		"uid", obj.GetUID())
+	libmetrics.RecordDroppedEvent(libmetrics.SubsystemHandler, libmetrics.DropReasonRecreated)
+	return true
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Recreated dependents", func() {
+	var dependent *corev1.ConfigMap
+
+	BeforeEach(func() {
+		dependent = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "churro", UID: "uid-1"}}
+	})
+
+	Describe("StampDependentUID", func() {
+		It("should set the annotation to the UID of the object", func() {
+			Expect(StampDependentUID(dependent)).To(BeTrue())
+			Expect(dependent.GetAnnotations()).To(HaveKeyWithValue(DependentUIDAnnotation, "uid-1"))
+			Expect(StampDependentUID(dependent)).To(BeFalse())
+		})
+
+		It("should not stamp objects without a UID", func() {
+			dependent.UID = ""
+			Expect(StampDependentUID(dependent)).To(BeFalse())
+			Expect(dependent.GetAnnotations()).To(BeEmpty())
+		})
+	})
+
+	Describe("IsRecreated", func() {
+		It("should detect objects whose UID changed since they were stamped", func() {
+			Expect(IsRecreated(dependent)).To(BeFalse())
+			StampDependentUID(dependent)
+			Expect(IsRecreated(dependent)).To(BeFalse())
+			dependent.UID = "uid-2"
+			Expect(IsRecreated(dependent)).To(BeTrue())
+			StampDependentUID(dependent)
+			Expect(IsRecreated(dependent)).To(BeFalse())
+		})
+	})
+
+	Describe("EnqueueRequestForAnnotation with IgnoreRecreated", func() {
+		var (
+			q         workqueue.TypedRateLimitingInterface[reconcile.Request]
+			instance  EnqueueRequestForAnnotation[client.Object]
+			recreated *corev1.ConfigMap
+		)
+
+		BeforeEach(func() {
+			q = &controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+			owner := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "owner"}}
+			owner.SetGroupVersionKind(schema.GroupVersionKind{Kind: "Pod"})
+			Expect(SetOwnerAnnotations(owner, dependent)).To(Succeed())
+			StampDependentUID(dependent)
+			recreated = dependent.DeepCopy()
+			recreated.UID = "uid-2"
+			instance = EnqueueRequestForAnnotation[client.Object]{Type: schema.GroupKind{Kind: "Pod"}, IgnoreRecreated: true}
+		})
+
+		It("should ignore the events of recreated dependents", func() {
+			instance.Create(context.TODO(), event.CreateEvent{Object: recreated}, q)
+			instance.Update(context.TODO(), event.UpdateEvent{ObjectOld: recreated, ObjectNew: recreated}, q)
+			instance.Generic(context.TODO(), event.GenericEvent{Object: recreated}, q)
+			Expect(q.Len()).To(Equal(0))
+		})
+
+		It("should enqueue the owner when the original dependent is deleted", func() {
+			instance.Delete(context.TODO(), event.DeleteEvent{Object: dependent}, q)
+			Expect(q.Len()).To(Equal(1))
+		})
+
+		It("should enqueue the owner once the recreated dependent is stamped again", func() {
+			restamped := recreated.DeepCopy()
+			StampDependentUID(restamped)
+			instance.Update(context.TODO(), event.UpdateEvent{ObjectOld: recreated, ObjectNew: restamped}, q)
+			Expect(q.Len()).To(Equal(1))
+		})
+
+		It("should enqueue the owner of recreated dependents by default", func() {
+			instance.IgnoreRecreated = false
+			instance.Create(context.TODO(), event.CreateEvent{Object: recreated}, q)
+			Expect(q.Len()).To(Equal(1))
+		})
+	})
+})
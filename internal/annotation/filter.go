@@ -36,12 +36,18 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/operator-framework/operator-lib/internal/metrics"
 )
 
 // Options configures a filter.
 type Options struct {
 	Log logr.Logger
 
+	// TrackPaused records the objects seen by the filter as paused or not, see PausedObjects.
+	// It is only supported by falsy filters.
+	TrackPaused bool
+
 	// Internally set.
 	truthy bool
 }
@@ -60,6 +66,20 @@ func NewFalsyEventHandler[T client.Object](key string, opts Options) (handler.Ty
 	return newEventHandler[T](key, opts)
 }
 
+// NewFalsyPredicateForLookup returns a predicate that passes objects for which lookup
+// finds no value or a falsy value. Name identifies the looked up value in logs.
+func NewFalsyPredicateForLookup[T client.Object](name string, lookup LookupFunc, opts Options) predicate.TypedPredicate[T] {
+	opts.truthy = false
+	return newLookupFilter[T](name, lookup, opts)
+}
+
+// NewFalsyEventHandlerForLookup returns an event handler that enqueues objects for which
+// lookup finds no value or a falsy value. Name identifies the looked up value in logs.
+func NewFalsyEventHandlerForLookup[T client.Object](name string, lookup LookupFunc, opts Options) handler.TypedEventHandler[T, reconcile.Request] {
+	opts.truthy = false
+	return filterEventHandler(newLookupFilter[T](name, lookup, opts))
+}
+
 // NewTruthyPredicate returns a predicate that passes objects
 // that do have annotation with key string key and whose value is truthy.
 func NewTruthyPredicate[T client.Object](key string, opts Options) (predicate.TypedPredicate[T], error) {
@@ -86,7 +106,11 @@ func newEventHandler[T client.Object](key string, opts Options) (handler.TypedEv
 	if err != nil {
 		return nil, err
 	}
+	return filterEventHandler(f), nil
+}
 
+// filterEventHandler returns an event handler that enqueues objects passing filter f.
+func filterEventHandler[T client.Object](f *filter[T]) handler.TypedEventHandler[T, reconcile.Request] {
 	f.hdlr = &handler.TypedEnqueueRequestForObject[T]{}
 	return handler.TypedFuncs[T, reconcile.Request]{
 		CreateFunc: func(ctx context.Context, evt event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
@@ -109,25 +133,32 @@ func newEventHandler[T client.Object](key string, opts Options) (handler.TypedEv
 				f.hdlr.Generic(ctx, evt, q)
 			}
 		},
-	}, nil
+	}
 }
 
 // newFilter returns a filter for use as a predicate.
 func newFilter[T client.Object](key string, opts Options) (*filter[T], error) {
-	defaultOptions(&opts)
-
 	// Make sure the annotation key and eventual value are valid together.
 	if err := validateAnnotation(key, opts.truthy); err != nil {
 		return nil, err
 	}
 
+	return newLookupFilter[T](key, annotationLookup(key), opts), nil
+}
+
+// newLookupFilter returns a filter for use as a predicate that evaluates the values found by lookup.
+func newLookupFilter[T client.Object](key string, lookup LookupFunc, opts Options) *filter[T] {
+	defaultOptions(&opts)
+
 	f := filter[T]{}
 	f.key = key
-	// Falsy filters return true in all cases except when the annotation is present and true.
-	// Truthy filters only return true when the annotation is present and true.
+	f.lookup = lookup
+	// Falsy filters return true in all cases except when the value is present and true.
+	// Truthy filters only return true when the value is present and true.
 	f.ret = !opts.truthy
+	f.trackPaused = opts.TrackPaused && !opts.truthy
 	f.log = opts.Log.WithName("pause")
-	return &f, nil
+	return &f
 }
 
 func validateAnnotation(key string, truthy bool) error {
@@ -140,10 +171,12 @@ func validateAnnotation(key string, truthy bool) error {
 // When this annotation is removed or value does not evaluate to "true",
 // the controller will see events from these objects again.
 type filter[T client.Object] struct {
-	key  string
-	ret  bool
-	log  logr.Logger
-	hdlr *handler.TypedEnqueueRequestForObject[T]
+	key         string
+	lookup      LookupFunc
+	ret         bool
+	trackPaused bool
+	log         logr.Logger
+	hdlr        *handler.TypedEnqueueRequestForObject[T]
 }
 
 // Create implements predicate.Predicate.Create().
@@ -151,7 +184,7 @@ func (f *filter[T]) Create(evt event.TypedCreateEvent[T]) bool {
 	var obj client.Object = evt.Object
 	if obj == nil {
 		f.log.Error(nil, "CreateEvent received with no object", "event", evt)
-		return f.ret
+		return f.noObject()
 	}
 	return f.run(obj)
 }
@@ -171,7 +204,7 @@ func (f *filter[T]) Update(evt event.TypedUpdateEvent[T]) bool {
 	if f.hdlr == nil {
 		f.log.Error(nil, "UpdateEvent received with no metadata", "event", evt)
 	}
-	return f.ret
+	return f.noObject()
 }
 
 // Delete implements predicate.Predicate.Delete().
@@ -181,9 +214,13 @@ func (f *filter[T]) Delete(evt event.TypedDeleteEvent[T]) bool {
 		if f.hdlr == nil {
 			f.log.Error(nil, "DeleteEvent received with no metadata", "event", evt)
 		}
-		return f.ret
+		return f.noObject()
 	}
-	return f.run(obj)
+	pass := f.run(obj)
+	if f.trackPaused {
+		trackPaused(obj, false)
+	}
+	return pass
 }
 
 // Generic implements predicate.Predicate.Generic().
@@ -193,26 +230,50 @@ func (f *filter[T]) Generic(evt event.TypedGenericEvent[T]) bool {
 		if f.hdlr == nil {
 			f.log.Error(nil, "GenericEvent received with no metadata", "event", evt)
 		}
-		return f.ret
+		return f.noObject()
 	}
 	return f.run(obj)
 }
 
 func (f *filter[T]) run(obj client.Object) bool {
-	annotations := obj.GetAnnotations()
-	if len(annotations) == 0 {
-		return f.ret
-	}
-	annoStr, hasAnno := annotations[f.key]
-	if !hasAnno {
-		return f.ret
+	value, found := f.lookup(obj)
+	if !found {
+		return f.track(obj, f.result(f.ret, metrics.DropReasonValueMissing))
 	}
-	annoBool, err := strconv.ParseBool(annoStr)
+	valueBool, err := strconv.ParseBool(value)
 	if err != nil {
-		f.log.Error(err, "Bad annotation value", "key", f.key, "value", annoStr)
-		return f.ret
+		f.log.Error(err, "Bad value", "key", f.key, "value", value)
+		return f.track(obj, f.result(f.ret, metrics.DropReasonValueInvalid))
 	}
 	// If the filter is falsy (f.ret == true) and value is false, then the object passes the filter.
 	// If the filter is truthy (f.ret == false) and value is true, then the object passes the filter.
-	return !annoBool == f.ret
+	if f.ret {
+		return f.track(obj, f.result(!valueBool, metrics.DropReasonPaused))
+	}
+	return f.result(valueBool, metrics.DropReasonPredicateFiltered)
+}
+
+// track records obj as paused if it does not pass a pause filter, and returns pass.
+func (f *filter[T]) track(obj client.Object, pass bool) bool {
+	if f.trackPaused {
+		trackPaused(obj, !pass)
+	}
+	return pass
+}
+
+// noObject returns the result of the filter for events without an object.
+func (f *filter[T]) noObject() bool {
+	return f.result(f.ret, metrics.DropReasonNoObject)
+}
+
+// result records events that do not pass the filter as dropped for reason, and returns pass.
+func (f *filter[T]) result(pass bool, reason string) bool {
+	if !pass {
+		subsystem := metrics.SubsystemPredicate
+		if f.hdlr != nil {
+			subsystem = metrics.SubsystemHandler
+		}
+		metrics.RecordDroppedEvent(subsystem, reason)
+	}
+	return pass
 }
@@ -18,9 +18,11 @@ import (
 	"context"
 
 	"github.com/operator-framework/operator-lib/internal/annotation"
+	"github.com/operator-framework/operator-lib/internal/metrics"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -588,3 +590,59 @@ func verifyQueueHasPod(q workqueue.TypedRateLimitingInterface[reconcile.Request]
 func verifyQueueEmpty(q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	ExpectWithOffset(1, q.Len()).To(Equal(0))
 }
+
+var _ = Describe("dropped events", func() {
+	const annotationKey = "my.app/paused"
+
+	var (
+		ctx = context.TODO()
+		q   workqueue.TypedRateLimitingInterface[reconcile.Request]
+		pod *corev1.Pod
+	)
+	BeforeEach(func() {
+		q = &controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+
+		pod = &corev1.Pod{}
+		pod.SetName("foo")
+		pod.SetNamespace("default")
+	})
+
+	dropped := func(subsystem, reason string) float64 {
+		return testutil.ToFloat64(metrics.DroppedEvents.WithLabelValues(subsystem, reason))
+	}
+
+	It("records paused objects dropped by a falsy event handler", func() {
+		hdlr, err := annotation.NewFalsyEventHandler[client.Object](annotationKey, annotation.Options{Log: logf.Log})
+		Expect(err).NotTo(HaveOccurred())
+		before := dropped(metrics.SubsystemHandler, metrics.DropReasonPaused)
+
+		pod.SetAnnotations(map[string]string{annotationKey: "true"})
+		hdlr.Create(ctx, makeCreateEventFor(pod), q)
+		Expect(q.Len()).To(Equal(0))
+		Expect(dropped(metrics.SubsystemHandler, metrics.DropReasonPaused)).To(Equal(before + 1))
+	})
+
+	It("records objects dropped by a truthy predicate by reason", func() {
+		pred, err := annotation.NewTruthyPredicate[client.Object](annotationKey, annotation.Options{Log: logf.Log})
+		Expect(err).NotTo(HaveOccurred())
+		missing := dropped(metrics.SubsystemPredicate, metrics.DropReasonValueMissing)
+		filtered := dropped(metrics.SubsystemPredicate, metrics.DropReasonPredicateFiltered)
+
+		Expect(pred.Create(makeCreateEventFor(pod))).To(BeFalse())
+		Expect(dropped(metrics.SubsystemPredicate, metrics.DropReasonValueMissing)).To(Equal(missing + 1))
+
+		invalid := dropped(metrics.SubsystemPredicate, metrics.DropReasonValueInvalid)
+		pod.SetAnnotations(map[string]string{annotationKey: "maybe"})
+		Expect(pred.Create(makeCreateEventFor(pod))).To(BeFalse())
+		Expect(dropped(metrics.SubsystemPredicate, metrics.DropReasonValueInvalid)).To(Equal(invalid + 1))
+		Expect(dropped(metrics.SubsystemPredicate, metrics.DropReasonValueMissing)).To(Equal(missing + 1))
+
+		pod.SetAnnotations(map[string]string{annotationKey: "false"})
+		Expect(pred.Create(makeCreateEventFor(pod))).To(BeFalse())
+		Expect(dropped(metrics.SubsystemPredicate, metrics.DropReasonPredicateFiltered)).To(Equal(filtered + 1))
+
+		pod.SetAnnotations(map[string]string{annotationKey: "true"})
+		Expect(pred.Create(makeCreateEventFor(pod))).To(BeTrue())
+		Expect(dropped(metrics.SubsystemPredicate, metrics.DropReasonPredicateFiltered)).To(Equal(filtered + 1))
+	})
+})
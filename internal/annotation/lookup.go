@@ -0,0 +1,81 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package annotation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LookupFunc returns the value evaluated by a filter for obj, and whether it was found.
+// Values are parsed with strconv.ParseBool.
+type LookupFunc func(obj client.Object) (value string, found bool)
+
+// annotationLookup returns a LookupFunc for the annotation with the given key.
+func annotationLookup(key string) LookupFunc {
+	return func(obj client.Object) (string, bool) {
+		value, found := obj.GetAnnotations()[key]
+		return value, found
+	}
+}
+
+// BoolLookup returns a LookupFunc for a boolean extracted from objects by fn.
+func BoolLookup(fn func(client.Object) bool) LookupFunc {
+	return func(obj client.Object) (string, bool) {
+		return strconv.FormatBool(fn(obj)), true
+	}
+}
+
+// JSONPathLookup returns a LookupFunc evaluating the given JSONPath expression, e.g.
+// "{.spec.paused}" or ".spec.paused", against objects. Typed objects are converted to
+// their unstructured representation first. The value is not found if the path does not
+// exist on an object or evaluates to null.
+func JSONPathLookup(path string) (LookupFunc, error) {
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+	jp := jsonpath.New("pause").AllowMissingKeys(true)
+	if err := jp.Parse(path); err != nil {
+		return nil, fmt.Errorf("invalid JSONPath %q: %w", path, err)
+	}
+
+	return func(obj client.Object) (string, bool) {
+		var content map[string]interface{}
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			content = u.Object
+		} else {
+			var err error
+			if content, err = runtime.DefaultUnstructuredConverter.ToUnstructured(obj); err != nil {
+				return "", false
+			}
+		}
+
+		results, err := jp.FindResults(content)
+		if err != nil || len(results) == 0 || len(results[0]) == 0 {
+			return "", false
+		}
+		value := results[0][0]
+		if !value.IsValid() || !value.CanInterface() || value.Interface() == nil {
+			return "", false
+		}
+		return fmt.Sprint(value.Interface()), true
+	}, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package annotation_test
+
+import (
+	"context"
+
+	"github.com/operator-framework/operator-lib/internal/annotation"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("lookup", func() {
+	newObject := func(spec map[string]interface{}) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Foo",
+			"metadata":   map[string]interface{}{"name": "foo", "namespace": "default"},
+		}}
+		if spec != nil {
+			u.Object["spec"] = spec
+		}
+		return u
+	}
+
+	Describe("JSONPathLookup", func() {
+		It("returns an error for an invalid path", func() {
+			_, err := annotation.JSONPathLookup("{.spec[}")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("finds boolean and string values on unstructured objects", func() {
+			lookup, err := annotation.JSONPathLookup(".spec.paused")
+			Expect(err).NotTo(HaveOccurred())
+
+			value, found := lookup(newObject(map[string]interface{}{"paused": true}))
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("true"))
+
+			value, found = lookup(newObject(map[string]interface{}{"paused": "false"}))
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("false"))
+		})
+
+		It("does not find missing or null values", func() {
+			lookup, err := annotation.JSONPathLookup("{.spec.paused}")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, found := lookup(newObject(nil))
+			Expect(found).To(BeFalse())
+			_, found = lookup(newObject(map[string]interface{}{"paused": nil}))
+			Expect(found).To(BeFalse())
+		})
+
+		It("finds values on typed objects", func() {
+			lookup, err := annotation.JSONPathLookup("{.spec.hostNetwork}")
+			Expect(err).NotTo(HaveOccurred())
+
+			value, found := lookup(&corev1.Pod{Spec: corev1.PodSpec{HostNetwork: true}})
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("true"))
+		})
+	})
+
+	Describe("NewFalsyPredicateForLookup", func() {
+		It("filters out objects whose value is truthy", func() {
+			lookup, err := annotation.JSONPathLookup("{.spec.paused}")
+			Expect(err).NotTo(HaveOccurred())
+			pred := annotation.NewFalsyPredicateForLookup[client.Object]("{.spec.paused}", lookup, annotation.Options{Log: logf.Log})
+
+			Expect(pred.Create(event.CreateEvent{Object: newObject(nil)})).To(BeTrue())
+			Expect(pred.Create(event.CreateEvent{Object: newObject(map[string]interface{}{"paused": false})})).To(BeTrue())
+			Expect(pred.Create(event.CreateEvent{Object: newObject(map[string]interface{}{"paused": "invalid"})})).To(BeTrue())
+			Expect(pred.Create(event.CreateEvent{Object: newObject(map[string]interface{}{"paused": true})})).To(BeFalse())
+			Expect(pred.Update(event.UpdateEvent{
+				ObjectOld: newObject(nil),
+				ObjectNew: newObject(map[string]interface{}{"paused": true}),
+			})).To(BeFalse())
+		})
+	})
+
+	Describe("NewFalsyEventHandlerForLookup", func() {
+		It("only enqueues objects for which the function returns false", func() {
+			q := &controllertest.Queue{TypedInterface: workqueue.NewTyped[reconcile.Request]()}
+			hdlr := annotation.NewFalsyEventHandlerForLookup[client.Object]("func", annotation.BoolLookup(func(obj client.Object) bool {
+				return obj.(*corev1.Pod).Spec.HostNetwork
+			}), annotation.Options{Log: logf.Log})
+
+			paused := &corev1.Pod{Spec: corev1.PodSpec{HostNetwork: true}}
+			paused.SetName("paused")
+			hdlr.Create(context.TODO(), event.CreateEvent{Object: paused}, q)
+			Expect(q.Len()).To(Equal(0))
+
+			running := &corev1.Pod{}
+			running.SetName("running")
+			hdlr.Create(context.TODO(), event.CreateEvent{Object: running}, q)
+			Expect(q.Len()).To(Equal(1))
+			req, _ := q.Get()
+			Expect(req.Name).To(Equal("running"))
+		})
+	})
+})
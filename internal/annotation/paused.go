@@ -0,0 +1,89 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package annotation
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+// PausedObject identifies an object that was most recently seen paused by a pause filter.
+type PausedObject struct {
+	GVK schema.GroupVersionKind
+	Key types.NamespacedName
+}
+
+// pausedObjects holds the objects seen paused by the filters with Options.TrackPaused set.
+var pausedObjects = struct {
+	mu      sync.RWMutex
+	objects map[PausedObject]struct{}
+}{objects: map[PausedObject]struct{}{}}
+
+// PausedObjects returns the objects that were most recently seen paused, sorted by GVK and key.
+func PausedObjects() []PausedObject {
+	pausedObjects.mu.RLock()
+	out := make([]PausedObject, 0, len(pausedObjects.objects))
+	for o := range pausedObjects.objects {
+		out = append(out, o)
+	}
+	pausedObjects.mu.RUnlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		if gi, gj := out[i].GVK.String(), out[j].GVK.String(); gi != gj {
+			return gi < gj
+		}
+		return out[i].Key.String() < out[j].Key.String()
+	})
+	return out
+}
+
+// trackPaused records whether obj is paused, updating the PausedObjects metric of its kind.
+func trackPaused(obj client.Object, paused bool) {
+	o := PausedObject{GVK: gvkFor(obj), Key: client.ObjectKeyFromObject(obj)}
+
+	pausedObjects.mu.Lock()
+	defer pausedObjects.mu.Unlock()
+	_, tracked := pausedObjects.objects[o]
+	switch {
+	case paused && !tracked:
+		pausedObjects.objects[o] = struct{}{}
+		metrics.PausedObjects.WithLabelValues(o.GVK.Group, o.GVK.Version, o.GVK.Kind).Inc()
+	case !paused && tracked:
+		delete(pausedObjects.objects, o)
+		metrics.PausedObjects.WithLabelValues(o.GVK.Group, o.GVK.Version, o.GVK.Kind).Dec()
+	}
+}
+
+// gvkFor returns the GVK of obj. Objects received from informers usually lack their type
+// information, in which case it is looked up in client-go's scheme. Kinds unknown to it,
+// such as custom resources, are identified by the name of their Go type.
+func gvkFor(obj client.Object) schema.GroupVersionKind {
+	if gvk := obj.GetObjectKind().GroupVersionKind(); gvk.Kind != "" {
+		return gvk
+	}
+	if gvk, err := apiutil.GVKForObject(obj, clientgoscheme.Scheme); err == nil {
+		return gvk
+	}
+	return schema.GroupVersionKind{Kind: reflect.Indirect(reflect.ValueOf(obj)).Type().Name()}
+}
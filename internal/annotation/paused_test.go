@@ -0,0 +1,108 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package annotation_test
+
+import (
+	"github.com/operator-framework/operator-lib/internal/annotation"
+	"github.com/operator-framework/operator-lib/internal/metrics"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+var _ = Describe("PausedObjects", func() {
+	const annotationKey = "my.app/paused"
+
+	var (
+		pred     predicate.TypedPredicate[client.Object]
+		pod      *corev1.Pod
+		podGVK   = corev1.SchemeGroupVersion.WithKind("Pod")
+		pausedOf = func(gvk schema.GroupVersionKind) float64 {
+			return testutil.ToFloat64(metrics.PausedObjects.WithLabelValues(gvk.Group, gvk.Version, gvk.Kind))
+		}
+	)
+	BeforeEach(func() {
+		var err error
+		pred, err = annotation.NewFalsyPredicate[client.Object](annotationKey, annotation.Options{Log: logf.Log, TrackPaused: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		pod = &corev1.Pod{}
+		pod.SetName("paused")
+		pod.SetNamespace("default")
+	})
+
+	pausedPod := annotation.PausedObject{GVK: corev1.SchemeGroupVersion.WithKind("Pod"), Key: types.NamespacedName{Namespace: "default", Name: "paused"}}
+
+	It("tracks objects until they are seen unpaused", func() {
+		before := pausedOf(podGVK)
+
+		pod.SetAnnotations(map[string]string{annotationKey: "true"})
+		Expect(pred.Create(event.CreateEvent{Object: pod})).To(BeFalse())
+		Expect(pred.Update(event.UpdateEvent{ObjectOld: pod, ObjectNew: pod})).To(BeFalse())
+		Expect(annotation.PausedObjects()).To(ContainElement(pausedPod))
+		Expect(pausedOf(podGVK)).To(Equal(before + 1))
+
+		pod.SetAnnotations(map[string]string{annotationKey: "false"})
+		Expect(pred.Update(event.UpdateEvent{ObjectOld: pod, ObjectNew: pod})).To(BeTrue())
+		Expect(annotation.PausedObjects()).NotTo(ContainElement(pausedPod))
+		Expect(pausedOf(podGVK)).To(Equal(before))
+	})
+
+	It("forgets deleted objects", func() {
+		before := pausedOf(podGVK)
+
+		pod.SetAnnotations(map[string]string{annotationKey: "true"})
+		Expect(pred.Create(event.CreateEvent{Object: pod})).To(BeFalse())
+		Expect(pred.Delete(event.DeleteEvent{Object: pod})).To(BeFalse())
+		Expect(annotation.PausedObjects()).NotTo(ContainElement(pausedPod))
+		Expect(pausedOf(podGVK)).To(Equal(before))
+	})
+
+	It("uses the type information of objects that have it", func() {
+		gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"}
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		u.SetNamespace("default")
+		u.SetName("db")
+		u.SetAnnotations(map[string]string{annotationKey: "true"})
+
+		Expect(pred.Generic(event.GenericEvent{Object: u})).To(BeFalse())
+		Expect(annotation.PausedObjects()).To(ContainElement(annotation.PausedObject{
+			GVK: gvk,
+			Key: types.NamespacedName{Namespace: "default", Name: "db"},
+		}))
+		Expect(pausedOf(gvk)).To(Equal(1.0))
+	})
+
+	It("does not track objects without TrackPaused", func() {
+		untracked, err := annotation.NewFalsyPredicate[client.Object](annotationKey, annotation.Options{Log: logf.Log})
+		Expect(err).NotTo(HaveOccurred())
+		pod.SetName("untracked")
+		pod.SetAnnotations(map[string]string{annotationKey: "true"})
+		Expect(untracked.Create(event.CreateEvent{Object: pod})).To(BeFalse())
+		for _, o := range annotation.PausedObjects() {
+			Expect(o.Key.Name).NotTo(Equal("untracked"))
+		}
+	})
+})
@@ -0,0 +1,29 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package annotation
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DependentUID is the key of the annotation set on dependents to their own UID, see
+// handler.DependentUIDAnnotation.
+const DependentUID = "operator-lib.operatorframework.io/dependent-uid"
+
+// IsRecreated returns true if obj carries a DependentUID annotation that does not match its UID.
+func IsRecreated(obj metav1.Object) bool {
+	uid, ok := obj.GetAnnotations()[DependentUID]
+	return ok && obj.GetUID() != "" && uid != string(obj.GetUID())
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics contains the metrics shared by all packages of the library.
+package metrics
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Subsystems of the library reported in the "subsystem" label of Errors.
+const (
+	SubsystemLeader      = "leader"
+	SubsystemPrune       = "prune"
+	SubsystemConditions  = "conditions"
+	SubsystemHandler     = "handler"
+	SubsystemPredicate   = "predicate"
+	SubsystemGate        = "gate"
+	SubsystemTerminating = "terminating"
+)
+
+// Reasons reported in the "reason" label of DroppedEvents.
+const (
+	// DropReasonPaused is used for events of objects that are paused.
+	DropReasonPaused = "paused"
+	// DropReasonValueMissing is used for events of objects lacking the value, e.g. an annotation
+	// or a spec field, that a filter requires.
+	DropReasonValueMissing = "value_missing"
+	// DropReasonValueInvalid is used for events of objects whose value, e.g. an annotation or a
+	// spec field, cannot be evaluated by a filter.
+	DropReasonValueInvalid = "value_invalid"
+	// DropReasonPredicateFiltered is used for events that a predicate does not pass.
+	DropReasonPredicateFiltered = "predicate_filtered"
+	// DropReasonStale is used for events that do not carry a newer resourceVersion.
+	DropReasonStale = "stale"
+	// DropReasonGateClosed is used for events dropped while a gate is closed.
+	DropReasonGateClosed = "gate_closed"
+	// DropReasonNoObject is used for events that carry no object.
+	DropReasonNoObject = "no_object"
+	// DropReasonNamespaceTerminating is used for events of objects in a terminating namespace.
+	DropReasonNamespaceTerminating = "namespace_terminating"
+	// DropReasonRecreated is used for events of objects recreated without being stamped again by
+	// their owner.
+	DropReasonRecreated = "recreated"
+)
+
+// Errors counts notable internal failures of the library, with information {"subsystem", "reason"}.
+// It gives a single alerting signal for the failures of all subsystems, whichever package
+// registers it, see Register.
+var Errors = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "operator_lib_errors_total",
+	Help: "Total number of internal errors encountered by operator-lib, by subsystem and reason",
+}, []string{"subsystem", "reason"})
+
+// RecordError increments Errors for the given subsystem and reason.
+func RecordError(subsystem, reason string) {
+	Errors.WithLabelValues(subsystem, reason).Inc()
+}
+
+// DroppedEvents counts events that the handlers and predicates of the library did not pass on,
+// with information {"subsystem", "reason"}. It gives a single place to find out why reconciliations
+// did or did not happen.
+var DroppedEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "operator_lib_dropped_events_total",
+	Help: "Total number of events dropped by operator-lib handlers and predicates, by subsystem and reason",
+}, []string{"subsystem", "reason"})
+
+// RecordDroppedEvent increments DroppedEvents for the given subsystem and reason.
+func RecordDroppedEvent(subsystem, reason string) {
+	DroppedEvents.WithLabelValues(subsystem, reason).Inc()
+}
+
+// PausedObjects is the number of objects most recently seen paused by the pause handlers and
+// predicates of the library, with information {"group", "version", "kind"}
+var PausedObjects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "operator_lib_paused_objects",
+	Help: "Number of objects currently paused, by group, version and kind",
+}, []string{"group", "version", "kind"})
+
+// Register registers the metrics with reg. Metrics that are already registered with reg are
+// ignored, so that Register can be called by every user of the metrics.
+func Register(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{
+		Errors,
+		DroppedEvents,
+		PausedObjects,
+	} {
+		if err := reg.Register(c); err != nil {
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if !errors.As(err, &alreadyRegistered) {
+				return err
+			}
+		}
+	}
+	return nil
+}
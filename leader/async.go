@@ -0,0 +1,87 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leader
+
+import (
+	"context"
+)
+
+// Leadership is the outcome of an attempt to become the leader started with BecomeAsync.
+// It can be shared by several consumers, e.g. to gate multiple controllers on leadership.
+type Leadership struct {
+	done    chan struct{}
+	elected chan struct{}
+	err     error
+}
+
+// BecomeAsync starts Become in the background and returns immediately, so that work that does
+// not require leadership, such as serving metrics or webhooks, can start right away while only
+// the controllers wait for the returned Leadership. Cancelling ctx aborts the attempt.
+func BecomeAsync(ctx context.Context, lockName string, opts ...Option) *Leadership {
+	l := &Leadership{done: make(chan struct{}), elected: make(chan struct{})}
+	go func() {
+		defer close(l.done)
+		l.err = Become(ctx, lockName, opts...)
+		if l.err == nil {
+			close(l.elected)
+		}
+	}()
+	return l
+}
+
+// Done returns a channel that is closed once the attempt to become the leader has completed,
+// either successfully or with an error returned by Err.
+func (l *Leadership) Done() <-chan struct{} {
+	return l.done
+}
+
+// Elected returns a channel that is closed once the current pod became the leader. Unlike Done,
+// it is never closed if the attempt fails, so that components waiting for leadership can simply
+// select on it.
+func (l *Leadership) Elected() <-chan struct{} {
+	return l.elected
+}
+
+// Err returns the error returned by Become, or nil if the current pod became the leader or
+// the attempt has not completed yet.
+func (l *Leadership) Err() error {
+	select {
+	case <-l.done:
+		return l.err
+	default:
+		return nil
+	}
+}
+
+// IsLeader returns true if the current pod became the leader.
+func (l *Leadership) IsLeader() bool {
+	select {
+	case <-l.done:
+		return l.err == nil
+	default:
+		return false
+	}
+}
+
+// Wait blocks until the attempt to become the leader completes and returns its error, or until
+// ctx is done, in which case the context's error is returned.
+func (l *Leadership) Wait(ctx context.Context) error {
+	select {
+	case <-l.done:
+		return l.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
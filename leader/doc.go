@@ -40,6 +40,9 @@ leader.
 The lock record in this case is a ConfigMap whose OwnerReference is set to the
 Pod that is the leader. When the leader is destroyed, the ConfigMap gets
 garbage-collected, enabling a different candidate Pod to become the leader.
+A coordination.k8s.io/v1 Lease, held by the leader Pod but never renewed, can
+be used as the lock record instead, see WithLockType. The lock record can also
+be owned by the Deployment or StatefulSet of the leader, see WithWorkloadOwner.
 
 Leader for Life requires that all candidate Pods be in the same Namespace. It
 uses the downwards API to determine the pod name, as hostname is not reliable.
@@ -0,0 +1,87 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Reasons reported in the "reason" label of LockTakeovers.
+const (
+	TakeoverReasonEvicted          = "evicted"
+	TakeoverReasonPreempted        = "preempted"
+	TakeoverReasonNodeNotReady     = "node_not_ready"
+	TakeoverReasonNodeUnreachable  = "node_unreachable"
+	TakeoverReasonStuckTerminating = "stuck_terminating"
+	TakeoverReasonLeaderGone       = "leader_gone"
+)
+
+// LockAcquisitionAttempts counts the attempts to create the leader lock,
+// with information {"lock"}
+var LockAcquisitionAttempts = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "leader_lock_acquisition_attempts_total",
+	Help: "Total number of attempts to acquire the leader lock",
+}, []string{"lock"})
+
+// LockWaitDuration observes the time between the start of leader election and the
+// acquisition of the lock, with information {"lock"}
+var LockWaitDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "leader_lock_wait_seconds",
+	Help:    "Time spent waiting to acquire the leader lock",
+	Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+}, []string{"lock"})
+
+// LockTakeovers counts the takeovers of the leader lock from a leader that was evicted,
+// preempted, running on a NotReady or unreachable node, stuck terminating, or deleted without its
+// lock, with information {"lock", "reason"}
+var LockTakeovers = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "leader_lock_takeovers_total",
+	Help: "Total number of takeovers of the leader lock from a failed leader, by reason",
+}, []string{"lock", "reason"})
+
+// IsLeader is set to 1 once the current pod holds the leader lock, with information {"lock"}
+var IsLeader = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "leader_is_leader",
+	Help: "Whether the current pod holds the leader lock (1) or not (0)",
+}, []string{"lock"})
+
+// Leader is set to 1 for the pod holding the leader lock while it acts as the leader, with
+// information {"lock", "pod"}
+var Leader = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "operator_lib_leader",
+	Help: "Pod holding the leader-for-life lock (1), by lock",
+}, []string{"lock", "pod"})
+
+// Register registers the leader metrics with reg. Metrics that are already registered
+// with reg are skipped.
+func Register(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{
+		LockAcquisitionAttempts,
+		LockWaitDuration,
+		LockTakeovers,
+		IsLeader,
+		Leader,
+	} {
+		if err := reg.Register(c); err != nil {
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if !errors.As(err, &alreadyRegistered) {
+				return err
+			}
+		}
+	}
+	return nil
+}
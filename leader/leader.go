@@ -16,19 +16,26 @@ package leader
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
 	"github.com/operator-framework/operator-lib/internal/utils"
+	"github.com/operator-framework/operator-lib/leader/internal/metrics"
 )
 
 // ErrNoNamespace indicates that a namespace could not be found for the current
@@ -43,10 +50,40 @@ var readNamespace = utils.GetOperatorNamespace
 
 var log = logf.Log.WithName("leader")
 
+const (
+	// LockHolderAnnotation is set on the lock record to the name of the leader pod
+	// when lock record annotations are enabled with WithLockRecordAnnotations.
+	LockHolderAnnotation = "operator-lib.operatorframework.io/leader-holder"
+	// LockAcquireTimeAnnotation is set on the lock record to the RFC3339 time at which
+	// the leader acquired the lock when lock record annotations are enabled.
+	LockAcquireTimeAnnotation = "operator-lib.operatorframework.io/leader-acquire-time"
+)
+
+// Reasons of the events emitted on leadership transitions when an EventRecorder is configured.
+const (
+	// LeaderElectedReason is used when the current pod becomes the leader.
+	LeaderElectedReason = "LeaderElected"
+	// LeaderEvictedReason is used when an evicted leader pod is deleted to take over the lock.
+	LeaderEvictedReason = "LeaderEvicted"
+	// LeaderPreemptedReason is used when a preempted leader pod is deleted to take over the lock.
+	LeaderPreemptedReason = "LeaderPreempted"
+	// StaleLockDeletedReason is used when the lock of a leader running on a NotReady node, or of a
+	// leader meeting a StaleLockPolicy, is deleted.
+	StaleLockDeletedReason = "StaleLockDeleted"
+)
+
 // defaultMaxBackoffInterval defines the default maximum amount of time to wait between
 // attempts to become the leader.
 const defaultMaxBackoffInterval = time.Second * 16
 
+// defaultInitialBackoffInterval defines the default amount of time to wait after the first
+// attempt to become the leader.
+const defaultInitialBackoffInterval = time.Second
+
+// ErrAcquisitionTimeout is returned by Become when the lock could not be acquired within the
+// time or the number of attempts set with WithTimeout or WithMaxAttempts.
+var ErrAcquisitionTimeout = errors.New("timed out acquiring the leader lock")
+
 // Option is a function that can modify Become's Config
 type Option func(*Config) error
 
@@ -54,6 +91,59 @@ type Option func(*Config) error
 type Config struct {
 	Client             crclient.Client
 	MaxBackoffInterval time.Duration
+
+	// InitialBackoffInterval is the time waited after the first attempt to acquire the lock. It
+	// doubles after each attempt, up to MaxBackoffInterval, see WithBackoff.
+	InitialBackoffInterval time.Duration
+
+	// MaxAttempts, if positive, is the number of attempts to acquire the lock after which Become
+	// gives up, see WithMaxAttempts.
+	MaxAttempts int
+
+	// Timeout, if positive, is the time after which Become gives up acquiring the lock, see
+	// WithTimeout.
+	Timeout time.Duration
+
+	// EventRecorder, if set, is used to emit events on leadership transitions. Events are
+	// attached to the lock record and to the Deployment running the operator, if any.
+	EventRecorder record.EventRecorder
+
+	// RecordLockAnnotations adds LockHolderAnnotation and LockAcquireTimeAnnotation to the
+	// lock record when it is created.
+	RecordLockAnnotations bool
+
+	// MetricsRegisterer, if set, is used to register the metrics of Become, see WithMetricsRegistry.
+	MetricsRegisterer prometheus.Registerer
+
+	// SkipNodeCheck disables the deletion of the lock of a leader running on a NotReady node,
+	// see WithoutNodeCheck.
+	SkipNodeCheck bool
+
+	// LockType is the kind of object used as the lock record, ConfigMapLock if empty, see
+	// WithLockType.
+	LockType LockType
+
+	// SkipConfigMapLock stops holding a ConfigMap lock next to a Lease, see WithoutConfigMapLock.
+	SkipConfigMapLock bool
+
+	// StaleLockPolicies are the additional conditions under which the lock of a leader is
+	// broken, see WithStaleLockPolicies.
+	StaleLockPolicies []StaleLockPolicy
+
+	// WorkloadOwner makes the workload of the leader pod own the lock record, see
+	// WithWorkloadOwner.
+	WorkloadOwner bool
+
+	// workloadOwner is the owner of the lock records when WorkloadOwner is set and the current
+	// pod is controlled by a supported workload.
+	workloadOwner *metav1.OwnerReference
+
+	// OnStartedLeading, if set, is called once the lock is acquired, see WithOnStartedLeading.
+	OnStartedLeading func(context.Context)
+
+	// OnStoppedLeading, if set, is called once the current pod stops acting as the leader, see
+	// WithOnStoppedLeading.
+	OnStoppedLeading func()
 }
 
 func (c *Config) setDefaults() error {
@@ -75,6 +165,22 @@ func (c *Config) setDefaults() error {
 	if c.MaxBackoffInterval <= 0 {
 		c.MaxBackoffInterval = defaultMaxBackoffInterval
 	}
+	if c.InitialBackoffInterval <= 0 {
+		c.InitialBackoffInterval = defaultInitialBackoffInterval
+	}
+
+	if c.LockType == "" {
+		c.LockType = ConfigMapLock
+	}
+
+	if c.MetricsRegisterer != nil {
+		if err := metrics.Register(c.MetricsRegisterer); err != nil {
+			return fmt.Errorf("error registering leader metrics: %w", err)
+		}
+		if err := libmetrics.Register(c.MetricsRegisterer); err != nil {
+			return fmt.Errorf("error registering operator-lib metrics: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -86,15 +192,131 @@ func WithClient(cl crclient.Client) Option {
 	}
 }
 
+// WithBackoff returns an Option that sets the time waited between attempts to acquire the lock:
+// initial after the first attempt, doubling after each attempt up to maxInterval. Non-positive
+// values keep the defaults of one and sixteen seconds.
+func WithBackoff(initial, maxInterval time.Duration) Option {
+	return func(c *Config) error {
+		c.InitialBackoffInterval = initial
+		c.MaxBackoffInterval = maxInterval
+		return nil
+	}
+}
+
+// WithMaxAttempts returns an Option that makes Become give up after n attempts to acquire the
+// lock, returning an error wrapping ErrAcquisitionTimeout. Become retries until ctx is done by
+// default.
+func WithMaxAttempts(n int) Option {
+	return func(c *Config) error {
+		if n <= 0 {
+			return fmt.Errorf("max attempts must be positive, got %d", n)
+		}
+		c.MaxAttempts = n
+		return nil
+	}
+}
+
+// WithTimeout returns an Option that makes Become give up acquiring the lock after timeout,
+// returning an error wrapping ErrAcquisitionTimeout instead of blocking the startup of the
+// operator indefinitely. Become retries until ctx is done by default.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Config) error {
+		if timeout <= 0 {
+			return fmt.Errorf("timeout must be positive, got %s", timeout)
+		}
+		c.Timeout = timeout
+		return nil
+	}
+}
+
+// WithEventRecorder returns an Option that sets the EventRecorder used by Become to emit
+// events on leadership acquisition, takeover of an evicted or preempted leader, and deletion
+// of a stale lock. Events are attached to the lock record and the operator's Deployment,
+// making transitions visible with `kubectl describe`.
+func WithEventRecorder(recorder record.EventRecorder) Option {
+	return func(c *Config) error {
+		c.EventRecorder = recorder
+		return nil
+	}
+}
+
+// WithLockRecordAnnotations returns an Option that makes Become record the leader pod name and
+// acquisition time as annotations on the lock record.
+func WithLockRecordAnnotations() Option {
+	return func(c *Config) error {
+		c.RecordLockAnnotations = true
+		return nil
+	}
+}
+
+// WithMetricsRegistry returns an Option that registers the leader election metrics with reg,
+// e.g. controller-runtime's metrics.Registry. The metrics report the attempts to acquire the
+// lock, the time spent waiting for it, the takeovers from evicted, preempted or unreachable
+// leaders, whether the current pod is the leader, and which pod holds the lock. They allow
+// alerting on operators that spend too much time without a leader. The metrics shared by the
+// library are registered as well, see the metrics package.
+func WithMetricsRegistry(reg prometheus.Registerer) Option {
+	return func(c *Config) error {
+		c.MetricsRegisterer = reg
+		return nil
+	}
+}
+
+// WithoutNodeCheck returns an Option that makes Become skip reading the Node of the leader pod,
+// for operators that are not allowed to read Nodes, e.g. with namespace-scoped RBAC. Stale locks
+// are then only detected from the status of the leader pod, i.e. when it is evicted or preempted,
+// and the lock of a leader running on a NotReady node is kept until the pod is deleted.
+//
+// Become also stops checking Nodes by itself when reading the Node of the leader is forbidden.
+func WithoutNodeCheck() Option {
+	return func(c *Config) error {
+		c.SkipNodeCheck = true
+		return nil
+	}
+}
+
+// WithOnStartedLeading returns an Option that makes Become call f in a new goroutine once the
+// current pod became the leader, with the context passed to Become. It lets other components
+// react to the acquisition of the lock, e.g. to start work reserved to the leader.
+func WithOnStartedLeading(f func(context.Context)) Option {
+	return func(c *Config) error {
+		c.OnStartedLeading = f
+		return nil
+	}
+}
+
+// WithOnStoppedLeading returns an Option that makes Become call f when the context passed to
+// Become is done after the current pod became the leader, typically when the operator shuts
+// down. The lock itself is held until the pod is deleted.
+func WithOnStoppedLeading(f func()) Option {
+	return func(c *Config) error {
+		c.OnStoppedLeading = f
+		return nil
+	}
+}
+
 // Become ensures that the current pod is the leader within its namespace. If
 // run outside a cluster, it will skip leader election and return nil. It
 // continuously tries to create a ConfigMap with the provided name and the
 // current pod set as the owner reference. Only one can exist at a time with
 // the same name, so the pod that successfully creates the ConfigMap is the
 // leader. Upon termination of that pod, the garbage collector will delete the
-// ConfigMap, enabling a different pod to become the leader.
+// ConfigMap, enabling a different pod to become the leader. A Lease can be used
+// as the lock record instead of a ConfigMap, see WithLockType.
+//
+// Become retries until ctx is done, unless it is bounded with WithTimeout or WithMaxAttempts.
 func Become(ctx context.Context, lockName string, opts ...Option) error {
 	log.Info("Trying to become the leader.")
+	start := time.Now()
+	isLeader := metrics.IsLeader.WithLabelValues(lockName)
+	isLeader.Set(0)
+	var leaderPod string
+	elected := func(pod string) {
+		leaderPod = pod
+		isLeader.Set(1)
+		metrics.Leader.WithLabelValues(lockName, pod).Set(1)
+		metrics.LockWaitDuration.WithLabelValues(lockName).Observe(time.Since(start).Seconds())
+	}
 
 	config := Config{}
 
@@ -108,139 +330,230 @@ func Become(ctx context.Context, lockName string, opts ...Option) error {
 		return err
 	}
 
+	acquireCtx := ctx
+	if config.Timeout > 0 {
+		var cancel context.CancelFunc
+		acquireCtx, cancel = context.WithTimeoutCause(ctx, config.Timeout, ErrAcquisitionTimeout)
+		defer cancel()
+	}
+	err := become(acquireCtx, lockName, &config, elected)
+	if err != nil && !errors.Is(err, ErrAcquisitionTimeout) && errors.Is(context.Cause(acquireCtx), ErrAcquisitionTimeout) {
+		return fmt.Errorf("%w: lock %s not acquired within %s", ErrAcquisitionTimeout, lockName, config.Timeout)
+	}
+	if err != nil || leaderPod == "" {
+		return err
+	}
+
+	context.AfterFunc(ctx, func() {
+		metrics.Leader.DeleteLabelValues(lockName, leaderPod)
+		if config.OnStoppedLeading != nil {
+			config.OnStoppedLeading()
+		}
+	})
+	if config.OnStartedLeading != nil {
+		go config.OnStartedLeading(ctx)
+	}
+	return nil
+}
+
+// become tries to acquire the lock named lockName with config, and calls elected with the name
+// of the current pod once it is acquired.
+func become(ctx context.Context, lockName string, config *Config, elected func(string)) error {
 	ns, err := readNamespace()
 	if err != nil {
 		return err
 	}
 
-	owner, err := myOwnerRef(ctx, config.Client, ns)
+	myPod, err := getPod(ctx, config.Client, ns)
 	if err != nil {
 		return err
 	}
+	owner := ownerRefFor(myPod)
+	if config.WorkloadOwner {
+		config.workloadOwner = workloadOwnerRef(ctx, config.Client, myPod)
+	}
+	recorder := newTransitionRecorder(ctx, *config, myPod)
 
 	// check for existing lock from this pod, in case we got restarted
-	existing := &corev1.ConfigMap{}
 	key := crclient.ObjectKey{Namespace: ns, Name: lockName}
+	existing := newLock(config.LockType, key)
 	err = config.Client.Get(ctx, key, existing)
 
 	switch {
 	case err == nil:
-		for _, existingOwner := range existing.GetOwnerReferences() {
-			if existingOwner.Name == owner.Name {
-				log.Info("Found existing lock with my name. I was likely restarted.")
+		if isHeldBy(existing, owner) {
+			log.Info("Found existing lock with my name. I was likely restarted.")
+			if config.syncConfigMapLock(ctx, key, owner) {
 				log.Info("Continuing as the leader.")
+				elected(owner.Name)
+				recorder.event(existing, corev1.EventTypeNormal, LeaderElectedReason,
+					"Pod %s continues as the leader after a restart", owner.Name)
 				return nil
 			}
+			config.releaseLease(ctx, existing)
+		}
+		for _, existingOwner := range existing.GetOwnerReferences() {
 			log.Info("Found existing lock", "LockOwner", existingOwner.Name)
 		}
 	case apierrors.IsNotFound(err):
 		log.Info("No pre-existing lock was found.")
 	default:
-		log.Error(err, "Unknown error trying to get ConfigMap")
+		log.Error(err, "Unknown error trying to get the lock", "LockType", config.LockType)
+		libmetrics.RecordError(libmetrics.SubsystemLeader, "get_lock_failed")
 		return err
 	}
 
-	cm := &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:            lockName,
-			Namespace:       ns,
-			OwnerReferences: []metav1.OwnerReference{*owner},
-		},
-	}
-
 	// try to create a lock
-	backoff := time.Second
-	for {
-		err := config.Client.Create(ctx, cm)
+	backoff := config.InitialBackoffInterval
+	for attempts := 0; ; attempts++ {
+		if config.MaxAttempts > 0 && attempts >= config.MaxAttempts {
+			return fmt.Errorf("%w: lock %s not acquired after %d attempts", ErrAcquisitionTimeout, lockName, attempts)
+		}
+		metrics.LockAcquisitionAttempts.WithLabelValues(lockName).Inc()
+
+		// honor the ConfigMap lock of a leader that does not use Leases yet
+		held, err := config.configMapLockOfOtherPod(ctx, key, owner)
 		switch {
-		case err == nil:
-			log.Info("Became the leader.")
-			return nil
-		case apierrors.IsAlreadyExists(err):
-			// refresh the lock so we use current leader
-			key := crclient.ObjectKey{Namespace: ns, Name: lockName}
-			if err := config.Client.Get(ctx, key, existing); err != nil {
-				log.Info("Leader lock configmap not found.")
-				continue // configmap got lost ... just wait a bit
+		case err != nil:
+			libmetrics.RecordError(libmetrics.SubsystemLeader, "get_lock_failed")
+			return err
+		case held != nil:
+			log.Info("Found a ConfigMap lock held by another pod, waiting for it before acquiring the Lease.")
+			if err := config.takeOverStaleLock(ctx, lockName, held, owner, recorder); err != nil {
+				return err
 			}
-
-			existingOwners := existing.GetOwnerReferences()
+		default:
+			lock := config.newLockFor(config.LockType, key, owner, time.Now())
+			err := config.Client.Create(ctx, lock)
 			switch {
-			case len(existingOwners) != 1:
-				log.Info("Leader lock configmap must have exactly one owner reference.", "ConfigMap", existing)
-			case existingOwners[0].Kind != "Pod":
-				log.Info("Leader lock configmap owner reference must be a pod.", "OwnerReference", existingOwners[0])
-			default:
-				leaderPod := &corev1.Pod{}
-				key = crclient.ObjectKey{Namespace: ns, Name: existingOwners[0].Name}
-				err = config.Client.Get(ctx, key, leaderPod)
-				switch {
-				case apierrors.IsNotFound(err):
-					log.Info("Leader pod has been deleted, waiting for garbage collection to remove the lock.")
-				case err != nil:
+			case err == nil:
+				if !config.syncConfigMapLock(ctx, key, owner) {
+					config.releaseLease(ctx, lock)
+					break
+				}
+				log.Info("Became the leader.")
+				elected(owner.Name)
+				recorder.event(lock, corev1.EventTypeNormal, LeaderElectedReason, "Pod %s became the leader", owner.Name)
+				return nil
+			case apierrors.IsAlreadyExists(err):
+				// refresh the lock so we use current leader
+				existing := newLock(config.LockType, key)
+				if err := config.Client.Get(ctx, key, existing); err != nil {
+					log.Info("Leader lock not found.", "LockType", config.LockType)
+					continue // lock got lost ... just wait a bit
+				}
+				if err := config.takeOverStaleLock(ctx, lockName, existing, owner, recorder); err != nil {
 					return err
-				case isPodEvicted(*leaderPod) && leaderPod.GetDeletionTimestamp() == nil:
-					log.Info("Operator pod with leader lock has been evicted.", "leader", leaderPod.Name)
-					log.Info("Deleting evicted leader.")
-					// Pod may not delete immediately, continue with backoff
-					err := config.Client.Delete(ctx, leaderPod)
-					if err != nil {
-						log.Error(err, "Leader pod could not be deleted.")
-					}
-				case isPodPreempted(*leaderPod) && leaderPod.GetDeletionTimestamp() == nil:
-					log.Info("Operator pod with leader lock has been preempted.", "leader", leaderPod.Name)
-					log.Info("Deleting preempted leader.")
-					// Pod may not delete immediately, continue with backoff
-					err := config.Client.Delete(ctx, leaderPod)
-					if err != nil {
-						log.Error(err, "Leader pod could not be deleted.")
-					}
-				case isNotReadyNode(ctx, config.Client, leaderPod.Spec.NodeName):
-					log.Info("the status of the node where operator pod with leader lock was running has been 'notReady'")
-					log.Info("Deleting the leader.")
-
-					// Mark the termainating status to the leaderPod and Delete the configmap lock
-					if err := deleteLeader(ctx, config.Client, leaderPod, existing); err != nil {
-						return err
-					}
-
-				default:
-					log.Info("Not the leader. Waiting.")
 				}
+			default:
+				log.Error(err, "Unknown error creating the lock", "LockType", config.LockType)
+				libmetrics.RecordError(libmetrics.SubsystemLeader, "create_lock_failed")
+				return err
 			}
+		}
 
-			select {
-			case <-time.After(wait.Jitter(backoff, .2)):
-				if backoff < config.MaxBackoffInterval {
-					backoff *= 2
-				}
-				continue
-			case <-ctx.Done():
-				return ctx.Err()
+		select {
+		case <-time.After(wait.Jitter(backoff, .2)):
+			if backoff < config.MaxBackoffInterval {
+				backoff *= 2
 			}
-		default:
-			log.Error(err, "Unknown error creating ConfigMap")
-			return err
+			continue
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
 }
 
-// myOwnerRef returns an OwnerReference that corresponds to the pod in which
-// this code is currently running.
-// It expects the environment variable POD_NAME to be set by the downwards API
-func myOwnerRef(ctx context.Context, client crclient.Client, ns string) (*metav1.OwnerReference, error) {
-	myPod, err := getPod(ctx, client, ns)
-	if err != nil {
-		return nil, err
+// takeOverStaleLock deletes the leader pod holding the existing lock, if it was evicted or
+// preempted, or the leader pod and its lock, if it runs on a NotReady node or meets one of the
+// StaleLockPolicies, so that the lock can be acquired on the next attempt.
+func (c *Config) takeOverStaleLock(ctx context.Context, lockName string, existing crclient.Object, owner *metav1.OwnerReference, recorder transitionRecorder) error {
+	if holder, ok := lockHolder(existing); ok {
+		leaderPod := &corev1.Pod{}
+		key := crclient.ObjectKey{Namespace: existing.GetNamespace(), Name: holder}
+		err := c.Client.Get(ctx, key, leaderPod)
+		switch {
+		case apierrors.IsNotFound(err) && isOwnedByPod(existing, holder):
+			log.Info("Leader pod has been deleted, waiting for garbage collection to remove the lock.")
+		case apierrors.IsNotFound(err):
+			log.Info("Leader pod has been deleted, deleting the lock it does not own.", "leader", holder)
+			if err := c.Client.Delete(ctx, existing); err != nil && !apierrors.IsNotFound(err) {
+				libmetrics.RecordError(libmetrics.SubsystemLeader, "takeover_failed")
+				return err
+			}
+			metrics.LockTakeovers.WithLabelValues(lockName, metrics.TakeoverReasonLeaderGone).Inc()
+			recorder.event(existing, corev1.EventTypeNormal, StaleLockDeletedReason,
+				"Pod %s deleted the lock of deleted leader pod %s", owner.Name, holder)
+		case err != nil:
+			return err
+		case isPodEvicted(*leaderPod) && leaderPod.GetDeletionTimestamp() == nil:
+			log.Info("Operator pod with leader lock has been evicted.", "leader", leaderPod.Name)
+			log.Info("Deleting evicted leader.")
+			// Pod may not delete immediately, continue with backoff
+			err := c.Client.Delete(ctx, leaderPod)
+			if err != nil {
+				log.Error(err, "Leader pod could not be deleted.")
+				libmetrics.RecordError(libmetrics.SubsystemLeader, "takeover_failed")
+			} else {
+				metrics.LockTakeovers.WithLabelValues(lockName, metrics.TakeoverReasonEvicted).Inc()
+				recorder.event(existing, corev1.EventTypeNormal, LeaderEvictedReason,
+					"Pod %s deleted evicted leader pod %s to take over the lock", owner.Name, leaderPod.Name)
+			}
+		case isPodPreempted(*leaderPod) && leaderPod.GetDeletionTimestamp() == nil:
+			log.Info("Operator pod with leader lock has been preempted.", "leader", leaderPod.Name)
+			log.Info("Deleting preempted leader.")
+			// Pod may not delete immediately, continue with backoff
+			err := c.Client.Delete(ctx, leaderPod)
+			if err != nil {
+				log.Error(err, "Leader pod could not be deleted.")
+				libmetrics.RecordError(libmetrics.SubsystemLeader, "takeover_failed")
+			} else {
+				metrics.LockTakeovers.WithLabelValues(lockName, metrics.TakeoverReasonPreempted).Inc()
+				recorder.event(existing, corev1.EventTypeNormal, LeaderPreemptedReason,
+					"Pod %s deleted preempted leader pod %s to take over the lock", owner.Name, leaderPod.Name)
+			}
+		case c.isNotReadyNode(ctx, leaderPod.Spec.NodeName):
+			log.Info("the status of the node where operator pod with leader lock was running has been 'notReady'")
+			log.Info("Deleting the leader.")
+
+			// Mark the termainating status to the leaderPod and Delete the lock
+			if err := c.breakStaleLock(ctx, lockName, metrics.TakeoverReasonNodeNotReady, leaderPod, existing); err != nil {
+				return err
+			}
+			recorder.event(existing, corev1.EventTypeWarning, StaleLockDeletedReason,
+				"Pod %s deleted the lock of leader pod %s running on NotReady node %s", owner.Name, leaderPod.Name, leaderPod.Spec.NodeName)
+
+		case c.isUnreachableNode(ctx, leaderPod.Spec.NodeName):
+			log.Info("The node where the operator pod with leader lock is running is unreachable.", "node", leaderPod.Spec.NodeName)
+			log.Info("Deleting the leader.")
+			if err := c.breakStaleLock(ctx, lockName, metrics.TakeoverReasonNodeUnreachable, leaderPod, existing); err != nil {
+				return err
+			}
+			recorder.event(existing, corev1.EventTypeWarning, StaleLockDeletedReason,
+				"Pod %s deleted the lock of leader pod %s running on unreachable node %s", owner.Name, leaderPod.Name, leaderPod.Spec.NodeName)
+		case c.isStuckTerminating(leaderPod):
+			log.Info("Operator pod with leader lock is stuck terminating.", "leader", leaderPod.Name)
+			log.Info("Force deleting the leader.")
+			if err := c.breakStaleLock(ctx, lockName, metrics.TakeoverReasonStuckTerminating, leaderPod, existing, crclient.GracePeriodSeconds(0)); err != nil {
+				return err
+			}
+			recorder.event(existing, corev1.EventTypeWarning, StaleLockDeletedReason,
+				"Pod %s deleted the lock of leader pod %s stuck terminating", owner.Name, leaderPod.Name)
+		default:
+			log.Info("Not the leader. Waiting.")
+		}
 	}
+	return nil
+}
 
-	owner := &metav1.OwnerReference{
+// ownerRefFor returns an OwnerReference that corresponds to the given pod.
+func ownerRefFor(pod *corev1.Pod) *metav1.OwnerReference {
+	return &metav1.OwnerReference{
 		APIVersion: "v1",
 		Kind:       "Pod",
-		Name:       myPod.ObjectMeta.Name,
-		UID:        myPod.ObjectMeta.UID,
+		Name:       pod.ObjectMeta.Name,
+		UID:        pod.ObjectMeta.UID,
 	}
-	return owner, nil
 }
 
 func isPodEvicted(pod corev1.Pod) bool {
@@ -289,21 +602,50 @@ func getNode(ctx context.Context, client crclient.Client, nodeName string, node
 	return nil
 }
 
-func isNotReadyNode(ctx context.Context, client crclient.Client, nodeName string) bool {
+// checkNotReadyNode returns true if the node nodeName is NotReady, or the error returned when
+// getting the node.
+func checkNotReadyNode(ctx context.Context, client crclient.Client, nodeName string) (bool, error) {
 	leaderNode := &corev1.Node{}
 	if err := getNode(ctx, client, nodeName, leaderNode); err != nil {
-		return false
+		return false, err
 	}
 	for _, condition := range leaderNode.Status.Conditions {
 		if condition.Type == corev1.NodeReady && condition.Status != corev1.ConditionTrue {
-			return true
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isNotReadyNode returns true if the node nodeName is NotReady, unless the node check is
+// skipped. If reading the node is forbidden, the node check is skipped from then on.
+func (c *Config) isNotReadyNode(ctx context.Context, nodeName string) bool {
+	if c.SkipNodeCheck {
+		return false
+	}
+	notReady, err := checkNotReadyNode(ctx, c.Client, nodeName)
+	if apierrors.IsForbidden(err) {
+		log.Info("Not allowed to read Nodes, stale locks are only detected from the status of the leader pod.")
+		c.SkipNodeCheck = true
+	}
+	return notReady
+}
+
+// breakStaleLock deletes the leader pod with opts and its lock, and counts the takeover with
+// reason.
+func (c *Config) breakStaleLock(ctx context.Context, lockName, reason string, leaderPod *corev1.Pod, existing crclient.Object, opts ...crclient.DeleteOption) error {
+	if err := deleteLeader(ctx, c.Client, leaderPod, existing, opts...); err != nil {
+		if !apierrors.IsNotFound(err) {
+			libmetrics.RecordError(libmetrics.SubsystemLeader, "takeover_failed")
 		}
+		return err
 	}
-	return false
+	metrics.LockTakeovers.WithLabelValues(lockName, reason).Inc()
+	return nil
 }
 
-func deleteLeader(ctx context.Context, client crclient.Client, leaderPod *corev1.Pod, existing *corev1.ConfigMap) error {
-	err := client.Delete(ctx, leaderPod)
+func deleteLeader(ctx context.Context, client crclient.Client, leaderPod *corev1.Pod, existing crclient.Object, opts ...crclient.DeleteOption) error {
+	err := client.Delete(ctx, leaderPod, opts...)
 	if err != nil {
 		log.Error(err, "Leader pod could not be deleted.")
 		return err
@@ -311,10 +653,67 @@ func deleteLeader(ctx context.Context, client crclient.Client, leaderPod *corev1
 	err = client.Delete(ctx, existing)
 	switch {
 	case apierrors.IsNotFound(err):
-		log.Info("ConfigMap has been deleted by prior operator.")
+		log.Info("Lock has been deleted by prior operator.")
 		return err
 	case err != nil:
 		return err
 	}
 	return nil
 }
+
+// transitionRecorder emits events on leadership transitions, attached to the lock
+// and to the Deployment of the operator.
+type transitionRecorder struct {
+	recorder   record.EventRecorder
+	deployment runtime.Object
+}
+
+func newTransitionRecorder(ctx context.Context, config Config, pod *corev1.Pod) transitionRecorder {
+	r := transitionRecorder{recorder: config.EventRecorder}
+	if r.recorder != nil {
+		if deployment := getDeployment(ctx, config.Client, pod); deployment != nil {
+			r.deployment = deployment
+		}
+	}
+	return r
+}
+
+func (r transitionRecorder) event(lock crclient.Object, eventType, reason, messageFmt string, args ...interface{}) {
+	if r.recorder == nil {
+		return
+	}
+	r.recorder.Eventf(lock, eventType, reason, messageFmt, args...)
+	if r.deployment != nil {
+		r.recorder.Eventf(r.deployment, eventType, reason, messageFmt, args...)
+	}
+}
+
+// getDeployment returns a reference to the Deployment controlling the given pod through a
+// ReplicaSet, or nil if there is none or it cannot be determined.
+func getDeployment(ctx context.Context, client crclient.Client, pod *corev1.Pod) *appsv1.Deployment {
+	rsRef := metav1.GetControllerOf(pod)
+	if rsRef == nil || rsRef.Kind != "ReplicaSet" {
+		return nil
+	}
+
+	rs := &appsv1.ReplicaSet{}
+	key := crclient.ObjectKey{Namespace: pod.Namespace, Name: rsRef.Name}
+	if err := client.Get(ctx, key, rs); err != nil {
+		log.V(1).Info("Unable to get the ReplicaSet of the operator pod", "error", err.Error())
+		return nil
+	}
+
+	deploymentRef := metav1.GetControllerOf(rs)
+	if deploymentRef == nil || deploymentRef.Kind != "Deployment" {
+		return nil
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: deploymentRef.APIVersion, Kind: deploymentRef.Kind},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploymentRef.Name,
+			Namespace: pod.Namespace,
+			UID:       deploymentRef.UID,
+		},
+	}
+}
@@ -16,16 +16,26 @@ package leader
 
 import (
 	"context"
+	"errors"
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/operator-framework/operator-lib/leader/internal/metrics"
 )
 
 var _ = Describe("Leader election", func() {
@@ -286,6 +296,615 @@ var _ = Describe("Leader election", func() {
 			Expect(Become(context.TODO(), "leader-test", WithClient(preemptedPodStatusClient))).To(Succeed())
 		})
 	})
+	Describe("BecomeAsync", func() {
+		var client crclient.Client
+		BeforeEach(func() {
+			os.Setenv("POD_NAME", "leader-test")
+			readNamespace = func() (string, error) {
+				return "testns", nil
+			}
+			client = fake.NewClientBuilder().WithObjects(
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "leader-test",
+						Namespace: "testns",
+					},
+				},
+			).Build()
+		})
+		It("should report leadership once the lock is acquired", func() {
+			l := BecomeAsync(context.TODO(), "leader-test", WithClient(client))
+			Eventually(l.Done()).Should(BeClosed())
+			Expect(l.Err()).NotTo(HaveOccurred())
+			Expect(l.IsLeader()).To(BeTrue())
+			Expect(l.Elected()).To(BeClosed())
+			Expect(l.Wait(context.TODO())).To(Succeed())
+		})
+		It("should report the error of Become", func() {
+			os.Unsetenv("POD_NAME")
+			l := BecomeAsync(context.TODO(), "leader-test", WithClient(client))
+			Expect(l.Wait(context.TODO())).To(MatchError(ContainSubstring("POD_NAME")))
+			Expect(l.Err()).To(HaveOccurred())
+			Expect(l.IsLeader()).To(BeFalse())
+			Expect(l.Elected()).NotTo(BeClosed())
+		})
+		It("should not be the leader while another pod holds the lock", func() {
+			Expect(client.Create(context.TODO(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "leader-test",
+					Namespace: "testns",
+					OwnerReferences: []metav1.OwnerReference{
+						{APIVersion: "v1", Kind: "Pod", Name: "other-leader"},
+					},
+				},
+			})).To(Succeed())
+
+			ctx, cancel := context.WithCancel(context.TODO())
+			l := BecomeAsync(ctx, "leader-test", WithClient(client))
+			Consistently(l.Done()).ShouldNot(BeClosed())
+			Expect(l.IsLeader()).To(BeFalse())
+			Expect(l.Err()).NotTo(HaveOccurred())
+
+			waitCtx, waitCancel := context.WithCancel(context.TODO())
+			waitCancel()
+			Expect(l.Wait(waitCtx)).To(MatchError(context.Canceled))
+
+			cancel()
+			Eventually(l.Done()).Should(BeClosed())
+			Expect(l.Err()).To(MatchError(context.Canceled))
+		})
+	})
+
+	Describe("Become with transition recording", func() {
+		var (
+			client   crclient.Client
+			recorder *record.FakeRecorder
+		)
+		BeforeEach(func() {
+			isController := true
+			client = fake.NewClientBuilder().WithObjects(
+				&appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "operator-rs",
+						Namespace: "testns",
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion: "apps/v1",
+								Kind:       "Deployment",
+								Name:       "operator",
+								Controller: &isController,
+							},
+						},
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "operator-pod",
+						Namespace: "testns",
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion: "apps/v1",
+								Kind:       "ReplicaSet",
+								Name:       "operator-rs",
+								Controller: &isController,
+							},
+						},
+					},
+				},
+			).Build()
+			recorder = record.NewFakeRecorder(10)
+			recorder.IncludeObject = true
+
+			os.Setenv("POD_NAME", "operator-pod")
+			readNamespace = func() (string, error) {
+				return "testns", nil
+			}
+		})
+		It("should emit LeaderElected events on the lock and the Deployment", func() {
+			Expect(Become(context.TODO(), "leader-lock", WithClient(client), WithEventRecorder(recorder))).To(Succeed())
+
+			Expect(recorder.Events).To(HaveLen(2))
+			Expect(<-recorder.Events).To(And(
+				ContainSubstring("Normal LeaderElected Pod operator-pod became the leader"),
+				ContainSubstring("kind=ConfigMap"),
+			))
+			Expect(<-recorder.Events).To(And(
+				ContainSubstring("Normal LeaderElected Pod operator-pod became the leader"),
+				ContainSubstring("kind=Deployment"),
+			))
+		})
+		It("should only emit events on the lock when the pod has no Deployment", func() {
+			pod := &corev1.Pod{}
+			Expect(client.Get(context.TODO(), crclient.ObjectKey{Namespace: "testns", Name: "operator-pod"}, pod)).To(Succeed())
+			pod.OwnerReferences = nil
+			Expect(client.Update(context.TODO(), pod)).To(Succeed())
+
+			Expect(Become(context.TODO(), "leader-lock", WithClient(client), WithEventRecorder(recorder))).To(Succeed())
+
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(ContainSubstring("kind=ConfigMap"))
+		})
+		It("should emit a LeaderEvicted event when taking over from an evicted leader", func() {
+			Expect(client.Create(context.TODO(), &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "old-leader", Namespace: "testns"},
+				Status: corev1.PodStatus{
+					Phase:  corev1.PodFailed,
+					Reason: "Evicted",
+				},
+			})).To(Succeed())
+			Expect(client.Create(context.TODO(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "leader-lock",
+					Namespace: "testns",
+					OwnerReferences: []metav1.OwnerReference{
+						{APIVersion: "v1", Kind: "Pod", Name: "old-leader"},
+					},
+				},
+			})).To(Succeed())
+			gcClient := interceptor.NewClient(client.(crclient.WithWatch), interceptor.Funcs{
+				// Mock garbage collection of the ConfigMap when the Pod is deleted.
+				Delete: func(ctx context.Context, c crclient.WithWatch, obj crclient.Object, opts ...crclient.DeleteOption) error {
+					if err := c.Delete(ctx, obj, opts...); err != nil {
+						return err
+					}
+					if _, ok := obj.(*corev1.Pod); ok {
+						cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "leader-lock", Namespace: "testns"}}
+						return c.Delete(ctx, cm)
+					}
+					return nil
+				},
+			})
+
+			takeovers := metrics.LockTakeovers.WithLabelValues("leader-lock", metrics.TakeoverReasonEvicted)
+			before := testutil.ToFloat64(takeovers)
+
+			Expect(Become(context.TODO(), "leader-lock", WithClient(gcClient), WithEventRecorder(recorder))).To(Succeed())
+			Expect(testutil.ToFloat64(takeovers)).To(Equal(before + 1))
+
+			Expect(recorder.Events).To(HaveLen(4))
+			Expect(<-recorder.Events).To(ContainSubstring("Normal LeaderEvicted Pod operator-pod deleted evicted leader pod old-leader"))
+			Expect(<-recorder.Events).To(ContainSubstring("Normal LeaderEvicted"))
+			Expect(<-recorder.Events).To(ContainSubstring("Normal LeaderElected"))
+			Expect(<-recorder.Events).To(ContainSubstring("Normal LeaderElected"))
+		})
+		It("should register and report the leader election metrics", func() {
+			reg := prometheus.NewRegistry()
+			attempts := metrics.LockAcquisitionAttempts.WithLabelValues("leader-lock")
+			before := testutil.ToFloat64(attempts)
+
+			Expect(Become(context.TODO(), "leader-lock", WithClient(client), WithMetricsRegistry(reg))).To(Succeed())
+
+			Expect(testutil.ToFloat64(attempts)).To(Equal(before + 1))
+			Expect(testutil.ToFloat64(metrics.IsLeader.WithLabelValues("leader-lock"))).To(Equal(1.0))
+			families, err := reg.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			var names []string
+			for _, family := range families {
+				names = append(names, family.GetName())
+			}
+			Expect(names).To(ContainElements(
+				"leader_lock_acquisition_attempts_total",
+				"leader_lock_wait_seconds",
+				"leader_is_leader",
+			))
+
+			By("registering the metrics with the same registry again")
+			Expect(Become(context.TODO(), "leader-lock", WithClient(client), WithMetricsRegistry(reg))).To(Succeed())
+		})
+		It("should record the holder annotations on the lock", func() {
+			Expect(Become(context.TODO(), "leader-lock", WithClient(client), WithLockRecordAnnotations())).To(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), crclient.ObjectKey{Namespace: "testns", Name: "leader-lock"}, cm)).To(Succeed())
+			Expect(cm.Annotations).To(HaveKeyWithValue(LockHolderAnnotation, "operator-pod"))
+			Expect(cm.Annotations).To(HaveKey(LockAcquireTimeAnnotation))
+		})
+	})
+
+	Describe("Become with a Lease lock", func() {
+		var client crclient.Client
+		key := crclient.ObjectKey{Namespace: "testns", Name: "leader-lock"}
+		lockOf := func(owner string) []metav1.OwnerReference {
+			return []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: owner}}
+		}
+		BeforeEach(func() {
+			client = fake.NewClientBuilder().WithObjects(
+				&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "operator-pod", Namespace: "testns"}},
+			).Build()
+			os.Setenv("POD_NAME", "operator-pod")
+			readNamespace = func() (string, error) {
+				return "testns", nil
+			}
+		})
+		It("should reject unknown lock types", func() {
+			Expect(Become(context.TODO(), "leader-lock", WithClient(client), WithLockType("Secret"))).
+				To(MatchError(ContainSubstring("unknown lock type")))
+		})
+		It("should hold a Lease and a ConfigMap lock", func() {
+			recorder := record.NewFakeRecorder(10)
+			recorder.IncludeObject = true
+			Expect(Become(context.TODO(), "leader-lock", WithClient(client), WithLockType(LeaseLock), WithEventRecorder(recorder))).To(Succeed())
+
+			lease := &coordinationv1.Lease{}
+			Expect(client.Get(context.TODO(), key, lease)).To(Succeed())
+			Expect(lease.OwnerReferences).To(HaveLen(1))
+			Expect(lease.OwnerReferences[0].Name).To(Equal("operator-pod"))
+			Expect(lease.Spec.HolderIdentity).To(HaveValue(Equal("operator-pod")))
+			Expect(lease.Spec.AcquireTime).NotTo(BeNil())
+			Expect(<-recorder.Events).To(ContainSubstring("kind=Lease"))
+
+			cm := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), key, cm)).To(Succeed())
+			Expect(cm.OwnerReferences).To(HaveLen(1))
+			Expect(cm.OwnerReferences[0].Name).To(Equal("operator-pod"))
+
+			By("continuing as the leader after a restart")
+			Expect(Become(context.TODO(), "leader-lock", WithClient(client), WithLockType(LeaseLock))).To(Succeed())
+		})
+		It("should adopt its ConfigMap lock and release it once migrated", func() {
+			Expect(client.Create(context.TODO(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "leader-lock", Namespace: "testns", OwnerReferences: lockOf("operator-pod")},
+			})).To(Succeed())
+
+			Expect(Become(context.TODO(), "leader-lock", WithClient(client), WithLockType(LeaseLock), WithoutConfigMapLock())).To(Succeed())
+
+			Expect(client.Get(context.TODO(), key, &coordinationv1.Lease{})).To(Succeed())
+			err := client.Get(context.TODO(), key, &corev1.ConfigMap{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+		It("should wait for the ConfigMap lock of another leader", func() {
+			Expect(client.Create(context.TODO(), &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "old-leader", Namespace: "testns"}})).To(Succeed())
+			Expect(client.Create(context.TODO(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "leader-lock", Namespace: "testns", OwnerReferences: lockOf("old-leader")},
+			})).To(Succeed())
+
+			ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+			defer cancel()
+			Expect(Become(ctx, "leader-lock", WithClient(client), WithLockType(LeaseLock))).To(MatchError(context.DeadlineExceeded))
+			err := client.Get(context.TODO(), key, &coordinationv1.Lease{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+		It("should release the Lease when another leader holds the ConfigMap lock", func() {
+			Expect(client.Create(context.TODO(), &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "old-leader", Namespace: "testns"}})).To(Succeed())
+			raceClient := interceptor.NewClient(client.(crclient.WithWatch), interceptor.Funcs{
+				// Mock a leader not using Leases acquiring the ConfigMap lock concurrently.
+				Create: func(ctx context.Context, c crclient.WithWatch, obj crclient.Object, opts ...crclient.CreateOption) error {
+					if _, ok := obj.(*coordinationv1.Lease); ok {
+						err := c.Create(ctx, &corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{Name: "leader-lock", Namespace: "testns", OwnerReferences: lockOf("old-leader")},
+						})
+						if err != nil && !apierrors.IsAlreadyExists(err) {
+							return err
+						}
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			})
+
+			ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+			defer cancel()
+			Expect(Become(ctx, "leader-lock", WithClient(raceClient), WithLockType(LeaseLock))).To(MatchError(context.DeadlineExceeded))
+			err := client.Get(context.TODO(), key, &coordinationv1.Lease{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+		It("should release its Lease after a restart when another leader holds the ConfigMap lock", func() {
+			Expect(client.Create(context.TODO(), &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "old-leader", Namespace: "testns"}})).To(Succeed())
+			holder := "operator-pod"
+			Expect(client.Create(context.TODO(), &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "leader-lock", Namespace: "testns", OwnerReferences: lockOf("operator-pod")},
+				Spec:       coordinationv1.LeaseSpec{HolderIdentity: &holder},
+			})).To(Succeed())
+			Expect(client.Create(context.TODO(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "leader-lock", Namespace: "testns", OwnerReferences: lockOf("old-leader")},
+			})).To(Succeed())
+
+			ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+			defer cancel()
+			Expect(Become(ctx, "leader-lock", WithClient(client), WithLockType(LeaseLock))).To(MatchError(context.DeadlineExceeded))
+			err := client.Get(context.TODO(), key, &coordinationv1.Lease{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+		It("should take over the ConfigMap lock of an evicted leader", func() {
+			Expect(client.Create(context.TODO(), &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "old-leader", Namespace: "testns"},
+				Status:     corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted"},
+			})).To(Succeed())
+			Expect(client.Create(context.TODO(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "leader-lock", Namespace: "testns", OwnerReferences: lockOf("old-leader")},
+			})).To(Succeed())
+			gcClient := interceptor.NewClient(client.(crclient.WithWatch), interceptor.Funcs{
+				// Mock garbage collection of the ConfigMap when the Pod is deleted.
+				Delete: func(ctx context.Context, c crclient.WithWatch, obj crclient.Object, opts ...crclient.DeleteOption) error {
+					if err := c.Delete(ctx, obj, opts...); err != nil {
+						return err
+					}
+					if _, ok := obj.(*corev1.Pod); ok {
+						cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "leader-lock", Namespace: "testns"}}
+						return c.Delete(ctx, cm)
+					}
+					return nil
+				},
+			})
+
+			Expect(Become(context.TODO(), "leader-lock", WithClient(gcClient), WithLockType(LeaseLock))).To(Succeed())
+			lease := &coordinationv1.Lease{}
+			Expect(client.Get(context.TODO(), key, lease)).To(Succeed())
+			Expect(lease.Spec.HolderIdentity).To(HaveValue(Equal("operator-pod")))
+		})
+	})
+
+	Describe("Become with bounded retries", func() {
+		var client crclient.Client
+		BeforeEach(func() {
+			client = fake.NewClientBuilder().WithObjects(
+				&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "operator-pod", Namespace: "testns"}},
+				&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other-leader", Namespace: "testns"}},
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+					Name:            "bounded-lock",
+					Namespace:       "testns",
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "other-leader"}},
+				}},
+			).Build()
+			os.Setenv("POD_NAME", "operator-pod")
+			readNamespace = func() (string, error) {
+				return "testns", nil
+			}
+		})
+		It("should reject invalid bounds", func() {
+			Expect(Become(context.TODO(), "bounded-lock", WithClient(client), WithMaxAttempts(0))).NotTo(Succeed())
+			Expect(Become(context.TODO(), "bounded-lock", WithClient(client), WithTimeout(-time.Second))).NotTo(Succeed())
+		})
+		It("should give up after the maximum number of attempts", func() {
+			attempts := metrics.LockAcquisitionAttempts.WithLabelValues("bounded-lock")
+			before := testutil.ToFloat64(attempts)
+
+			err := Become(context.TODO(), "bounded-lock", WithClient(client), WithMaxAttempts(3),
+				WithBackoff(time.Millisecond, 2*time.Millisecond))
+			Expect(err).To(MatchError(ErrAcquisitionTimeout))
+			Expect(err).To(MatchError(ContainSubstring("after 3 attempts")))
+			Expect(testutil.ToFloat64(attempts)).To(Equal(before + 3))
+		})
+		It("should give up after the timeout", func() {
+			start := time.Now()
+			err := Become(context.TODO(), "bounded-lock", WithClient(client), WithTimeout(50*time.Millisecond),
+				WithBackoff(10*time.Millisecond, 10*time.Millisecond))
+			Expect(err).To(MatchError(ErrAcquisitionTimeout))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+		It("should return the error of the context when it is done first", func() {
+			ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+			defer cancel()
+			err := Become(ctx, "bounded-lock", WithClient(client), WithTimeout(time.Minute),
+				WithBackoff(10*time.Millisecond, 10*time.Millisecond))
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+			Expect(err).NotTo(MatchError(ErrAcquisitionTimeout))
+		})
+	})
+
+	Describe("Become with leadership callbacks", func() {
+		var client crclient.Client
+		BeforeEach(func() {
+			client = fake.NewClientBuilder().WithObjects(
+				&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "operator-pod", Namespace: "testns"}},
+			).Build()
+			os.Setenv("POD_NAME", "operator-pod")
+			readNamespace = func() (string, error) {
+				return "testns", nil
+			}
+		})
+		It("should notify the start and the end of the leadership", func() {
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+			started := make(chan context.Context, 1)
+			stopped := make(chan struct{})
+
+			Expect(Become(ctx, "callback-lock", WithClient(client),
+				WithOnStartedLeading(func(ctx context.Context) { started <- ctx }),
+				WithOnStoppedLeading(func() { close(stopped) }),
+			)).To(Succeed())
+			Eventually(started).Should(Receive(Equal(ctx)))
+			Expect(stopped).NotTo(BeClosed())
+			Expect(testutil.ToFloat64(metrics.Leader.WithLabelValues("callback-lock", "operator-pod"))).To(Equal(1.0))
+
+			cancel()
+			Eventually(stopped).Should(BeClosed())
+			Expect(metrics.Leader.DeleteLabelValues("callback-lock", "operator-pod")).To(BeFalse(), "the series of the lock should be deleted")
+		})
+		It("should not notify when the lock is not acquired", func() {
+			Expect(client.Create(context.TODO(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:            "callback-lock",
+				Namespace:       "testns",
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "other-leader"}},
+			}})).To(Succeed())
+			ctx, cancel := context.WithCancel(context.TODO())
+			called := make(chan struct{}, 2)
+
+			err := Become(ctx, "callback-lock", WithClient(client), WithMaxAttempts(1),
+				WithOnStartedLeading(func(context.Context) { called <- struct{}{} }),
+				WithOnStoppedLeading(func() { called <- struct{}{} }),
+			)
+			Expect(err).To(MatchError(ErrAcquisitionTimeout))
+			cancel()
+			Consistently(called).ShouldNot(Receive())
+		})
+	})
+
+	Describe("Become with stale lock policies", func() {
+		var leaderPod *corev1.Pod
+		lock := func() *corev1.ConfigMap {
+			return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:            "stale-lock",
+				Namespace:       "testns",
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "other-leader"}},
+			}}
+		}
+		node := func(taints ...corev1.Taint) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Spec:       corev1.NodeSpec{Taints: taints},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				}},
+			}
+		}
+		BeforeEach(func() {
+			leaderPod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-leader", Namespace: "testns"},
+				Spec:       corev1.PodSpec{NodeName: "node-1"},
+			}
+			os.Setenv("POD_NAME", "operator-pod")
+			readNamespace = func() (string, error) {
+				return "testns", nil
+			}
+		})
+		become := func(client crclient.Client, opts ...Option) error {
+			opts = append([]Option{WithClient(client), WithMaxAttempts(3), WithBackoff(time.Millisecond, time.Millisecond)}, opts...)
+			return Become(context.TODO(), "stale-lock", opts...)
+		}
+		It("should reject unknown policies", func() {
+			Expect(become(fake.NewClientBuilder().Build(), WithStaleLockPolicies("Unknown"))).To(MatchError(ContainSubstring("unknown stale lock policy")))
+		})
+		It("should break the lock of a leader on an unreachable node", func() {
+			client := fake.NewClientBuilder().WithObjects(
+				&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "operator-pod", Namespace: "testns"}},
+				leaderPod, lock(),
+				node(corev1.Taint{Key: corev1.TaintNodeUnreachable, Effect: corev1.TaintEffectNoExecute}),
+			).Build()
+			takeovers := metrics.LockTakeovers.WithLabelValues("stale-lock", metrics.TakeoverReasonNodeUnreachable)
+			before := testutil.ToFloat64(takeovers)
+
+			Expect(become(client)).To(MatchError(ErrAcquisitionTimeout))
+			Expect(become(client, WithStaleLockPolicies(StaleLockNodeUnreachable))).To(Succeed())
+			Expect(testutil.ToFloat64(takeovers)).To(Equal(before + 1))
+
+			cm := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), crclient.ObjectKey{Namespace: "testns", Name: "stale-lock"}, cm)).To(Succeed())
+			Expect(cm.OwnerReferences[0].Name).To(Equal("operator-pod"))
+		})
+		It("should keep the lock of a leader on an unreachable node when the node check is skipped", func() {
+			client := fake.NewClientBuilder().WithObjects(
+				&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "operator-pod", Namespace: "testns"}},
+				leaderPod, lock(),
+				node(corev1.Taint{Key: corev1.TaintNodeUnreachable, Effect: corev1.TaintEffectNoExecute}),
+			).Build()
+			Expect(become(client, WithStaleLockPolicies(StaleLockNodeUnreachable), WithoutNodeCheck())).To(MatchError(ErrAcquisitionTimeout))
+		})
+		It("should break the lock of a leader stuck terminating", func() {
+			leaderPod.Finalizers = []string{"example.com/finalizer"}
+			leaderPod.DeletionTimestamp = &metav1.Time{Time: time.Now().Add(-StuckTerminatingTolerance - time.Minute)}
+			client := fake.NewClientBuilder().WithObjects(
+				&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "operator-pod", Namespace: "testns"}},
+				leaderPod, lock(), node(),
+			).Build()
+			takeovers := metrics.LockTakeovers.WithLabelValues("stale-lock", metrics.TakeoverReasonStuckTerminating)
+			before := testutil.ToFloat64(takeovers)
+
+			Expect(become(client)).To(MatchError(ErrAcquisitionTimeout))
+			Expect(become(client, WithStaleLockPolicies(StaleLockStuckTerminating))).To(Succeed())
+			Expect(testutil.ToFloat64(takeovers)).To(Equal(before + 1))
+		})
+		It("should keep the lock of a leader within its grace period", func() {
+			leaderPod.Finalizers = []string{"example.com/finalizer"}
+			leaderPod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			client := fake.NewClientBuilder().WithObjects(
+				&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "operator-pod", Namespace: "testns"}},
+				leaderPod, lock(), node(),
+			).Build()
+			Expect(become(client, WithStaleLockPolicies(StaleLockStuckTerminating))).To(MatchError(ErrAcquisitionTimeout))
+		})
+	})
+
+	Describe("Become with a workload owner", func() {
+		controller := true
+		replicaSet := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name:      "operator-rs",
+			Namespace: "testns",
+			UID:       "rs-uid",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "operator", UID: "deployment-uid", Controller: &controller},
+			},
+		}}
+		podOf := func(name string, refs ...metav1.OwnerReference) *corev1.Pod {
+			for i := range refs {
+				refs[i].Controller = &controller
+			}
+			return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "testns", OwnerReferences: refs}}
+		}
+		rsRef := metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "operator-rs", UID: "rs-uid"}
+		lockKey := crclient.ObjectKey{Namespace: "testns", Name: "workload-lock"}
+		become := func(client crclient.Client, opts ...Option) error {
+			opts = append([]Option{WithClient(client), WithWorkloadOwner(), WithMaxAttempts(3), WithBackoff(time.Millisecond, time.Millisecond)}, opts...)
+			return Become(context.TODO(), "workload-lock", opts...)
+		}
+		BeforeEach(func() {
+			os.Setenv("POD_NAME", "operator-pod")
+			readNamespace = func() (string, error) {
+				return "testns", nil
+			}
+		})
+		It("should make the Deployment own the lock and continue as the leader after a restart", func() {
+			client := fake.NewClientBuilder().WithObjects(replicaSet, podOf("operator-pod", rsRef)).Build()
+			Expect(become(client)).To(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), lockKey, cm)).To(Succeed())
+			Expect(cm.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+				APIVersion: "apps/v1", Kind: "Deployment", Name: "operator", UID: "deployment-uid",
+			}))
+			Expect(cm.Annotations).To(HaveKeyWithValue(LockHolderAnnotation, "operator-pod"))
+
+			Expect(become(client)).To(Succeed())
+		})
+		It("should make the StatefulSet own a Lease lock", func() {
+			ssRef := metav1.OwnerReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "operator", UID: "ss-uid"}
+			client := fake.NewClientBuilder().WithObjects(podOf("operator-pod", ssRef)).Build()
+			Expect(become(client, WithLockType(LeaseLock), WithoutConfigMapLock())).To(Succeed())
+
+			lease := &coordinationv1.Lease{}
+			Expect(client.Get(context.TODO(), lockKey, lease)).To(Succeed())
+			Expect(lease.OwnerReferences).To(HaveLen(1))
+			Expect(lease.OwnerReferences[0].Kind).To(Equal("StatefulSet"))
+			Expect(*lease.Spec.HolderIdentity).To(Equal("operator-pod"))
+		})
+		It("should make the pod own the lock when it is controlled by another kind", func() {
+			jobRef := metav1.OwnerReference{APIVersion: "batch/v1", Kind: "Job", Name: "operator", UID: "job-uid"}
+			client := fake.NewClientBuilder().WithObjects(podOf("operator-pod", jobRef)).Build()
+			Expect(become(client)).To(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), lockKey, cm)).To(Succeed())
+			Expect(cm.OwnerReferences).To(HaveLen(1))
+			Expect(cm.OwnerReferences[0].Kind).To(Equal("Pod"))
+		})
+		It("should delete the lock of a deleted leader", func() {
+			lock := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:            lockKey.Name,
+				Namespace:       lockKey.Namespace,
+				Annotations:     map[string]string{LockHolderAnnotation: "old-leader"},
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "operator", UID: "deployment-uid"}},
+			}}
+			client := fake.NewClientBuilder().WithObjects(replicaSet, podOf("operator-pod", rsRef), lock).Build()
+			takeovers := metrics.LockTakeovers.WithLabelValues("workload-lock", metrics.TakeoverReasonLeaderGone)
+			before := testutil.ToFloat64(takeovers)
+
+			Expect(become(client)).To(Succeed())
+			Expect(testutil.ToFloat64(takeovers)).To(Equal(before + 1))
+			cm := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), lockKey, cm)).To(Succeed())
+			Expect(cm.Annotations).To(HaveKeyWithValue(LockHolderAnnotation, "operator-pod"))
+		})
+		It("should wait for a running leader", func() {
+			lock := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:            lockKey.Name,
+				Namespace:       lockKey.Namespace,
+				Annotations:     map[string]string{LockHolderAnnotation: "other-leader"},
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "operator", UID: "deployment-uid"}},
+			}}
+			client := fake.NewClientBuilder().WithObjects(replicaSet, podOf("operator-pod", rsRef), podOf("other-leader", rsRef), lock).Build()
+			Expect(become(client)).To(MatchError(ErrAcquisitionTimeout))
+		})
+	})
+
 	Describe("isPodEvicted", func() {
 		var leaderPod *corev1.Pod
 		BeforeEach(func() {
@@ -332,44 +951,6 @@ var _ = Describe("Leader election", func() {
 			Expect(isPodPreempted(*leaderPod)).To(BeTrue())
 		})
 	})
-	Describe("myOwnerRef", func() {
-		var client crclient.Client
-		BeforeEach(func() {
-			client = fake.NewClientBuilder().WithObjects(
-				&corev1.Pod{
-					TypeMeta: metav1.TypeMeta{
-						APIVersion: schema.GroupVersion{
-							Group:   corev1.SchemeGroupVersion.Group,
-							Version: corev1.SchemeGroupVersion.Version,
-						}.String(),
-						Kind: "Pod",
-					},
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "mypod",
-						Namespace: "testns",
-					},
-				},
-			).Build()
-		})
-		It("should return an error when POD_NAME is not set", func() {
-			os.Unsetenv("POD_NAME")
-			_, err := myOwnerRef(context.TODO(), client, "")
-			Expect(err).Should(HaveOccurred())
-		})
-		It("should return an error if no pod is found", func() {
-			os.Setenv("POD_NAME", "thisisnotthepodyourelookingfor")
-			_, err := myOwnerRef(context.TODO(), client, "")
-			Expect(err).Should(HaveOccurred())
-		})
-		It("should return the owner reference without error", func() {
-			os.Setenv("POD_NAME", "mypod")
-			owner, err := myOwnerRef(context.TODO(), client, "testns")
-			Expect(err).ShouldNot(HaveOccurred())
-			Expect(owner.APIVersion).To(Equal("v1"))
-			Expect(owner.Kind).To(Equal("Pod"))
-			Expect(owner.Name).To(Equal("mypod"))
-		})
-	})
 	Describe("getPod", func() {
 		var client crclient.Client
 		BeforeEach(func() {
@@ -466,35 +1047,64 @@ var _ = Describe("Leader election", func() {
 
 		It("should return false if node is invalid", func() {
 			client = fake.NewClientBuilder().WithObjects().Build()
-			ret := isNotReadyNode(context.TODO(), client, "")
+			ret := (&Config{Client: client}).isNotReadyNode(context.TODO(), "")
 			Expect(ret).To(BeFalse())
 		})
 		It("should return false if no NodeCondition is found", func() {
 			client = fake.NewClientBuilder().WithObjects(node).Build()
-			ret := isNotReadyNode(context.TODO(), client, nodeName)
+			ret := (&Config{Client: client}).isNotReadyNode(context.TODO(), nodeName)
 			Expect(ret).To(BeFalse())
 		})
 		It("should return false if type is incorrect", func() {
 			node.Status.Conditions[0].Type = corev1.NodeMemoryPressure
 			node.Status.Conditions[0].Status = corev1.ConditionFalse
 			client = fake.NewClientBuilder().WithObjects(node).Build()
-			ret := isNotReadyNode(context.TODO(), client, nodeName)
+			ret := (&Config{Client: client}).isNotReadyNode(context.TODO(), nodeName)
 			Expect(ret).To(BeFalse())
 		})
 		It("should return false if NodeReady's type is true", func() {
 			node.Status.Conditions[0].Type = corev1.NodeReady
 			node.Status.Conditions[0].Status = corev1.ConditionTrue
 			client = fake.NewClientBuilder().WithObjects(node).Build()
-			ret := isNotReadyNode(context.TODO(), client, nodeName)
+			ret := (&Config{Client: client}).isNotReadyNode(context.TODO(), nodeName)
 			Expect(ret).To(BeFalse())
 		})
 		It("should return true when Type is set and Status is set to false", func() {
 			node.Status.Conditions[0].Type = corev1.NodeReady
 			node.Status.Conditions[0].Status = corev1.ConditionFalse
 			client = fake.NewClientBuilder().WithObjects(node).Build()
-			ret := isNotReadyNode(context.TODO(), client, nodeName)
+			ret := (&Config{Client: client}).isNotReadyNode(context.TODO(), nodeName)
 			Expect(ret).To(BeTrue())
 		})
+		It("should return false without reading the node when the node check is skipped", func() {
+			node.Status.Conditions[0].Type = corev1.NodeReady
+			node.Status.Conditions[0].Status = corev1.ConditionFalse
+			var gets int
+			client = interceptor.NewClient(fake.NewClientBuilder().WithObjects(node).Build(), interceptor.Funcs{
+				Get: func(ctx context.Context, c crclient.WithWatch, key crclient.ObjectKey, obj crclient.Object, opts ...crclient.GetOption) error {
+					gets++
+					return c.Get(ctx, key, obj, opts...)
+				},
+			})
+			config := Config{Client: client}
+			Expect(WithoutNodeCheck()(&config)).To(Succeed())
+			Expect(config.isNotReadyNode(context.TODO(), nodeName)).To(BeFalse())
+			Expect(gets).To(BeZero())
+		})
+		It("should skip the node check once reading nodes is forbidden", func() {
+			var gets int
+			client = interceptor.NewClient(fake.NewClientBuilder().WithObjects(node).Build(), interceptor.Funcs{
+				Get: func(_ context.Context, _ crclient.WithWatch, key crclient.ObjectKey, _ crclient.Object, _ ...crclient.GetOption) error {
+					gets++
+					return apierrors.NewForbidden(schema.GroupResource{Resource: "nodes"}, key.Name, errors.New("no RBAC"))
+				},
+			})
+			config := Config{Client: client}
+			Expect(config.isNotReadyNode(context.TODO(), nodeName)).To(BeFalse())
+			Expect(config.SkipNodeCheck).To(BeTrue())
+			Expect(config.isNotReadyNode(context.TODO(), nodeName)).To(BeFalse())
+			Expect(gets).To(Equal(1))
+		})
 	})
 	Describe("deleteLeader", func() {
 		var (
@@ -0,0 +1,196 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leader
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LockType is the kind of object used as the lock record by Become.
+type LockType string
+
+const (
+	// ConfigMapLock uses a ConfigMap as the lock record. It is the default.
+	ConfigMapLock LockType = "ConfigMap"
+	// LeaseLock uses a coordination.k8s.io/v1 Lease as the lock record. The holder of the Lease is
+	// set to the name of the leader pod. Leases are not renewed: the leader is still the leader
+	// for life, and the Lease is garbage collected with the leader pod.
+	LeaseLock LockType = "Lease"
+)
+
+// WithLockType returns an Option that sets the kind of object used as the lock record.
+//
+// Operators switching from ConfigMapLock to LeaseLock are migrated live: a ConfigMap lock with
+// the same name held by another pod, e.g. the leader running the previous version of the
+// operator, is honored and taken over like a Lease, and a ConfigMap lock held by the current
+// pod is adopted. Since replicas of the previous version only know about ConfigMap locks, the
+// leader also holds a ConfigMap lock next to its Lease, until all replicas use Leases and the
+// ConfigMap lock is released with WithoutConfigMapLock.
+func WithLockType(lockType LockType) Option {
+	return func(c *Config) error {
+		switch lockType {
+		case ConfigMapLock, LeaseLock:
+			c.LockType = lockType
+			return nil
+		default:
+			return fmt.Errorf("unknown lock type %q", lockType)
+		}
+	}
+}
+
+// WithoutConfigMapLock returns an Option that completes the migration to LeaseLock, see
+// WithLockType: the leader no longer holds a ConfigMap lock next to its Lease, and deletes the
+// ConfigMap lock it held, if any. ConfigMap locks held by other pods are still honored.
+func WithoutConfigMapLock() Option {
+	return func(c *Config) error {
+		c.SkipConfigMapLock = true
+		return nil
+	}
+}
+
+// newLock returns an empty lock record of the given type.
+func newLock(lockType LockType, key crclient.ObjectKey) crclient.Object {
+	meta := metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}
+	if lockType == LeaseLock {
+		return &coordinationv1.Lease{
+			TypeMeta:   metav1.TypeMeta{APIVersion: coordinationv1.SchemeGroupVersion.String(), Kind: "Lease"},
+			ObjectMeta: meta,
+		}
+	}
+	return &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: meta,
+	}
+}
+
+// newLockFor returns the lock record of the given type held by owner, acquired at now. The lock
+// is owned by the workload of owner instead, see WithWorkloadOwner.
+func (c *Config) newLockFor(lockType LockType, key crclient.ObjectKey, owner *metav1.OwnerReference, now time.Time) crclient.Object {
+	lock := newLock(lockType, key)
+	annotations := map[string]string{}
+	if c.workloadOwner != nil {
+		lock.SetOwnerReferences([]metav1.OwnerReference{*c.workloadOwner})
+		annotations[LockHolderAnnotation] = owner.Name
+	} else {
+		lock.SetOwnerReferences([]metav1.OwnerReference{*owner})
+	}
+	if c.RecordLockAnnotations {
+		annotations[LockHolderAnnotation] = owner.Name
+		annotations[LockAcquireTimeAnnotation] = now.UTC().Format(time.RFC3339)
+	}
+	if len(annotations) > 0 {
+		lock.SetAnnotations(annotations)
+	}
+	if lease, ok := lock.(*coordinationv1.Lease); ok {
+		holder := owner.Name
+		acquireTime := metav1.NewMicroTime(now)
+		lease.Spec.HolderIdentity = &holder
+		lease.Spec.AcquireTime = &acquireTime
+	}
+	return lock
+}
+
+// configMapLockOfOtherPod returns the ConfigMap lock named key held by a pod other than owner,
+// when Leases are used as lock records, or nil if there is none.
+func (c *Config) configMapLockOfOtherPod(ctx context.Context, key crclient.ObjectKey, owner *metav1.OwnerReference) (crclient.Object, error) {
+	if c.LockType != LeaseLock {
+		return nil, nil
+	}
+	cm := &corev1.ConfigMap{}
+	if err := c.Client.Get(ctx, key, cm); apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting the ConfigMap lock: %w", err)
+	}
+	if isHeldBy(cm, owner) {
+		return nil, nil
+	}
+	cm.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+	return cm, nil
+}
+
+// syncConfigMapLock makes the leader hold a ConfigMap lock next to its Lease, or deletes the
+// ConfigMap lock it holds once the migration to Leases is complete, see WithLockType. It returns
+// false if the ConfigMap lock is held by another pod, e.g. a replica not using Leases that
+// acquired it concurrently, in which case the current pod is not the leader. Other errors are
+// logged: the Lease is the lock record.
+func (c *Config) syncConfigMapLock(ctx context.Context, key crclient.ObjectKey, owner *metav1.OwnerReference) bool {
+	if c.LockType != LeaseLock {
+		return true
+	}
+
+	if !c.SkipConfigMapLock {
+		err := c.Client.Create(ctx, c.newLockFor(ConfigMapLock, key, owner, time.Now()))
+		switch {
+		case apierrors.IsAlreadyExists(err):
+			cm := &corev1.ConfigMap{}
+			if err := c.Client.Get(ctx, key, cm); err != nil {
+				// the ConfigMap lock got lost or cannot be checked, try again later
+				log.Error(err, "Failed to get the ConfigMap lock held for replicas not using Leases")
+				return false
+			}
+			return isHeldBy(cm, owner)
+		case err != nil:
+			log.Error(err, "Failed to create the ConfigMap lock held for replicas not using Leases")
+		}
+		return true
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := c.Client.Get(ctx, key, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed to get the ConfigMap lock")
+		}
+		return true
+	}
+	if !isHeldBy(cm, owner) {
+		return false
+	}
+	if err := c.Client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "Failed to delete the ConfigMap lock after migrating to a Lease")
+		return true
+	}
+	log.Info("Deleted the ConfigMap lock after migrating to a Lease.")
+	return true
+}
+
+// releaseLease deletes the Lease held by the current pod while the ConfigMap lock is held by
+// another pod, so that the holder of the ConfigMap lock can acquire it.
+func (c *Config) releaseLease(ctx context.Context, lease crclient.Object) {
+	log.Info("Found a ConfigMap lock held by another pod, releasing the Lease until it is free.")
+	if err := c.Client.Delete(ctx, lease); err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "Failed to release the Lease")
+	}
+}
+
+// isHeldBy returns true if the lock record obj is held by the pod owner refers to, i.e. the pod
+// is recorded as its holder, or owns it if no holder is recorded.
+func isHeldBy(obj crclient.Object, owner *metav1.OwnerReference) bool {
+	if lease, ok := obj.(*coordinationv1.Lease); ok && lease.Spec.HolderIdentity != nil && *lease.Spec.HolderIdentity != "" {
+		return *lease.Spec.HolderIdentity == owner.Name
+	}
+	if holder := obj.GetAnnotations()[LockHolderAnnotation]; holder != "" {
+		return holder == owner.Name
+	}
+	return isOwnedByPod(obj, owner.Name)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leader
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WithWorkloadOwner returns an Option that sets the owner reference of the lock record to the
+// workload controlling the current pod instead of the pod: its Deployment, or its ReplicaSet if
+// the ReplicaSet has no Deployment, or its StatefulSet. The lock then survives the deletion of
+// the leader pod, e.g. a StatefulSet pod recreated with the same name continues as the leader, but
+// is garbage collected along with the workload. Pods controlled by other kinds of workloads, such
+// as Jobs, still own the lock.
+//
+// The leader pod is recorded in LockHolderAnnotation, or as the holder of a Lease. Since the
+// garbage collector does not delete the lock of a leader pod that is gone, the other candidates
+// delete it themselves.
+func WithWorkloadOwner() Option {
+	return func(c *Config) error {
+		c.WorkloadOwner = true
+		return nil
+	}
+}
+
+// workloadOwnerRef returns an OwnerReference to the Deployment, ReplicaSet or StatefulSet
+// controlling pod, or nil if it has none.
+func workloadOwnerRef(ctx context.Context, client crclient.Client, pod *corev1.Pod) *metav1.OwnerReference {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil || (ref.Kind != "ReplicaSet" && ref.Kind != "StatefulSet") {
+		log.Info("The operator pod is not controlled by a Deployment, ReplicaSet or StatefulSet, the lock is owned by the pod.")
+		return nil
+	}
+	if ref.Kind == "ReplicaSet" {
+		if deployment := getDeployment(ctx, client, pod); deployment != nil {
+			ref = metav1.NewControllerRef(deployment, appsv1.SchemeGroupVersion.WithKind("Deployment"))
+		}
+	}
+	return &metav1.OwnerReference{APIVersion: ref.APIVersion, Kind: ref.Kind, Name: ref.Name, UID: ref.UID}
+}
+
+// lockHolder returns the name of the pod holding lock: the holder of a Lease, the pod recorded in
+// LockHolderAnnotation, or else the pod owning lock. It returns false if there is none.
+func lockHolder(lock crclient.Object) (string, bool) {
+	if lease, ok := lock.(*coordinationv1.Lease); ok && lease.Spec.HolderIdentity != nil && *lease.Spec.HolderIdentity != "" {
+		return *lease.Spec.HolderIdentity, true
+	}
+	if holder := lock.GetAnnotations()[LockHolderAnnotation]; holder != "" {
+		return holder, true
+	}
+
+	owners := lock.GetOwnerReferences()
+	switch {
+	case len(owners) != 1:
+		log.Info("Leader lock must have exactly one owner reference.", "Lock", lock)
+		return "", false
+	case owners[0].Kind != "Pod":
+		log.Info("Leader lock owner reference must be a pod.", "OwnerReference", owners[0])
+		return "", false
+	}
+	return owners[0].Name, true
+}
+
+// isOwnedByPod returns true if obj has an owner reference to the pod named name.
+func isOwnedByPod(obj crclient.Object, name string) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Kind == "Pod" && ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leader
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// StaleLockPolicy is a condition under which the lock of a leader pod is considered stale and
+// deleted by the other candidates, see WithStaleLockPolicies.
+type StaleLockPolicy string
+
+const (
+	// StaleLockNodeUnreachable breaks the lock of a leader running on a node tainted with
+	// node.kubernetes.io/unreachable, i.e. a node the node controller lost contact with. It
+	// requires reading Nodes, see WithoutNodeCheck.
+	StaleLockNodeUnreachable StaleLockPolicy = "NodeUnreachable"
+	// StaleLockStuckTerminating breaks the lock of a leader pod that is still terminating more
+	// than StuckTerminatingTolerance after the end of its grace period, e.g. because its kubelet
+	// is gone. The pod is force deleted.
+	StaleLockStuckTerminating StaleLockPolicy = "StuckTerminating"
+)
+
+// StuckTerminatingTolerance is the time a leader pod may keep terminating after the end of its
+// grace period before its lock is broken by StaleLockStuckTerminating.
+const StuckTerminatingTolerance = time.Minute
+
+// WithStaleLockPolicies returns an Option that makes Become break the lock of a leader that
+// meets any of policies, in addition to the leaders that were evicted, preempted or run on a
+// NotReady node. The leader pod and its lock are deleted, so that failover happens without
+// deleting the lock manually.
+func WithStaleLockPolicies(policies ...StaleLockPolicy) Option {
+	return func(c *Config) error {
+		for _, policy := range policies {
+			switch policy {
+			case StaleLockNodeUnreachable, StaleLockStuckTerminating:
+			default:
+				return fmt.Errorf("unknown stale lock policy %q", policy)
+			}
+		}
+		c.StaleLockPolicies = append(c.StaleLockPolicies, policies...)
+		return nil
+	}
+}
+
+// hasStaleLockPolicy returns true if policy is enabled.
+func (c *Config) hasStaleLockPolicy(policy StaleLockPolicy) bool {
+	for _, p := range c.StaleLockPolicies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+// isStuckTerminating returns true if StaleLockStuckTerminating is enabled and pod is still
+// terminating StuckTerminatingTolerance after the end of its grace period.
+func (c *Config) isStuckTerminating(pod *corev1.Pod) bool {
+	if !c.hasStaleLockPolicy(StaleLockStuckTerminating) || pod.GetDeletionTimestamp() == nil {
+		return false
+	}
+	// the deletion timestamp is set to the end of the grace period
+	return time.Since(pod.GetDeletionTimestamp().Time) > StuckTerminatingTolerance
+}
+
+// isUnreachableNode returns true if StaleLockNodeUnreachable is enabled and the node nodeName
+// has the unreachable taint, unless the node check is skipped. If reading the node is
+// forbidden, the node check is skipped from then on.
+func (c *Config) isUnreachableNode(ctx context.Context, nodeName string) bool {
+	if c.SkipNodeCheck || !c.hasStaleLockPolicy(StaleLockNodeUnreachable) {
+		return false
+	}
+	node := &corev1.Node{}
+	if err := getNode(ctx, c.Client, nodeName, node); err != nil {
+		if apierrors.IsForbidden(err) {
+			log.Info("Not allowed to read Nodes, stale locks are only detected from the status of the leader pod.")
+			c.SkipNodeCheck = true
+		}
+		return false
+	}
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == corev1.TaintNodeUnreachable {
+			return true
+		}
+	}
+	return false
+}
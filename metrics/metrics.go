@@ -0,0 +1,42 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics registers the metrics shared by all packages of the library:
+//
+//   - operator_lib_errors_total counts notable internal failures, by subsystem and reason.
+//   - operator_lib_dropped_events_total counts the events that the handlers and predicates of
+//     the library did not pass on, by subsystem and reason, to find out why reconciliations did or
+//     did not happen.
+//   - operator_lib_paused_objects is the number of objects currently paused, by group, version
+//     and kind.
+//
+// The metrics are only exported once registered, with Register or with the metrics registration
+// of any package of the library: RegisterMetrics of the gate, handler, predicate and recovery
+// packages, or WithMetricsRegistry of the prune and leader packages. Operators alerting on
+// operator_lib_errors_total should register it with Register, since the failures of all
+// subsystems, including those of packages without metrics of their own such as conditions, are
+// counted in it.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+// Register registers the metrics shared by all packages of the library with reg, e.g.
+// controller-runtime's metrics.Registry. Registering them again with the same reg has no effect.
+func Register(reg prometheus.Registerer) error {
+	return libmetrics.Register(reg)
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+var _ = Describe("Register", func() {
+	It("should export the shared metrics with the registry, once", func() {
+		reg := prometheus.NewRegistry()
+		Expect(Register(reg)).To(Succeed())
+		Expect(Register(reg)).To(Succeed())
+
+		libmetrics.RecordError(libmetrics.SubsystemPrune, "test")
+		Expect(testutil.GatherAndCount(reg, "operator_lib_errors_total")).To(BeNumerically(">", 0))
+	})
+})
@@ -22,10 +22,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
 )
 
 var log = logf.Log.WithName("predicate")
 
+// drop records an event dropped by a predicate of this package for reason, and returns false.
+func drop(reason string) bool {
+	libmetrics.RecordDroppedEvent(libmetrics.SubsystemPredicate, reason)
+	return false
+}
+
 var _ predicate.Predicate = DependentPredicate{}
 
 // DependentPredicate is a predicate that filters events for resources
@@ -57,7 +65,7 @@ func (DependentPredicate) Create(e event.CreateEvent) bool {
 	log.V(1).Info("Skipping reconciliation for dependent resource creation",
 		"name", o.GetName(), "namespace", o.GetNamespace(), "apiVersion",
 		o.GroupVersionKind().GroupVersion(), "kind", o.GroupVersionKind().Kind)
-	return false
+	return drop(libmetrics.DropReasonPredicateFiltered)
 }
 
 // Delete passes all events through. This allows the controller to
@@ -77,7 +85,7 @@ func (DependentPredicate) Generic(e event.GenericEvent) bool {
 	log.V(1).Info("Skipping reconcile due to generic event", "name", o.GetName(),
 		"namespace", o.GetNamespace(), "apiVersion", o.GroupVersionKind().GroupVersion(),
 		"kind", o.GroupVersionKind().Kind)
-	return false
+	return drop(libmetrics.DropReasonPredicateFiltered)
 }
 
 // Update filters out events that change only the dependent resource
@@ -96,7 +104,7 @@ func (DependentPredicate) Update(e event.UpdateEvent) bool {
 	updated.SetManagedFields(removeTimeFromManagedFields(updated.GetManagedFields()))
 
 	if reflect.DeepEqual(old.Object, updated.Object) {
-		return false
+		return drop(libmetrics.DropReasonPredicateFiltered)
 	}
 	log.V(1).Info("Reconciling due to dependent resource update",
 		"name", updated.GetName(), "namespace", updated.GetNamespace(), "apiVersion",
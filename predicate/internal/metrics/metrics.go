@@ -0,0 +1,44 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// StaleEventsSuppressed counts events dropped by the resource version predicate
+// because they did not carry a newer resourceVersion, with information {"event"}
+var StaleEventsSuppressed = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "resource_version_predicate_suppressed_events_total",
+	Help: "Total number of events suppressed because their resourceVersion was not newer than the last handled one",
+}, []string{"event"})
+
+// Register registers the predicate metrics with reg. Metrics that are already registered
+// with reg are skipped.
+func Register(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{
+		StaleEventsSuppressed,
+	} {
+		if err := reg.Register(c); err != nil {
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if !errors.As(err, &alreadyRegistered) {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+	"github.com/operator-framework/operator-lib/predicate/internal/metrics"
+)
+
+// RegisterMetrics registers the predicate metrics with reg, e.g. controller-runtime's
+// metrics.Registry. The metrics report the events suppressed by resource version predicates. The
+// metrics shared by the library, which report the events dropped by all predicates by reason, are
+// registered as well, see the metrics package.
+func RegisterMetrics(reg prometheus.Registerer) error {
+	if err := metrics.Register(reg); err != nil {
+		return fmt.Errorf("error registering predicate metrics: %w", err)
+	}
+	if err := libmetrics.Register(reg); err != nil {
+		return fmt.Errorf("error registering operator-lib metrics: %w", err)
+	}
+	return nil
+}
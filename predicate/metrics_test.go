@@ -0,0 +1,40 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("RegisterMetrics", func() {
+	It("should export the predicate metrics with the registry, once", func() {
+		reg := prometheus.NewRegistry()
+		Expect(RegisterMetrics(reg)).To(Succeed())
+		Expect(RegisterMetrics(reg)).To(Succeed())
+
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "metrics", Namespace: "default", ResourceVersion: "1"}}
+		pred := NewResourceVersionPredicate[client.Object](1)
+		Expect(pred.Create(makeCreateEventFor(pod))).To(BeTrue())
+		Expect(pred.Create(makeCreateEventFor(pod))).To(BeFalse())
+		Expect(testutil.GatherAndCount(reg, "resource_version_predicate_suppressed_events_total")).To(BeNumerically(">", 0))
+		Expect(testutil.GatherAndCount(reg, "operator_lib_dropped_events_total")).To(BeNumerically(">", 0))
+	})
+})
@@ -0,0 +1,102 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+// NewNodeLifecyclePredicate returns a predicate for Nodes that only passes the Update events
+// changing the lifecycle of a Node: its taints, whether it is cordoned, the status of its
+// conditions, or the start of its deletion. Nodes update their status every few seconds with new
+// heartbeat times, resource usage and images, and those updates are filtered out. Create, Delete
+// and Generic events always pass, as do events for objects that are not *corev1.Node, e.g.
+// metadata-only Nodes. It is typically used with handler.EnqueueRequestsForNode.
+func NewNodeLifecyclePredicate[T client.Object]() predicate.TypedPredicate[T] {
+	return predicate.TypedFuncs[T]{
+		UpdateFunc: func(e event.TypedUpdateEvent[T]) bool {
+			oldNode, okOld := any(e.ObjectOld).(*corev1.Node)
+			newNode, okNew := any(e.ObjectNew).(*corev1.Node)
+			if !okOld || !okNew || oldNode == nil || newNode == nil {
+				return true
+			}
+			if nodeLifecycleChanged(oldNode, newNode) {
+				return true
+			}
+			return drop(libmetrics.DropReasonPredicateFiltered)
+		},
+	}
+}
+
+// nodeLifecycleChanged returns true if the taints, schedulability, condition statuses or
+// deletion of a Node changed between oldNode and newNode.
+func nodeLifecycleChanged(oldNode, newNode *corev1.Node) bool {
+	if oldNode.Spec.Unschedulable != newNode.Spec.Unschedulable {
+		return true
+	}
+	if oldNode.DeletionTimestamp.IsZero() != newNode.DeletionTimestamp.IsZero() {
+		return true
+	}
+	if !sameTaints(oldNode.Spec.Taints, newNode.Spec.Taints) {
+		return true
+	}
+	return !sameConditionStatuses(oldNode.Status.Conditions, newNode.Status.Conditions)
+}
+
+// sameTaints returns true if a and b have the same taints, ignoring when they were added.
+func sameTaints(a, b []corev1.Taint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	type taintKey struct {
+		key, value string
+		effect     corev1.TaintEffect
+	}
+	taints := make(map[taintKey]int, len(a))
+	for _, t := range a {
+		taints[taintKey{t.Key, t.Value, t.Effect}]++
+	}
+	for _, t := range b {
+		k := taintKey{t.Key, t.Value, t.Effect}
+		if taints[k] == 0 {
+			return false
+		}
+		taints[k]--
+	}
+	return true
+}
+
+// sameConditionStatuses returns true if a and b have the same condition types with the same
+// statuses, ignoring heartbeat and transition times, reasons and messages.
+func sameConditionStatuses(a, b []corev1.NodeCondition) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	statuses := make(map[corev1.NodeConditionType]corev1.ConditionStatus, len(a))
+	for _, c := range a {
+		statuses[c.Type] = c.Status
+	}
+	for _, c := range b {
+		if status, ok := statuses[c.Type]; !ok || status != c.Status {
+			return false
+		}
+	}
+	return true
+}
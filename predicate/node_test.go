@@ -0,0 +1,110 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+var _ = Describe("NodeLifecyclePredicate", func() {
+	var (
+		pred predicate.TypedPredicate[client.Object]
+		node *corev1.Node
+	)
+
+	BeforeEach(func() {
+		pred = NewNodeLifecyclePredicate[client.Object]()
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-0", ResourceVersion: "1"},
+			Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+				{Key: "dedicated", Value: "infra", Effect: corev1.TaintEffectNoSchedule},
+			}},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue, LastHeartbeatTime: metav1.Now()},
+				{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse, LastHeartbeatTime: metav1.Now()},
+			}},
+		}
+	})
+
+	update := func(mutate func(*corev1.Node)) bool {
+		updated := node.DeepCopy()
+		updated.ResourceVersion = "2"
+		mutate(updated)
+		return pred.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: updated})
+	}
+
+	It("should filter heartbeat updates", func() {
+		Expect(update(func(n *corev1.Node) {
+			later := metav1.NewTime(time.Now().Add(10 * time.Second))
+			for i := range n.Status.Conditions {
+				n.Status.Conditions[i].LastHeartbeatTime = later
+				n.Status.Conditions[i].Message = "kubelet is posting ready status"
+			}
+			n.Status.Allocatable = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3")}
+			n.Status.Images = []corev1.ContainerImage{{Names: []string{"churro:latest"}}}
+			n.Annotations = map[string]string{"node.alpha.kubernetes.io/ttl": "0"}
+		})).To(BeFalse())
+	})
+
+	It("should filter updates reordering taints and conditions", func() {
+		Expect(update(func(n *corev1.Node) {
+			n.Spec.Taints[0].TimeAdded = &metav1.Time{Time: time.Now()}
+			n.Status.Conditions[0], n.Status.Conditions[1] = n.Status.Conditions[1], n.Status.Conditions[0]
+		})).To(BeFalse())
+	})
+
+	It("should pass cordoning", func() {
+		Expect(update(func(n *corev1.Node) { n.Spec.Unschedulable = true })).To(BeTrue())
+	})
+
+	It("should pass taint changes", func() {
+		Expect(update(func(n *corev1.Node) {
+			n.Spec.Taints = append(n.Spec.Taints, corev1.Taint{Key: "node.kubernetes.io/unreachable", Effect: corev1.TaintEffectNoExecute})
+		})).To(BeTrue())
+		Expect(update(func(n *corev1.Node) { n.Spec.Taints[0].Effect = corev1.TaintEffectNoExecute })).To(BeTrue())
+		Expect(update(func(n *corev1.Node) { n.Spec.Taints = nil })).To(BeTrue())
+	})
+
+	It("should pass condition transitions", func() {
+		Expect(update(func(n *corev1.Node) { n.Status.Conditions[0].Status = corev1.ConditionUnknown })).To(BeTrue())
+		Expect(update(func(n *corev1.Node) {
+			n.Status.Conditions = append(n.Status.Conditions, corev1.NodeCondition{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue})
+		})).To(BeTrue())
+	})
+
+	It("should pass the start of a deletion", func() {
+		Expect(update(func(n *corev1.Node) { n.DeletionTimestamp = &metav1.Time{Time: time.Now()} })).To(BeTrue())
+	})
+
+	It("should pass create, delete and generic events", func() {
+		Expect(pred.Create(event.CreateEvent{Object: node})).To(BeTrue())
+		Expect(pred.Delete(event.DeleteEvent{Object: node})).To(BeTrue())
+		Expect(pred.Generic(event.GenericEvent{Object: node})).To(BeTrue())
+	})
+
+	It("should pass updates of other objects", func() {
+		cm := &corev1.ConfigMap{}
+		Expect(pred.Update(event.UpdateEvent{ObjectOld: cm, ObjectNew: cm})).To(BeTrue())
+	})
+})
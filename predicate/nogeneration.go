@@ -17,6 +17,8 @@ package predicate
 import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
 )
 
 var _ predicate.Predicate = NoGenerationPredicate{}
@@ -60,13 +62,16 @@ type NoGenerationPredicate struct {
 func (NoGenerationPredicate) Update(e event.UpdateEvent) bool {
 	if e.ObjectOld == nil {
 		log.V(1).Info("Update event has no old runtime object to update", "event", e)
-		return false
+		return drop(libmetrics.DropReasonNoObject)
 	}
 	if e.ObjectNew == nil {
 		log.V(1).Info("Update event has no new runtime object for update", "event", e)
-		return false
+		return drop(libmetrics.DropReasonNoObject)
 	}
 	// Since generation is monotonically increasing, the new generation will always be greater than the old
 	// iff the object respects generations.
-	return e.ObjectNew.GetGeneration() == e.ObjectOld.GetGeneration() && e.ObjectNew.GetGeneration() == 0
+	if e.ObjectNew.GetGeneration() == e.ObjectOld.GetGeneration() && e.ObjectNew.GetGeneration() == 0 {
+		return true
+	}
+	return drop(libmetrics.DropReasonPredicateFiltered)
 }
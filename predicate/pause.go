@@ -33,5 +33,24 @@ import (
 // to a webhook:
 // https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#request
 func NewPause[T client.Object](key string) (predicate.TypedPredicate[T], error) {
-	return annotation.NewFalsyPredicate[T](key, annotation.Options{Log: log})
+	return annotation.NewFalsyPredicate[T](key, annotation.Options{Log: log, TrackPaused: true})
+}
+
+// NewPauseFunc returns a predicate that filters out objects for which paused returns true.
+// This supports pause semantics modeled as a field of the object, ex. spec.paused, instead of an annotation.
+// The same security considerations as NewPause apply to the field used to pause reconciliation.
+func NewPauseFunc[T client.Object](paused func(client.Object) bool) predicate.TypedPredicate[T] {
+	return annotation.NewFalsyPredicateForLookup[T]("pause func", annotation.BoolLookup(paused), annotation.Options{Log: log, TrackPaused: true})
+}
+
+// NewPauseJSONPath returns a predicate that filters out objects whose field at JSONPath path,
+// ex. "{.spec.paused}", has a truthy value. Typed objects are converted to unstructured to evaluate the path.
+// When the field is absent, null or has a falsy value, the watch constructed with this predicate
+// will see events for the object. Otherwise it will not pass events for that object to the event handler.
+func NewPauseJSONPath[T client.Object](path string) (predicate.TypedPredicate[T], error) {
+	lookup, err := annotation.JSONPathLookup(path)
+	if err != nil {
+		return nil, err
+	}
+	return annotation.NewFalsyPredicateForLookup[T](path, lookup, annotation.Options{Log: log, TrackPaused: true}), nil
 }
@@ -0,0 +1,55 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/operator-framework/operator-lib/internal/annotation"
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+// NewRecreatedPredicate returns a predicate that filters out the Create, Update and Generic events
+// of dependents recreated with the same name but a new UID, until their owner stamps them again, see
+// handler.IsRecreated and handler.StampDependentUID. This prevents reconcilers from acting on stale
+// dependents after a delete and recreate race. Delete events always pass, so that the owner of the
+// original dependent is notified and can adopt or delete the recreated one.
+func NewRecreatedPredicate[T client.Object]() predicate.TypedPredicate[T] {
+	return predicate.TypedFuncs[T]{
+		CreateFunc: func(e event.TypedCreateEvent[T]) bool {
+			return notRecreated(e.Object)
+		},
+		UpdateFunc: func(e event.TypedUpdateEvent[T]) bool {
+			return notRecreated(e.ObjectNew)
+		},
+		DeleteFunc: func(event.TypedDeleteEvent[T]) bool {
+			return true
+		},
+		GenericFunc: func(e event.TypedGenericEvent[T]) bool {
+			return notRecreated(e.Object)
+		},
+	}
+}
+
+func notRecreated(obj client.Object) bool {
+	if obj == nil || !annotation.IsRecreated(obj) {
+		return true
+	}
+	log.V(1).Info("Skipping event of recreated dependent", "namespace", obj.GetNamespace(), "name", obj.GetName(),
+		"uid", obj.GetUID())
+	return drop(libmetrics.DropReasonRecreated)
+}
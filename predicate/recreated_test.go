@@ -0,0 +1,65 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/operator-framework/operator-lib/internal/annotation"
+)
+
+var _ = Describe("RecreatedPredicate", func() {
+	var (
+		pred      predicate.TypedPredicate[client.Object]
+		original  *corev1.ConfigMap
+		recreated *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		pred = NewRecreatedPredicate[client.Object]()
+		original = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "churro",
+			UID:         "uid-1",
+			Annotations: map[string]string{annotation.DependentUID: "uid-1"},
+		}}
+		recreated = original.DeepCopy()
+		recreated.UID = "uid-2"
+	})
+
+	It("should pass events of dependents stamped with their UID", func() {
+		Expect(pred.Create(event.CreateEvent{Object: original})).To(BeTrue())
+		Expect(pred.Update(event.UpdateEvent{ObjectOld: original, ObjectNew: original})).To(BeTrue())
+		Expect(pred.Generic(event.GenericEvent{Object: original})).To(BeTrue())
+	})
+
+	It("should pass events of objects without the annotation", func() {
+		original.Annotations = nil
+		Expect(pred.Create(event.CreateEvent{Object: original})).To(BeTrue())
+	})
+
+	It("should filter events of recreated dependents except deletions", func() {
+		Expect(pred.Create(event.CreateEvent{Object: recreated})).To(BeFalse())
+		Expect(pred.Update(event.UpdateEvent{ObjectOld: recreated, ObjectNew: recreated})).To(BeFalse())
+		Expect(pred.Generic(event.GenericEvent{Object: recreated})).To(BeFalse())
+		Expect(pred.Delete(event.DeleteEvent{Object: recreated})).To(BeTrue())
+	})
+})
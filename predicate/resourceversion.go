@@ -0,0 +1,111 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	"strconv"
+
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+	"github.com/operator-framework/operator-lib/predicate/internal/metrics"
+)
+
+// DefaultMaxTrackedObjects is the number of objects tracked by a resource version
+// predicate when no bound is provided.
+const DefaultMaxTrackedObjects = 4096
+
+// NewResourceVersionPredicate returns a predicate that remembers the last resourceVersion handled
+// for each object and filters out Create and Update events whose resourceVersion is not newer.
+// This protects reconcilers against duplicate deliveries and informer replays after a watch reconnects.
+// Note that periodic resyncs, which deliver Update events with an unchanged resourceVersion,
+// are filtered out as well.
+//
+// At most maxObjects objects are tracked; the least recently seen objects are forgotten first,
+// after which their next event always passes the filter. If maxObjects is not positive,
+// DefaultMaxTrackedObjects is used. Delete events always pass and forget the object.
+//
+// Although the API server documents resourceVersion as opaque, it is compared numerically. Events for
+// objects with a resourceVersion that is not an unsigned integer always pass the filter.
+func NewResourceVersionPredicate[T client.Object](maxObjects int) predicate.TypedPredicate[T] {
+	if maxObjects <= 0 {
+		maxObjects = DefaultMaxTrackedObjects
+	}
+	return &resourceVersionPredicate[T]{seen: lru.New(maxObjects)}
+}
+
+type resourceVersionPredicate[T client.Object] struct {
+	seen *lru.Cache
+}
+
+func (p *resourceVersionPredicate[T]) Create(e event.TypedCreateEvent[T]) bool {
+	var obj client.Object = e.Object
+	if obj == nil {
+		return true
+	}
+	return p.observe(obj, "create")
+}
+
+func (p *resourceVersionPredicate[T]) Update(e event.TypedUpdateEvent[T]) bool {
+	var obj client.Object = e.ObjectNew
+	if obj == nil {
+		return true
+	}
+	return p.observe(obj, "update")
+}
+
+func (p *resourceVersionPredicate[T]) Delete(e event.TypedDeleteEvent[T]) bool {
+	var obj client.Object = e.Object
+	if obj != nil {
+		p.seen.Remove(objectKey(obj))
+	}
+	return true
+}
+
+func (p *resourceVersionPredicate[T]) Generic(event.TypedGenericEvent[T]) bool {
+	return true
+}
+
+// observe records the resourceVersion of obj and returns false if it is not newer than the
+// last recorded one.
+func (p *resourceVersionPredicate[T]) observe(obj client.Object, eventType string) bool {
+	rv, err := strconv.ParseUint(obj.GetResourceVersion(), 10, 64)
+	if err != nil {
+		return true
+	}
+
+	key := objectKey(obj)
+	if last, ok := p.seen.Get(key); ok && rv <= last.(uint64) {
+		log.V(1).Info("Skipping event with stale resourceVersion", "event", eventType,
+			"name", obj.GetName(), "namespace", obj.GetNamespace(),
+			"resourceVersion", rv, "lastResourceVersion", last)
+		metrics.StaleEventsSuppressed.WithLabelValues(eventType).Inc()
+		libmetrics.RecordDroppedEvent(libmetrics.SubsystemPredicate, libmetrics.DropReasonStale)
+		return false
+	}
+
+	p.seen.Add(key, rv)
+	return true
+}
+
+func objectKey(obj client.Object) string {
+	if uid := obj.GetUID(); uid != "" {
+		return string(uid)
+	}
+	return client.ObjectKeyFromObject(obj).String()
+}
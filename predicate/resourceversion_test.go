@@ -0,0 +1,93 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/operator-framework/operator-lib/predicate/internal/metrics"
+)
+
+var _ = Describe("ResourceVersionPredicate", func() {
+	var pred predicate.Predicate
+
+	newPod := func(uid, rv string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:            "churro-" + uid,
+			Namespace:       "default",
+			UID:             types.UID("uid-" + uid),
+			ResourceVersion: rv,
+		}}
+	}
+
+	BeforeEach(func() {
+		pred = NewResourceVersionPredicate[client.Object](2)
+	})
+
+	It("should pass events with a newer resourceVersion", func() {
+		Expect(pred.Create(makeCreateEventFor(newPod("a", "1")))).To(BeTrue())
+		Expect(pred.Update(makeUpdateEventFor(newPod("a", "1"), newPod("a", "2")))).To(BeTrue())
+		Expect(pred.Update(makeUpdateEventFor(newPod("a", "2"), newPod("a", "10")))).To(BeTrue())
+	})
+
+	It("should filter events whose resourceVersion is not newer", func() {
+		before := testutil.ToFloat64(metrics.StaleEventsSuppressed.WithLabelValues("update"))
+
+		Expect(pred.Update(makeUpdateEventFor(newPod("a", "1"), newPod("a", "5")))).To(BeTrue())
+		By("replaying the same event")
+		Expect(pred.Update(makeUpdateEventFor(newPod("a", "1"), newPod("a", "5")))).To(BeFalse())
+		By("delivering an older event")
+		Expect(pred.Update(makeUpdateEventFor(newPod("a", "1"), newPod("a", "3")))).To(BeFalse())
+		By("delivering a duplicate create event")
+		Expect(pred.Create(makeCreateEventFor(newPod("a", "5")))).To(BeFalse())
+
+		Expect(testutil.ToFloat64(metrics.StaleEventsSuppressed.WithLabelValues("update")) - before).To(BeEquivalentTo(2))
+	})
+
+	It("should track objects independently", func() {
+		Expect(pred.Create(makeCreateEventFor(newPod("a", "5")))).To(BeTrue())
+		Expect(pred.Create(makeCreateEventFor(newPod("b", "3")))).To(BeTrue())
+	})
+
+	It("should forget objects when they are deleted", func() {
+		Expect(pred.Create(makeCreateEventFor(newPod("a", "5")))).To(BeTrue())
+		Expect(pred.Delete(makeDeleteEventFor(newPod("a", "5")))).To(BeTrue())
+		Expect(pred.Create(makeCreateEventFor(newPod("a", "5")))).To(BeTrue())
+	})
+
+	It("should forget the least recently seen objects when the bound is reached", func() {
+		Expect(pred.Create(makeCreateEventFor(newPod("a", "5")))).To(BeTrue())
+		Expect(pred.Create(makeCreateEventFor(newPod("b", "5")))).To(BeTrue())
+		Expect(pred.Create(makeCreateEventFor(newPod("c", "5")))).To(BeTrue())
+		Expect(pred.Create(makeCreateEventFor(newPod("a", "5")))).To(BeTrue())
+		Expect(pred.Create(makeCreateEventFor(newPod("c", "5")))).To(BeFalse())
+	})
+
+	It("should pass events with a resourceVersion that is not numeric", func() {
+		Expect(pred.Create(makeCreateEventFor(newPod("a", "abc")))).To(BeTrue())
+		Expect(pred.Create(makeCreateEventFor(newPod("a", "abc")))).To(BeTrue())
+	})
+
+	It("should pass generic events", func() {
+		Expect(pred.Generic(makeGenericEventFor(newPod("a", "1")))).To(BeTrue())
+	})
+})
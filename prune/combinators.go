@@ -0,0 +1,126 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The combinators below compose StrategyFuncs. Each strategy is given its own copy of the
+// resources, so that strategies sorting them do not affect the others, and resources are
+// returned in the order they were given to the combinator, independently of the order of the
+// strategies. Resources are identified by their namespace and name.
+
+// AllOf returns a StrategyFunc that prunes the resources selected by all of strategies, e.g.
+// AllOf(keep at most 10, older than 7 days) only prunes resources beyond the 10 most recent ones
+// that are also older than 7 days. Without strategies, no resources are pruned.
+func AllOf(strategies ...StrategyFunc) StrategyFunc {
+	return func(ctx context.Context, objs []client.Object) ([]client.Object, error) {
+		if len(strategies) == 0 {
+			return nil, nil
+		}
+
+		counts := map[client.ObjectKey]int{}
+		for i, strategy := range strategies {
+			selected, err := runStrategy(ctx, i, strategy, objs)
+			if err != nil {
+				return nil, err
+			}
+			for key := range selected {
+				counts[key]++
+			}
+		}
+		return filterObjects(objs, func(key client.ObjectKey) bool {
+			return counts[key] == len(strategies)
+		}), nil
+	}
+}
+
+// AnyOf returns a StrategyFunc that prunes the resources selected by any of strategies, e.g.
+// AnyOf(keep at most 10, older than 7 days) prunes resources beyond the 10 most recent ones as
+// well as all resources older than 7 days. Without strategies, no resources are pruned.
+func AnyOf(strategies ...StrategyFunc) StrategyFunc {
+	return func(ctx context.Context, objs []client.Object) ([]client.Object, error) {
+		union := map[client.ObjectKey]bool{}
+		for i, strategy := range strategies {
+			selected, err := runStrategy(ctx, i, strategy, objs)
+			if err != nil {
+				return nil, err
+			}
+			for key := range selected {
+				union[key] = true
+			}
+		}
+		return filterObjects(objs, func(key client.ObjectKey) bool {
+			return union[key]
+		}), nil
+	}
+}
+
+// Chain returns a StrategyFunc that gives each of strategies the resources selected by the
+// previous one, and prunes the resources selected by the last one. Unlike AllOf, later strategies
+// only see the resources selected so far, e.g. Chain(older than 7 days, keep at most 10) prunes
+// all but the 10 most recent of the resources older than 7 days. Without strategies, no resources
+// are pruned.
+func Chain(strategies ...StrategyFunc) StrategyFunc {
+	return func(ctx context.Context, objs []client.Object) ([]client.Object, error) {
+		if len(strategies) == 0 {
+			return nil, nil
+		}
+
+		remaining := objs
+		for i, strategy := range strategies {
+			selected, err := runStrategy(ctx, i, strategy, remaining)
+			if err != nil {
+				return nil, err
+			}
+			remaining = filterObjects(remaining, func(key client.ObjectKey) bool {
+				return selected[key]
+			})
+		}
+		return remaining, nil
+	}
+}
+
+// runStrategy runs the i-th strategy of a combinator on a copy of objs and returns the keys of
+// the resources it selected.
+func runStrategy(ctx context.Context, i int, strategy StrategyFunc, objs []client.Object) (map[client.ObjectKey]bool, error) {
+	objsCopy := make([]client.Object, len(objs))
+	copy(objsCopy, objs)
+	selected, err := strategy(ctx, objsCopy)
+	if err != nil {
+		return nil, fmt.Errorf("error running strategy %d: %w", i, err)
+	}
+
+	keys := make(map[client.ObjectKey]bool, len(selected))
+	for _, obj := range selected {
+		keys[client.ObjectKeyFromObject(obj)] = true
+	}
+	return keys, nil
+}
+
+// filterObjects returns the resources of objs whose key is kept, in the order of objs.
+func filterObjects(objs []client.Object, keep func(client.ObjectKey) bool) []client.Object {
+	var kept []client.Object
+	for _, obj := range objs {
+		if keep(client.ObjectKeyFromObject(obj)) {
+			kept = append(kept, obj)
+		}
+	}
+	return kept
+}
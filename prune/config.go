@@ -0,0 +1,336 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	"github.com/operator-framework/operator-lib/conditions"
+)
+
+// RetentionConfigKey is the key of the ConfigMap data holding the RetentionConfig read by a
+// ConfigLoader.
+const RetentionConfigKey = "retention.yaml"
+
+// Reasons of the condition set by a ConfigLoader, see WithConfigCondition.
+const (
+	// RetentionConfigLoadedReason is used when the retention configuration was loaded.
+	RetentionConfigLoadedReason = "RetentionConfigLoaded"
+	// RetentionConfigInvalidReason is used when the retention configuration is invalid.
+	RetentionConfigInvalidReason = "RetentionConfigInvalid"
+)
+
+// RetentionConfig describes the retention policies of an operator, e.g.
+//
+//	rules:
+//	- apiVersion: batch/v1
+//	  kind: Job
+//	  labelSelector: app=backup
+//	  maxCount: 5
+//	- apiVersion: v1
+//	  kind: Pod
+//	  fieldSelector: status.phase=Succeeded
+//	  maxAge: 24h
+type RetentionConfig struct {
+	// Rules are the retention rules, each of which configures a Pruner
+	Rules []RetentionRule `json:"rules"`
+}
+
+// RetentionRule configures a Pruner for a kind of resources.
+type RetentionRule struct {
+	// APIVersion and Kind are the kind of resources to prune
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+
+	// Namespace is the namespace of the resources to prune, all namespaces if empty
+	Namespace string `json:"namespace,omitempty"`
+
+	// LabelSelector and FieldSelector select the resources to prune, see WithLabelSelectorString
+	// and WithFieldSelectorString
+	LabelSelector string `json:"labelSelector,omitempty"`
+	FieldSelector string `json:"fieldSelector,omitempty"`
+
+	// MaxCount, if set, is the number of most recent resources kept
+	MaxCount *int `json:"maxCount,omitempty"`
+
+	// MaxAge, if set, is the age after which resources are pruned. If both MaxCount and MaxAge
+	// are set, resources exceeding either are pruned. If neither is set, the default strategy
+	// registered for the kind is used, see RegisterDefaultStrategy.
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// ParseRetentionConfig parses a RetentionConfig from YAML or JSON data.
+func ParseRetentionConfig(data []byte) (*RetentionConfig, error) {
+	config := &RetentionConfig{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("error parsing retention configuration: %w", err)
+	}
+	return config, nil
+}
+
+// NewPruners returns a Pruner for each rule of the RetentionConfig, configured with opts and the
+// settings of the rule. An error is returned if a rule is invalid.
+func (c *RetentionConfig) NewPruners(prunerClient client.Client, opts ...PrunerOption) ([]*Pruner, error) {
+	pruners := make([]*Pruner, 0, len(c.Rules))
+	for i, rule := range c.Rules {
+		pruner, err := rule.newPruner(prunerClient, opts)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retention rule %d: %w", i, err)
+		}
+		pruners = append(pruners, pruner)
+	}
+	return pruners, nil
+}
+
+func (r RetentionRule) newPruner(prunerClient client.Client, opts []PrunerOption) (*Pruner, error) {
+	gv, err := schema.ParseGroupVersion(r.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid apiVersion %q: %w", r.APIVersion, err)
+	}
+	if r.Kind == "" {
+		return nil, errors.New("kind is required")
+	}
+	gvk := gv.WithKind(r.Kind)
+	if !prunerClient.Scheme().Recognizes(gvk) {
+		return nil, fmt.Errorf("kind %s is not registered in the scheme", gvk)
+	}
+
+	ruleOpts := append([]PrunerOption{}, opts...)
+	ruleOpts = append(ruleOpts, WithNamespace(r.Namespace))
+	if r.LabelSelector != "" {
+		ruleOpts = append(ruleOpts, WithLabelSelectorString(r.LabelSelector))
+	}
+	if r.FieldSelector != "" {
+		ruleOpts = append(ruleOpts, WithFieldSelectorString(r.FieldSelector))
+	}
+
+	selection := Selection{Strategy: "RetentionRule", Parameters: map[string]string{}}
+	var strategies []StrategyFunc
+	if r.MaxCount != nil {
+		if *r.MaxCount < 0 {
+			return nil, fmt.Errorf("maxCount must not be negative, got %d", *r.MaxCount)
+		}
+		strategies = append(strategies, keepNewest(*r.MaxCount))
+		selection.Parameters["maxCount"] = strconv.Itoa(*r.MaxCount)
+	}
+	if r.MaxAge != nil {
+		if r.MaxAge.Duration <= 0 {
+			return nil, fmt.Errorf("maxAge must be positive, got %s", r.MaxAge.Duration)
+		}
+		strategies = append(strategies, NewPruneOlderThan(r.MaxAge.Duration))
+		selection.Parameters["maxAge"] = r.MaxAge.Duration.String()
+	}
+	if len(strategies) > 0 {
+		ruleOpts = append(ruleOpts, WithStrategyV2(StrategyV2WithSelection(AnyOf(strategies...), selection)))
+	}
+
+	return NewPruner(prunerClient, gvk, nil, ruleOpts...)
+}
+
+// ConfigLoader loads the Pruners of an operator from a RetentionConfig stored in a ConfigMap,
+// under the RetentionConfigKey, and reloads them when the ConfigMap changes, so that cluster
+// admins can tune retention without restarting the operator. If the ConfigMap does not exist,
+// nothing is pruned. If it is invalid, the Pruners of the last valid configuration are kept.
+//
+// A ConfigLoader is a reconcile.Reconciler for the ConfigMap, see SetupWithManager, and
+// validation errors can be exposed through a condition, see WithConfigCondition.
+type ConfigLoader struct {
+	client    client.Client
+	key       types.NamespacedName
+	opts      []PrunerOption
+	condition conditions.Condition
+
+	mu      sync.RWMutex
+	pruners []*Pruner
+}
+
+// ConfigLoaderOption configures a ConfigLoader.
+type ConfigLoaderOption func(*ConfigLoader)
+
+// WithConfigCondition sets condition to True with the RetentionConfigLoadedReason when the
+// configuration is loaded, and to False with the RetentionConfigInvalidReason and the
+// validation error when it is invalid.
+func WithConfigCondition(condition conditions.Condition) ConfigLoaderOption {
+	return func(l *ConfigLoader) {
+		l.condition = condition
+	}
+}
+
+// WithConfigPrunerOptions configures all the Pruners loaded by the ConfigLoader with opts, e.g.
+// WithDryRun. The settings of the retention rules take precedence.
+func WithConfigPrunerOptions(opts ...PrunerOption) ConfigLoaderOption {
+	return func(l *ConfigLoader) {
+		l.opts = append(l.opts, opts...)
+	}
+}
+
+// NewConfigLoader returns a ConfigLoader reading the ConfigMap with the given key with
+// prunerClient, which is also used by the loaded Pruners. No configuration is loaded until Load
+// or Reconcile is called.
+func NewConfigLoader(prunerClient client.Client, key types.NamespacedName, opts ...ConfigLoaderOption) *ConfigLoader {
+	l := &ConfigLoader{client: prunerClient, key: key}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Pruners returns the Pruners of the last valid configuration.
+func (l *ConfigLoader) Pruners() []*Pruner {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return append([]*Pruner(nil), l.pruners...)
+}
+
+// Prune runs the Pruners of the last valid configuration, and returns the errors of the runs
+// that failed joined together.
+func (l *ConfigLoader) Prune(ctx context.Context) error {
+	var errs []error
+	for _, pruner := range l.Pruners() {
+		if _, err := pruner.Prune(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("error pruning %s: %w", pruner.GVK(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Load reads and validates the configuration, and replaces the Pruners if it is valid. An error
+// is returned if the configuration is invalid.
+func (l *ConfigLoader) Load(ctx context.Context) error {
+	_, err := l.load(ctx)
+	return err
+}
+
+// Reconcile implements reconcile.Reconciler. It loads the configuration when the ConfigMap
+// changes. Invalid configurations are not retried, since they are reloaded when fixed.
+func (l *ConfigLoader) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	if req.NamespacedName != l.key {
+		return reconcile.Result{}, nil
+	}
+	if invalid, err := l.load(ctx); !invalid {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
+
+// load loads the configuration like Load, and returns true if the only error is that the
+// configuration is invalid.
+func (l *ConfigLoader) load(ctx context.Context) (bool, error) {
+	cm := &corev1.ConfigMap{}
+	err := l.client.Get(ctx, l.key, cm)
+	switch {
+	case apierrors.IsNotFound(err):
+		l.setPruners(nil)
+		return false, l.setCondition(ctx, metav1.ConditionTrue, RetentionConfigLoadedReason,
+			fmt.Sprintf("ConfigMap %s not found, nothing is pruned", l.key))
+	case err != nil:
+		return false, fmt.Errorf("error getting retention configuration: %w", err)
+	}
+
+	pruners, err := l.parse(cm)
+	if err != nil {
+		err = fmt.Errorf("invalid retention configuration: %w", err)
+		log.Error(err, "Keeping the previous retention configuration", "configMap", l.key)
+		if condErr := l.setCondition(ctx, metav1.ConditionFalse, RetentionConfigInvalidReason, err.Error()); condErr != nil {
+			return false, errors.Join(err, condErr)
+		}
+		return true, err
+	}
+
+	l.setPruners(pruners)
+	log.Info("Loaded retention configuration", "configMap", l.key, "rules", len(pruners))
+	return false, l.setCondition(ctx, metav1.ConditionTrue, RetentionConfigLoadedReason,
+		fmt.Sprintf("Loaded %d retention rules from ConfigMap %s", len(pruners), l.key))
+}
+
+// SetupWithManager registers the ConfigLoader with mgr, as a controller watching its ConfigMap.
+// The Pruners still have to be run, e.g. periodically with Prune.
+//
+// The watch goes through the cache of mgr, which caches all the ConfigMaps of the namespaces it
+// watches unless configured otherwise. Operators that do not need other ConfigMaps can restrict
+// the cache to the ConfigMap of the ConfigLoader with ConfigCacheByObject.
+func (l *ConfigLoader) SetupWithManager(mgr manager.Manager) error {
+	isConfigMap := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return client.ObjectKeyFromObject(obj) == l.key
+	})
+	return builder.ControllerManagedBy(mgr).
+		Named("prune-config-loader").
+		For(&corev1.ConfigMap{}, builder.WithPredicates(isConfigMap)).
+		Complete(l)
+}
+
+// ConfigCacheByObject returns the cache configuration restricting the ConfigMaps cached by a
+// manager to the one with key, with field selectors on its name and namespace, e.g.
+//
+//	mgr, err := ctrl.NewManager(cfg, ctrl.Options{Cache: cache.Options{
+//		ByObject: map[client.Object]cache.ByObject{
+//			&corev1.ConfigMap{}: prune.ConfigCacheByObject(key),
+//		},
+//	}})
+//
+// The client of the manager then only reads that ConfigMap from the cache, so this must not be
+// used by operators reading other ConfigMaps through the cache.
+func ConfigCacheByObject(key types.NamespacedName) cache.ByObject {
+	return cache.ByObject{
+		Field: fields.SelectorFromSet(fields.Set{"metadata.name": key.Name, "metadata.namespace": key.Namespace}),
+	}
+}
+
+// parse returns the Pruners configured by cm.
+func (l *ConfigLoader) parse(cm *corev1.ConfigMap) ([]*Pruner, error) {
+	data, ok := cm.Data[RetentionConfigKey]
+	if !ok {
+		return nil, fmt.Errorf("ConfigMap %s has no %s key", l.key, RetentionConfigKey)
+	}
+	config, err := ParseRetentionConfig([]byte(data))
+	if err != nil {
+		return nil, err
+	}
+	return config.NewPruners(l.client, l.opts...)
+}
+
+func (l *ConfigLoader) setPruners(pruners []*Pruner) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pruners = pruners
+}
+
+func (l *ConfigLoader) setCondition(ctx context.Context, status metav1.ConditionStatus, reason, message string) error {
+	if l.condition == nil {
+		return nil
+	}
+	if err := l.condition.Set(ctx, status, conditions.WithReason(reason), conditions.WithMessage(message)); err != nil {
+		return fmt.Errorf("error setting retention configuration condition: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PruneContext describes the configuration of the prune run that invokes a strategy or
+// an IsPrunable function.
+type PruneContext struct {
+	// GVK is the type of objects being pruned
+	GVK schema.GroupVersionKind
+
+	// Namespace is the namespace objects are pruned in, or empty for all namespaces
+	Namespace string
+
+	// ClusterScoped is true if the objects being pruned are cluster-scoped
+	ClusterScoped bool
+
+	// LabelSelector selects the objects considered for pruning
+	LabelSelector labels.Selector
+
+	// FieldSelector selects the objects considered for pruning, if set
+	FieldSelector fields.Selector
+
+	// RunID uniquely identifies the prune run
+	RunID string
+
+	// DryRun is true if objects are not actually deleted, see WithDryRun
+	DryRun bool
+
+	// Clock is the clock that strategies should use to determine the current time, see WithClock
+	Clock clock.PassiveClock
+}
+
+// StrategyResult is returned by a StrategyFuncV2.
+type StrategyResult struct {
+	// Objects are the objects to prune
+	Objects []client.Object
+
+	// NextRun, if positive, is the time after which the next object becomes prunable, e.g. "nothing
+	// to prune now, the next candidate is eligible in 37m". It lets callers schedule the next run
+	// precisely instead of polling on a fixed interval. Zero means that the strategy gives no hint.
+	NextRun time.Duration
+
+	// Selections optionally describe the policy that selected each of the objects to prune, to
+	// explain their deletion. Objects may be missing.
+	Selections map[client.ObjectKey]Selection
+}
+
+// StrategyFuncV2 takes a list of resources and the PruneContext of the run, and returns the subset to prune.
+type StrategyFuncV2 func(ctx context.Context, pctx PruneContext, objs []client.Object) (StrategyResult, error)
+
+// IsPrunableFuncV2 is an IsPrunableFunc that also receives the context and the PruneContext of
+// the run.
+type IsPrunableFuncV2 func(ctx context.Context, pctx PruneContext, obj client.Object) error
+
+// StrategyV2 adapts a StrategyFunc to a StrategyFuncV2. The PruneContext remains available
+// to the StrategyFunc through PruneContextFrom.
+func StrategyV2(strategy StrategyFunc) StrategyFuncV2 {
+	return func(ctx context.Context, pctx PruneContext, objs []client.Object) (StrategyResult, error) {
+		objsToPrune, err := strategy(WithPruneContext(ctx, pctx), objs)
+		return StrategyResult{Objects: objsToPrune}, err
+	}
+}
+
+// IsPrunableV2 adapts an IsPrunableFunc to an IsPrunableFuncV2 that ignores the PruneContext.
+func IsPrunableV2(isPrunable IsPrunableFunc) IsPrunableFuncV2 {
+	return func(_ context.Context, _ PruneContext, obj client.Object) error {
+		return isPrunable(obj)
+	}
+}
+
+type pruneContextKey struct{}
+
+// WithPruneContext returns a copy of ctx carrying pctx.
+func WithPruneContext(ctx context.Context, pctx PruneContext) context.Context {
+	return context.WithValue(ctx, pruneContextKey{}, pctx)
+}
+
+// PruneContextFrom returns the PruneContext carried by ctx, if any. The context passed by a
+// Pruner to a StrategyFunc always carries the PruneContext of the run.
+func PruneContextFrom(ctx context.Context) (PruneContext, bool) {
+	pctx, ok := ctx.Value(pruneContextKey{}).(PruneContext)
+	return pctx, ok
+}
+
+// WithStrategyV2 can be used to set a StrategyFuncV2, replacing the StrategyFunc given to NewPruner.
+func WithStrategyV2(strategy StrategyFuncV2) PrunerOption {
+	return func(p *Pruner) {
+		p.strategy = strategy
+	}
+}
+
+// WithClock can be used to set the clock passed to strategies in the PruneContext, e.g. to test
+// retention policies deterministically. It defaults to the real clock.
+func WithClock(c clock.PassiveClock) PrunerOption {
+	return func(p *Pruner) {
+		p.clock = c
+	}
+}
+
+// WithDryRun can be used to run the Pruner without deleting objects. Deletions are sent to the
+// API server as dry-run requests, and the PruneContext passed to strategies has DryRun set.
+func WithDryRun() PrunerOption {
+	return func(p *Pruner) {
+		p.dryRun = true
+	}
+}
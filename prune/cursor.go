@@ -0,0 +1,233 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CursorStore persists the continue tokens of Pruners listing a page per run, see
+// WithPagePerRun. Tokens are stored by key, one per Pruner.
+type CursorStore interface {
+	// Load returns the token stored for key, or an empty token if there is none.
+	Load(ctx context.Context, key string) (string, error)
+	// Save stores token for key. An empty token removes the token stored for key.
+	Save(ctx context.Context, key, token string) error
+}
+
+// WithPagePerRun lists a single page of resources per run, see WithPageSize, resuming from the
+// continue token that store saved at the end of the previous run. Once the last page was
+// listed, the next run starts over from the first page. This bounds the memory used and the
+// requests sent by each run deterministically for kinds with very many resources, at the cost of
+// taking several runs to go through all of them. The page size defaults to
+// DefaultStreamingPageSize.
+//
+// As with WithStreaming, the strategy only sees the resources of the page listed by the run, and so
+// does the Plan passed to the BeforeRunFuncs, which are called once per run.
+// Continue tokens expire after a few minutes on most API servers, usually before the next run.
+// The position of the last resource listed by a run is therefore stored next to its token, and
+// runs with an expired token list from the first page again, skipping the resources up to that
+// position client-side. Tokens are stored under a key derived from the Pruner's kind, namespace
+// and selectors, so that several Pruners can share a store. WithPagePerRun has no effect on
+// Pruners reading resources from a CandidateIndex.
+func WithPagePerRun(store CursorStore) PrunerOption {
+	return func(p *Pruner) {
+		p.cursors = store
+		if p.pageSize == 0 {
+			p.pageSize = DefaultStreamingPageSize
+		}
+	}
+}
+
+// listResumedPage calls fn with the page of resources following the continue token stored for
+// the Pruner, and stores the continue token of the next page and the position of the last
+// resource of the page once fn succeeded.
+func (p Pruner) listResumedPage(ctx context.Context, listOpts client.ListOptions, fn func(*unstructured.UnstructuredList) error) error {
+	key := p.cursorKey()
+	token, err := p.cursors.Load(ctx, key)
+	if err != nil {
+		return fmt.Errorf("error loading continue token: %w", err)
+	}
+	after, err := p.cursors.Load(ctx, key+cursorPositionSuffix)
+	if err != nil {
+		return fmt.Errorf("error loading list position: %w", err)
+	}
+
+	useFieldSelector := p.fieldSelector != nil && !p.fieldSelector.Empty()
+	listOpts.Limit = p.pageSize
+	listOpts.Continue = token
+	page, err := p.listPage(ctx, listOpts, &useFieldSelector)
+	if token != "" && apierrors.IsResourceExpired(err) {
+		log.V(1).Info("Continue token expired, resuming after the last listed resource", "gvk", p.gvk, "position", after)
+		listOpts.Continue = ""
+		page, err = p.listPageAfter(ctx, listOpts, &useFieldSelector, after)
+	}
+	if err != nil {
+		return fmt.Errorf("error getting a list of resources: %w", err)
+	}
+
+	if err := fn(page); err != nil {
+		return err
+	}
+	if page.GetContinue() == "" {
+		after = ""
+	} else if len(page.Items) > 0 {
+		after = listPosition(&page.Items[len(page.Items)-1])
+	}
+	if err := p.cursors.Save(ctx, key+cursorPositionSuffix, after); err != nil {
+		return fmt.Errorf("error saving list position: %w", err)
+	}
+	if err := p.cursors.Save(ctx, key, page.GetContinue()); err != nil {
+		return fmt.Errorf("error saving continue token: %w", err)
+	}
+	return nil
+}
+
+// listPageAfter returns the first page of resources listed after the position after, skipping
+// the resources up to after client-side.
+func (p Pruner) listPageAfter(ctx context.Context, listOpts client.ListOptions, useFieldSelector *bool, after string) (*unstructured.UnstructuredList, error) {
+	for {
+		page, err := p.listPage(ctx, listOpts, useFieldSelector)
+		if err != nil || after == "" {
+			return page, err
+		}
+
+		items := page.Items[:0]
+		for _, item := range page.Items {
+			if listPosition(&item) > after {
+				items = append(items, item)
+			}
+		}
+		page.Items = items
+		if len(items) > 0 || page.GetContinue() == "" {
+			return page, nil
+		}
+		listOpts.Continue = page.GetContinue()
+	}
+}
+
+// cursorPositionSuffix is appended to the key of the continue token of a Pruner to store the
+// position of the last resource it listed.
+const cursorPositionSuffix = ".position"
+
+// listPosition returns the position of obj in lists, which the API server sorts by namespace
+// and name.
+func listPosition(obj client.Object) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// cursorKey returns the key of the Pruner's continue token in its CursorStore. Keys are valid
+// ConfigMap keys.
+func (p Pruner) cursorKey() string {
+	fieldSelector := ""
+	if p.fieldSelector != nil {
+		fieldSelector = p.fieldSelector.String()
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s|%s", p.gvk, p.namespace, p.LabelSelector(), fieldSelector)))
+	return fmt.Sprintf("%s.%s", p.gvk.Kind, hex.EncodeToString(sum[:8]))
+}
+
+// memoryCursorStore is a CursorStore keeping tokens in memory.
+type memoryCursorStore struct {
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+// NewMemoryCursorStore returns a CursorStore keeping tokens in memory. Tokens are lost when the
+// operator restarts, and the next runs start over from the first page.
+func NewMemoryCursorStore() CursorStore {
+	return &memoryCursorStore{tokens: map[string]string{}}
+}
+
+func (s *memoryCursorStore) Load(_ context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens[key], nil
+}
+
+func (s *memoryCursorStore) Save(_ context.Context, key, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if token == "" {
+		delete(s.tokens, key)
+	} else {
+		s.tokens[key] = token
+	}
+	return nil
+}
+
+// configMapCursorStore is a CursorStore keeping tokens in the data of a ConfigMap.
+type configMapCursorStore struct {
+	client client.Client
+	key    client.ObjectKey
+}
+
+// NewConfigMapCursorStore returns a CursorStore keeping tokens in the data of the ConfigMap
+// identified by key, so that they survive restarts and leader changes. The ConfigMap is created
+// when the first token is saved.
+func NewConfigMapCursorStore(c client.Client, key client.ObjectKey) CursorStore {
+	return &configMapCursorStore{client: c, key: key}
+}
+
+func (s *configMapCursorStore) Load(ctx context.Context, key string) (string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := s.client.Get(ctx, s.key, cm); apierrors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return cm.Data[key], nil
+}
+
+func (s *configMapCursorStore) Save(ctx context.Context, key, token string) error {
+	cm := &corev1.ConfigMap{}
+	err := s.client.Get(ctx, s.key, cm)
+	if apierrors.IsNotFound(err) {
+		if token == "" {
+			return nil
+		}
+		cm.Name, cm.Namespace = s.key.Name, s.key.Namespace
+		cm.Data = map[string]string{key: token}
+		return s.client.Create(ctx, cm)
+	} else if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(cm.DeepCopy())
+	if token == "" {
+		if _, ok := cm.Data[key]; !ok {
+			return nil
+		}
+		delete(cm.Data, key)
+	} else {
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[key] = token
+	}
+	return s.client.Patch(ctx, cm, patch)
+}
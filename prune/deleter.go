@@ -0,0 +1,83 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeleterFunc prunes obj using c. It is called for each object selected by the strategy,
+// and can implement pruning as something other than a deletion, such as scaling a Deployment
+// to zero or archiving a custom resource by setting a field. Errors are handled like errors
+// of deletions: retriable errors are retried, see IsRetriable, and NotFound errors mark the
+// object as already gone.
+type DeleterFunc func(ctx context.Context, c client.Client, obj client.Object) error
+
+// WithDeleter can be used to replace the deletion of the objects selected by the strategy with
+// deleter. It defaults to deleting the objects. When running with WithDryRun, deleter is passed
+// a client that sends all its write requests as dry-run requests. Dependents handled by
+// WithOrphanCleanup are still deleted, except the ones protected by the ProtectAnnotation,
+// which are kept.
+func WithDeleter(deleter DeleterFunc) PrunerOption {
+	return func(p *Pruner) {
+		p.deleter = deleter
+	}
+}
+
+// WithDeleteOptions adds opts to the options of the delete requests sent by the Pruner, for the
+// objects selected by the strategy as well as their dependents. Options are applied in the order
+// they were added, so later options take precedence.
+func WithDeleteOptions(opts ...client.DeleteOption) PrunerOption {
+	return func(p *Pruner) {
+		p.deleteOpts = append(p.deleteOpts, opts...)
+	}
+}
+
+// WithPropagationPolicy sets the propagation policy of the delete requests sent by the Pruner,
+// e.g. metav1.DeletePropagationForeground so that the Pods of pruned Jobs are deleted along
+// with them. The default policy of each kind of resources is used by default.
+func WithPropagationPolicy(policy metav1.DeletionPropagation) PrunerOption {
+	return WithDeleteOptions(client.PropagationPolicy(policy))
+}
+
+// WithGracePeriod sets the grace period of the delete requests sent by the Pruner, rounded down
+// to seconds. A grace period of zero deletes objects immediately. The default grace period of
+// each object is used by default.
+func WithGracePeriod(gracePeriod time.Duration) PrunerOption {
+	return WithDeleteOptions(client.GracePeriodSeconds(int64(gracePeriod / time.Second)))
+}
+
+// deleteObject is the DeleterFunc used by a Pruner unless overridden with WithDeleter.
+func (p Pruner) deleteObject(ctx context.Context, c client.Client, obj client.Object) error {
+	return c.Delete(ctx, obj, p.deleteOptions()...)
+}
+
+// prune prunes obj with the Pruner's DeleterFunc, retrying transient errors.
+func (p Pruner) prune(ctx context.Context, obj client.Object) error {
+	if p.deleter == nil {
+		return p.deleteWithRetry(ctx, obj)
+	}
+	c := p.client
+	if p.dryRun {
+		c = client.NewDryRunClient(c)
+	}
+	return p.retry(ctx, obj, func(ctx context.Context) error {
+		return p.deleter(ctx, c, obj)
+	})
+}
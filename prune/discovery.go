@@ -0,0 +1,129 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DiscoverGVKs returns the kinds of resources served by the cluster that have objects matching
+// selector in namespace, or in all namespaces if namespace is empty. Only the preferred version
+// of resources that can be listed and deleted is considered. Objects are listed with reader as
+// metadata only, one per kind, so that discovery stays cheap even for large clusters. reader
+// should not read from a cache, e.g. it should be the manager's API reader rather than its
+// client, since a cached reader starts a metadata informer for every kind served by the cluster.
+//
+// Groups that cannot be discovered, e.g. because an aggregated API server is unavailable, are
+// logged and skipped, and so are kinds that cannot be listed, e.g. because the operator is not
+// allowed to list them.
+func DiscoverGVKs(ctx context.Context, disc discovery.DiscoveryInterface, reader client.Reader, namespace string, selector labels.Selector) ([]schema.GroupVersionKind, error) {
+	resourceLists, err := discovery.ServerPreferredResources(disc)
+	if discovery.IsGroupDiscoveryFailedError(err) {
+		log.Error(err, "Skipping API groups that could not be discovered")
+	} else if err != nil {
+		return nil, fmt.Errorf("error discovering resources: %w", err)
+	}
+	resourceLists = discovery.FilteredBy(discovery.SupportsAllVerbs{Verbs: []string{"list", "delete"}}, resourceLists)
+
+	var gvks []schema.GroupVersionKind
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing group version %q: %w", resourceList.GroupVersion, err)
+		}
+		for _, resource := range resourceList.APIResources {
+			// subresources are served with the objects of their parent resource
+			if strings.Contains(resource.Name, "/") || (namespace != "" && !resource.Namespaced) {
+				continue
+			}
+			gvk := gv.WithKind(resource.Kind)
+			found, err := hasMatchingObjects(ctx, reader, gvk, namespace, selector)
+			if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) || apierrors.IsMethodNotSupported(err) {
+				log.Info("Skipping kind that cannot be listed", "gvk", gvk, "error", err.Error())
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				gvks = append(gvks, gvk)
+			}
+		}
+	}
+
+	return gvks, nil
+}
+
+// NewDiscoveredPruners returns a Pruner for each kind of resources that has objects matching
+// selector in namespace, as found by DiscoverGVKs. It lets operators that label all their
+// dependents alike apply a single retention pass to all of them. The Pruners select objects
+// with namespace and selector, use strategy and are configured with opts.
+//
+// If strategy is nil, the default strategy registered for each kind is used, see
+// RegisterDefaultStrategy, and kinds without a default strategy are skipped. Kinds that are
+// not registered in the scheme of prunerClient are skipped as well. Kinds are discovered with
+// prunerClient as reader, which should therefore not read from a cache, see DiscoverGVKs.
+func NewDiscoveredPruners(ctx context.Context, prunerClient client.Client, disc discovery.DiscoveryInterface, namespace string, selector labels.Selector, strategy StrategyFunc, opts ...PrunerOption) ([]*Pruner, error) {
+	gvks, err := DiscoverGVKs(ctx, disc, prunerClient, namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append([]PrunerOption{WithNamespace(namespace), WithLabelSelector(selector)}, opts...)
+	pruners := make([]*Pruner, 0, len(gvks))
+	for _, gvk := range gvks {
+		if !prunerClient.Scheme().Recognizes(gvk) {
+			log.V(1).Info("Skipping discovered kind not registered in the scheme", "gvk", gvk)
+			continue
+		}
+		if strategy == nil {
+			if _, ok := defaultRegistry.DefaultStrategy(gvk); !ok {
+				log.V(1).Info("Skipping discovered kind without a default strategy", "gvk", gvk)
+				continue
+			}
+		}
+		pruner, err := NewPruner(prunerClient, gvk, strategy, opts...)
+		if err != nil {
+			return nil, err
+		}
+		pruners = append(pruners, pruner)
+	}
+
+	return pruners, nil
+}
+
+// hasMatchingObjects returns true if there is at least one object of the given kind matching
+// selector in namespace.
+func hasMatchingObjects(ctx context.Context, reader client.Reader, gvk schema.GroupVersionKind, namespace string, selector labels.Selector) (bool, error) {
+	list := &metav1.PartialObjectMetadataList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	opts := []client.ListOption{client.InNamespace(namespace), client.Limit(1)}
+	if selector != nil {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: selector})
+	}
+	if err := reader.List(ctx, list, opts...); err != nil {
+		return false, fmt.Errorf("error listing %s: %w", gvk, err)
+	}
+	return len(list.Items) > 0, nil
+}
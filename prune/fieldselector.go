@@ -0,0 +1,178 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WithFieldSelector can be used to set a field selector, such as `status.phase=Succeeded` for Pods,
+// that is passed to the API server when listing resources to prune. This avoids retrieving
+// resources that can not be pruned. If the API server rejects the selector because the kind
+// does not support it, the resources are listed without it and the selector is evaluated
+// client-side instead.
+func WithFieldSelector(selector fields.Selector) PrunerOption {
+	return func(p *Pruner) {
+		p.fieldSelector = selector
+	}
+}
+
+// WithFieldSelectorString is like WithFieldSelector, with a selector parsed by
+// fields.ParseSelector, ex. "status.phase=Succeeded". NewPruner returns an error if the selector
+// is invalid.
+func WithFieldSelectorString(selector string) PrunerOption {
+	return func(p *Pruner) {
+		parsed, err := fields.ParseSelector(selector)
+		if err != nil {
+			p.err = fmt.Errorf("error when creating a new Pruner: invalid field selector %q: %w", selector, err)
+			return
+		}
+		p.fieldSelector = parsed
+	}
+}
+
+// FieldSelector returns the field selector that the Pruner is using to find resources to prune
+func (p Pruner) FieldSelector() fields.Selector {
+	return p.fieldSelector
+}
+
+// list returns the resources matching the Pruner's namespace, labels and field selector.
+func (p Pruner) list(ctx context.Context, listOpts client.ListOptions) (*unstructured.UnstructuredList, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(p.gvk)
+	err := p.listPages(ctx, listOpts, func(page *unstructured.UnstructuredList) error {
+		list.Items = append(list.Items, page.Items...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// listPages calls fn with each page of the resources matching the Pruner's namespace, labels and
+// field selector, see WithPageSize. Without a page size, or with a candidate index, all resources
+// are passed in a single page. With WithPagePerRun, only the page following the stored continue
+// token is passed. Errors returned by fn are returned unchanged.
+func (p Pruner) listPages(ctx context.Context, listOpts client.ListOptions, fn func(*unstructured.UnstructuredList) error) error {
+	useFieldSelector := p.fieldSelector != nil && !p.fieldSelector.Empty()
+
+	if p.index != nil {
+		if p.index.GVK() != p.gvk {
+			return fmt.Errorf("candidate index is for %s, not %s", p.index.GVK(), p.gvk)
+		}
+		list, err := p.index.list(ctx, listOpts.Namespace, listOpts.LabelSelector, p.newObject)
+		if err == nil && useFieldSelector {
+			list, err = filterByFieldSelector(list, p.fieldSelector)
+		}
+		if err != nil {
+			return fmt.Errorf("error getting a list of resources: %w", err)
+		}
+		return fn(list)
+	}
+	if p.cursors != nil {
+		return p.listResumedPage(ctx, listOpts, fn)
+	}
+
+	listOpts.Limit = p.pageSize
+	for {
+		page, err := p.listPage(ctx, listOpts, &useFieldSelector)
+		if err != nil {
+			return fmt.Errorf("error getting a list of resources: %w", err)
+		}
+		if err := fn(page); err != nil {
+			return err
+		}
+		if page.GetContinue() == "" {
+			return nil
+		}
+		listOpts.Continue = page.GetContinue()
+	}
+}
+
+// listPage lists a page of resources. If the API server does not support the Pruner's field
+// selector, the resources are filtered client-side, and useFieldSelector is set to false for the
+// following pages.
+func (p Pruner) listPage(ctx context.Context, listOpts client.ListOptions, useFieldSelector *bool) (*unstructured.UnstructuredList, error) {
+	if *useFieldSelector {
+		listOpts.FieldSelector = p.fieldSelector
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(p.gvk)
+	if err := p.client.List(ctx, list, &listOpts); err != nil {
+		if !*useFieldSelector || !apierrors.IsBadRequest(err) {
+			return nil, err
+		}
+
+		// The field selector is not supported for this kind, filter the resources client-side.
+		log.V(1).Info("Field selector not supported by the API server, filtering client-side",
+			"gvk", p.gvk, "fieldSelector", p.fieldSelector.String(), "error", err.Error())
+		*useFieldSelector = false
+		listOpts.FieldSelector = nil
+		list = &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(p.gvk)
+		if err := p.client.List(ctx, list, &listOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	if *useFieldSelector || p.fieldSelector == nil || p.fieldSelector.Empty() {
+		return list, nil
+	}
+	return filterByFieldSelector(list, p.fieldSelector)
+}
+
+// filterByFieldSelector removes the items of list that do not match selector.
+func filterByFieldSelector(list *unstructured.UnstructuredList, selector fields.Selector) (*unstructured.UnstructuredList, error) {
+	items := list.Items[:0]
+	for i := range list.Items {
+		matches, err := matchesFieldSelector(&list.Items[i], selector)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			items = append(items, list.Items[i])
+		}
+	}
+	list.Items = items
+
+	return list, nil
+}
+
+// matchesFieldSelector evaluates the field selector against the fields of the given object.
+// Fields that are missing from the object are treated as empty strings.
+func matchesFieldSelector(obj *unstructured.Unstructured, selector fields.Selector) (bool, error) {
+	set := fields.Set{}
+	for _, req := range selector.Requirements() {
+		value, _, err := unstructured.NestedFieldNoCopy(obj.Object, strings.Split(req.Field, ".")...)
+		if err != nil {
+			return false, fmt.Errorf("error evaluating field selector %q: %w", req.Field, err)
+		}
+		if value != nil {
+			set[req.Field] = fmt.Sprint(value)
+		} else {
+			set[req.Field] = ""
+		}
+	}
+	return selector.Matches(set), nil
+}
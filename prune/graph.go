@@ -0,0 +1,232 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Relationship defines how the dependents of a PruneGraphEdge are linked to their owner.
+type Relationship string
+
+const (
+	// RelationshipOwnerReference links dependents that have an ownerReference to their owner.
+	RelationshipOwnerReference Relationship = "OwnerReference"
+	// RelationshipAnnotation links dependents whose owner annotations of the handler package,
+	// see handler.SetOwnerAnnotations, identify their owner.
+	RelationshipAnnotation Relationship = "Annotation"
+	// RelationshipLabel links dependents that have a label, see PruneGraphEdge.Label, set to
+	// the name of their owner.
+	RelationshipLabel Relationship = "Label"
+)
+
+// PruneOrder defines the order in which an object and its dependents are pruned.
+type PruneOrder string
+
+const (
+	// DependentsFirst prunes the dependents of an object before the object. If a dependent
+	// cannot be pruned, the object is kept, so that the whole group is pruned in a later run.
+	DependentsFirst PruneOrder = "DependentsFirst"
+	// OwnerFirst prunes an object before its dependents.
+	OwnerFirst PruneOrder = "OwnerFirst"
+)
+
+// PruneGraphEdge describes a kind of dependents of the objects of its parent in a PruneGraph.
+type PruneGraphEdge struct {
+	// GVK is the kind of the dependents.
+	GVK schema.GroupVersionKind
+	// Relationship defines how the dependents are linked to their owner.
+	Relationship Relationship
+	// Label is the key of the label set to the name of the owner, for RelationshipLabel.
+	Label string
+	// Dependents are the kinds of dependents of the dependents.
+	Dependents []PruneGraphEdge
+}
+
+// PruneGraph describes the dependents of the objects pruned by a Pruner, which are pruned
+// along with them as a group, see WithPruneGraph.
+type PruneGraph struct {
+	// Dependents are the kinds of dependents of the pruned objects.
+	Dependents []PruneGraphEdge
+	// Order is the order in which objects and their dependents are pruned, DependentsFirst if
+	// empty.
+	Order PruneOrder
+}
+
+// errDependentsRemain is returned when an object is not pruned because some of its dependents
+// were not.
+var errDependentsRemain = errors.New("dependents could not be pruned")
+
+// WithPruneGraph prunes the dependents described by graph along with each object selected by
+// the strategy, such as the Jobs and ConfigMaps created for each run of a custom resource.
+// Dependents are looked up in the namespace of their owner, or in the Pruner's namespace for
+// cluster-scoped owners, and recorded in Result.Dependents when pruned.
+//
+// Dependents are selected regardless of the strategy and IsPrunableFuncs, except for dependents
+// protected by the ProtectAnnotation, which are kept. With the DependentsFirst order, an object
+// is only pruned once all its dependents were, so that a group is never left half-pruned.
+func WithPruneGraph(graph PruneGraph) PrunerOption {
+	return func(p *Pruner) {
+		if graph.Order != "" && graph.Order != DependentsFirst && graph.Order != OwnerFirst {
+			p.err = fmt.Errorf("error when creating a new Pruner: unknown prune order %q", graph.Order)
+			return
+		}
+		if err := validateEdges(graph.Dependents); err != nil {
+			p.err = fmt.Errorf("error when creating a new Pruner: %w", err)
+			return
+		}
+		p.graph = &graph
+	}
+}
+
+func validateEdges(edges []PruneGraphEdge) error {
+	for _, edge := range edges {
+		switch edge.Relationship {
+		case RelationshipOwnerReference, RelationshipAnnotation:
+		case RelationshipLabel:
+			if edge.Label == "" {
+				return fmt.Errorf("dependents of kind %s are linked by a label but no label is set", edge.GVK)
+			}
+		default:
+			return fmt.Errorf("dependents of kind %s have unknown relationship %q", edge.GVK, edge.Relationship)
+		}
+		if err := validateEdges(edge.Dependents); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneGroup prunes obj along with its dependents in the Pruner's PruneGraph, if any, and
+// returns the error of the pruning of obj. Dependents are recorded in result.
+func (p Pruner) pruneGroup(ctx context.Context, obj client.Object, result *Result) error {
+	if p.graph == nil {
+		return p.prune(ctx, obj)
+	}
+
+	if p.graph.Order == OwnerFirst {
+		err := p.prune(ctx, obj)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if _, depErr := p.pruneDependents(ctx, obj, p.graph.Dependents, OwnerFirst, result); depErr != nil {
+			return depErr
+		}
+		return err
+	}
+
+	complete, err := p.pruneDependents(ctx, obj, p.graph.Dependents, DependentsFirst, result)
+	if err != nil {
+		return err
+	}
+	if !complete {
+		return errDependentsRemain
+	}
+	return p.prune(ctx, obj)
+}
+
+// pruneDependents prunes the dependents of owner described by edges, in the given order. It
+// returns false if some dependents were kept. Errors that are not retriable are returned.
+func (p Pruner) pruneDependents(ctx context.Context, owner client.Object, edges []PruneGraphEdge, order PruneOrder, result *Result) (bool, error) {
+	namespace := owner.GetNamespace()
+	if namespace == "" {
+		namespace = p.namespace
+	}
+
+	complete := true
+	for _, edge := range edges {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(edge.GVK)
+		opts := []client.ListOption{client.InNamespace(namespace)}
+		if edge.Relationship == RelationshipLabel {
+			opts = append(opts, client.MatchingLabels{edge.Label: owner.GetName()})
+		}
+		if err := p.client.List(ctx, list, opts...); err != nil {
+			return false, fmt.Errorf("error listing dependents of kind %s: %w", edge.GVK, err)
+		}
+
+		for i := range list.Items {
+			dependent := &list.Items[i]
+			if !edge.links(dependent, owner) {
+				continue
+			}
+			if err := checkProtected(dependent); err != nil {
+				log.V(1).Info("Keeping protected dependent", "object", client.ObjectKeyFromObject(dependent),
+					"owner", client.ObjectKeyFromObject(owner))
+				complete = false
+				continue
+			}
+
+			if order == DependentsFirst {
+				ok, err := p.pruneDependents(ctx, dependent, edge.Dependents, order, result)
+				if err != nil {
+					return false, err
+				}
+				if !ok {
+					complete = false
+					continue
+				}
+			}
+
+			err := p.prune(ctx, dependent)
+			switch {
+			case err == nil:
+				result.Dependents = append(result.Dependents, dependent)
+			case apierrors.IsNotFound(err):
+			case IsRetriable(err):
+				log.Error(err, "Giving up on pruning dependent", "object", client.ObjectKeyFromObject(dependent))
+				result.Failed = append(result.Failed, FailedDeletion{Obj: dependent, Err: err})
+				complete = false
+				continue
+			default:
+				return false, fmt.Errorf("error pruning dependent %s: %w", client.ObjectKeyFromObject(dependent), err)
+			}
+
+			if order == OwnerFirst {
+				if _, err := p.pruneDependents(ctx, dependent, edge.Dependents, order, result); err != nil {
+					return false, err
+				}
+			}
+		}
+	}
+
+	return complete, nil
+}
+
+// links returns true if dependent is linked to owner according to the edge's relationship.
+func (edge PruneGraphEdge) links(dependent, owner client.Object) bool {
+	switch edge.Relationship {
+	case RelationshipOwnerReference:
+		for _, ref := range dependent.GetOwnerReferences() {
+			if ownerRefMatches(ref, owner) {
+				return true
+			}
+		}
+		return false
+	case RelationshipAnnotation:
+		return annotationsMatch(dependent.GetAnnotations(), owner)
+	case RelationshipLabel:
+		return dependent.GetLabels()[edge.Label] == owner.GetName()
+	default:
+		return false
+	}
+}
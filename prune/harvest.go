@@ -0,0 +1,78 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HarvestedAnnotation is set by MarkHarvested on objects, typically Jobs or Pods, whose logs have
+// been captured, to the time they were captured in RFC 3339 format. It lets a log harvester and a
+// Pruner coordinate, so that objects are not pruned before their logs are harvested, see
+// RequireHarvested.
+const HarvestedAnnotation = "operator-lib.operatorframework.io/logs-harvested"
+
+// MarkHarvested sets the HarvestedAnnotation on obj, so that Pruners using RequireHarvested may
+// prune it. It is called by log harvesters once the logs of obj have been captured. Objects that
+// are already marked are left unchanged.
+func MarkHarvested(ctx context.Context, c client.Client, obj client.Object) error {
+	if IsHarvested(obj) {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[HarvestedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	obj.SetAnnotations(annotations)
+	if err := c.Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("error marking object as harvested: %w", err)
+	}
+	log.V(1).Info("Marked object as harvested", "object", client.ObjectKeyFromObject(obj))
+	return nil
+}
+
+// IsHarvested returns true if obj has the HarvestedAnnotation.
+func IsHarvested(obj client.Object) bool {
+	_, ok := obj.GetAnnotations()[HarvestedAnnotation]
+	return ok
+}
+
+// RequireHarvested returns an IsPrunableFunc that marks objects without the HarvestedAnnotation
+// as Unprunable, and defers to isPrunable, if not nil, for the others. Registering it for the
+// kinds whose logs are harvested ensures that the Pruner never deletes an object whose logs have
+// not been captured yet, e.g.
+//
+//	prune.RegisterIsPrunableFunc(jobGVK, prune.RequireHarvested(prune.DefaultJobIsPrunable))
+func RequireHarvested(isPrunable IsPrunableFunc) IsPrunableFunc {
+	return func(obj client.Object) error {
+		if !IsHarvested(obj) {
+			return &Unprunable{
+				Obj:    &obj,
+				Reason: "logs have not been harvested",
+			}
+		}
+		if isPrunable == nil {
+			return nil
+		}
+		return isPrunable(obj)
+	}
+}
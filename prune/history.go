@@ -0,0 +1,196 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DefaultHistorySize is the number of runs a History records when no size is provided.
+const DefaultHistorySize = 10
+
+// RunRecord describes a prune run recorded in a History.
+type RunRecord struct {
+	// APIVersion and Kind identify the kind of resources pruned by the run
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Start is the time at which the run started
+	Start time.Time `json:"start"`
+	// Duration is the duration of the run
+	Duration time.Duration `json:"duration"`
+	// DryRun is true if deletions were only simulated, see WithDryRun
+	DryRun bool `json:"dryRun,omitempty"`
+	// Pruned is the number of objects that were pruned
+	Pruned int `json:"pruned"`
+	// Failed is the number of objects that could not be deleted, see Result.Failed
+	Failed int `json:"failed"`
+	// Dependents is the number of dependents pruned along with the objects, see Result.Dependents
+	Dependents int `json:"dependents,omitempty"`
+	// Orphans is the number of orphaned dependents cleaned up, see Result.Orphans
+	Orphans int `json:"orphans,omitempty"`
+	// Error is the error that aborted the run, if any
+	Error string `json:"error,omitempty"`
+}
+
+// History records the last runs of a Pruner in memory, see WithHistory, so that operators can
+// expose their retention history, e.g. in the status of a custom resource or on a debug endpoint,
+// without persisting it. A History can be served as JSON, since it implements http.Handler and
+// json.Marshaler, and exports the last run of each kind as metrics, since it implements
+// prometheus.Collector. It is safe for concurrent use.
+type History struct {
+	mu      sync.Mutex
+	records []RunRecord
+	next    int
+	full    bool
+}
+
+// NewHistory returns a History recording the last size runs, DefaultHistorySize if size is not
+// positive.
+func NewHistory(size int) *History {
+	if size <= 0 {
+		size = DefaultHistorySize
+	}
+	return &History{records: make([]RunRecord, size)}
+}
+
+// WithHistory records the runs of the Pruner in history. A History can be shared by several
+// Pruners, e.g. when passed to NewMultiPruner, NewMultiClusterPruner or NewDiscoveredPruners:
+// each record names the kind it pruned, and the oldest records are dropped first whatever their
+// kind, so the size of a shared History should account for the number of Pruners. Runs of the
+// same kind in several clusters are not told apart.
+func WithHistory(history *History) PrunerOption {
+	return func(p *Pruner) {
+		p.history = history
+	}
+}
+
+// History returns the History of the Pruner, if any, see WithHistory.
+func (p Pruner) History() *History {
+	return p.history
+}
+
+// Records returns the recorded runs, oldest first.
+func (h *History) Records() []RunRecord {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.full {
+		return append([]RunRecord(nil), h.records[:h.next]...)
+	}
+	records := make([]RunRecord, 0, len(h.records))
+	records = append(records, h.records[h.next:]...)
+	return append(records, h.records[:h.next]...)
+}
+
+// Last returns the most recent run, and false if no run was recorded.
+func (h *History) Last() (RunRecord, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.full && h.next == 0 {
+		return RunRecord{}, false
+	}
+	return h.records[(h.next+len(h.records)-1)%len(h.records)], true
+}
+
+// MarshalJSON implements json.Marshaler. The recorded runs are marshaled oldest first.
+func (h *History) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.Records())
+}
+
+// ServeHTTP implements http.Handler. It serves the recorded runs as JSON, oldest first.
+func (h *History) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	data, err := h.MarshalJSON()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+var (
+	historyLabels = []string{"group", "version", "kind"}
+
+	historyLastRunDesc = prometheus.NewDesc("operator_lib_prune_last_run_timestamp_seconds",
+		"Time at which the last prune run started, in seconds since the Unix epoch", historyLabels, nil)
+	historyLastRunDurationDesc = prometheus.NewDesc("operator_lib_prune_last_run_duration_seconds",
+		"Duration of the last prune run", historyLabels, nil)
+	historyLastRunPrunedDesc = prometheus.NewDesc("operator_lib_prune_last_run_pruned_objects",
+		"Number of objects pruned by the last prune run", historyLabels, nil)
+	historyLastRunFailedDesc = prometheus.NewDesc("operator_lib_prune_last_run_failed_objects",
+		"Number of objects that could not be pruned by the last prune run", historyLabels, nil)
+	historyLastRunErrorDesc = prometheus.NewDesc("operator_lib_prune_last_run_error",
+		"1 if the last prune run was aborted by an error, 0 otherwise", historyLabels, nil)
+)
+
+// Describe implements prometheus.Collector. A History is an unchecked collector: it describes no
+// metrics, so that several Histories can be registered with the same registry.
+func (h *History) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector. The last run of each recorded kind is collected, and
+// nothing is collected until a run is recorded.
+func (h *History) Collect(ch chan<- prometheus.Metric) {
+	records := h.Records()
+	collected := map[schema.GroupVersionKind]bool{}
+	for i := len(records) - 1; i >= 0; i-- {
+		last := records[i]
+		gvk := schema.FromAPIVersionAndKind(last.APIVersion, last.Kind)
+		if collected[gvk] {
+			continue
+		}
+		collected[gvk] = true
+
+		aborted := 0.0
+		if last.Error != "" {
+			aborted = 1
+		}
+		labels := []string{gvk.Group, gvk.Version, gvk.Kind}
+		ch <- prometheus.MustNewConstMetric(historyLastRunDesc, prometheus.GaugeValue, float64(last.Start.UnixNano())/1e9, labels...)
+		ch <- prometheus.MustNewConstMetric(historyLastRunDurationDesc, prometheus.GaugeValue, last.Duration.Seconds(), labels...)
+		ch <- prometheus.MustNewConstMetric(historyLastRunPrunedDesc, prometheus.GaugeValue, float64(last.Pruned), labels...)
+		ch <- prometheus.MustNewConstMetric(historyLastRunFailedDesc, prometheus.GaugeValue, float64(last.Failed), labels...)
+		ch <- prometheus.MustNewConstMetric(historyLastRunErrorDesc, prometheus.GaugeValue, aborted, labels...)
+	}
+}
+
+// record records a run of a Pruner of gvk that started at start and returned result and err.
+func (h *History) record(gvk schema.GroupVersionKind, start time.Time, duration time.Duration, dryRun bool, result *Result, err error) {
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+	record := RunRecord{APIVersion: apiVersion, Kind: kind, Start: start, Duration: duration, DryRun: dryRun}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	if result != nil {
+		record.Pruned = len(result.Pruned)
+		record.Failed = len(result.Failed)
+		record.Dependents = len(result.Dependents)
+		record.Orphans = len(result.Orphans)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records[h.next] = record
+	h.next = (h.next + 1) % len(h.records)
+	if h.next == 0 {
+		h.full = true
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+// ErrRunVetoed is wrapped by the error returned by a Pruner whose run was vetoed by a
+// BeforeRunFunc.
+var ErrRunVetoed = errors.New("prune run vetoed")
+
+// ErrSkipDeletion is returned by a PreDeleteHookFunc to keep an object instead of pruning it.
+var ErrSkipDeletion = errors.New("deletion skipped by pre-delete hook")
+
+// Plan describes the objects a prune run is about to prune.
+type Plan struct {
+	PruneContext
+
+	// Objects are the objects selected by the strategy, in the order in which they are pruned.
+	// It may be empty.
+	Objects []client.Object
+}
+
+// BeforeRunFunc is called with the Plan of a prune run before any object is pruned, e.g. to take a
+// backup of the objects about to be deleted. Returning an error vetoes the run.
+type BeforeRunFunc func(ctx context.Context, plan Plan) error
+
+// AfterRunFunc is called with the Result of a completed prune run, e.g. to publish a summary.
+type AfterRunFunc func(ctx context.Context, result *Result)
+
+// PreDeleteHookFunc is called with each object selected for pruning before it is deleted, e.g. to
+// remove the finalizers of the object, emit an event or archive the object. Returning
+// ErrSkipDeletion keeps the object, see Result.Skipped, and returning any other error aborts the
+// run. The PruneContext of the run, including whether it is a dry run, is available through
+// PruneContextFrom.
+type PreDeleteHookFunc func(ctx context.Context, obj client.Object) error
+
+// PostDeleteHookFunc is called with each object after it was pruned. Errors are logged and do not
+// abort the run, since the object is already deleted.
+type PostDeleteHookFunc func(ctx context.Context, obj client.Object) error
+
+// WithBeforeRun adds hook to the functions called before each run prunes objects. Hooks are
+// called in the order they were added. If a hook returns an error, no object is pruned and the
+// run returns an error wrapping ErrRunVetoed and the error of the hook. WithBeforeRun can not be
+// used with WithStreaming.
+func WithBeforeRun(hook BeforeRunFunc) PrunerOption {
+	return func(p *Pruner) {
+		p.beforeRun = append(p.beforeRun, hook)
+	}
+}
+
+// WithAfterRun adds hook to the functions called after each run that completed, including runs
+// that failed to delete some objects, see Result.Failed. Hooks are called in the order they were
+// added. Runs aborted by an error do not call them.
+func WithAfterRun(hook AfterRunFunc) PrunerOption {
+	return func(p *Pruner) {
+		p.afterRun = append(p.afterRun, hook)
+	}
+}
+
+// WithPreDeleteHook adds hook to the functions called before each object is pruned. Hooks are
+// called in the order they were added, until one of them returns an error.
+func WithPreDeleteHook(hook PreDeleteHookFunc) PrunerOption {
+	return func(p *Pruner) {
+		p.preDelete = append(p.preDelete, hook)
+	}
+}
+
+// WithPostDeleteHook adds hook to the functions called after each object is pruned. Hooks are
+// called in the order they were added. Objects that were already gone when they were deleted, see
+// Result.AlreadyGone, do not call them.
+func WithPostDeleteHook(hook PostDeleteHookFunc) PrunerOption {
+	return func(p *Pruner) {
+		p.postDelete = append(p.postDelete, hook)
+	}
+}
+
+// runPreDeleteHooks calls the pre-delete hooks with obj, and returns false if obj must be kept.
+func (p Pruner) runPreDeleteHooks(ctx context.Context, obj client.Object) (bool, error) {
+	for _, hook := range p.preDelete {
+		err := hook(ctx, obj)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrSkipDeletion):
+			log.V(1).Info("Keeping object skipped by a pre-delete hook", "object", client.ObjectKeyFromObject(obj))
+			return false, nil
+		default:
+			return false, fmt.Errorf("error running pre-delete hook for %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+	}
+	return true, nil
+}
+
+// runPostDeleteHooks calls the post-delete hooks with obj, logging their errors.
+func (p Pruner) runPostDeleteHooks(ctx context.Context, obj client.Object) {
+	for _, hook := range p.postDelete {
+		if err := hook(ctx, obj); err != nil {
+			log.Error(err, "Post-delete hook failed", "object", client.ObjectKeyFromObject(obj))
+			metrics.RecordError(metrics.SubsystemPrune, "post_delete_hook_failed")
+		}
+	}
+}
@@ -0,0 +1,313 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+// CandidateIndex keeps track of the prune candidates of a single GVK, grouped by namespace, from
+// the events of a shared informer. A Pruner configured with WithCandidateIndex reads its
+// candidates from the index instead of listing them from the API server on every run, and
+// verifies each object selected by the strategy with a GET before deleting it.
+//
+// The index only holds the key, UID, creation timestamp and labels of each object. The objects
+// themselves are read from the cache on demand, when a Pruner runs, so that the index does not
+// hold copies of the objects already held by the informer.
+type CandidateIndex struct {
+	gvk      schema.GroupVersionKind
+	informer cache.Informer
+	reader   client.Reader
+
+	// maxCandidates, if positive, is the number of oldest candidates returned per run
+	maxCandidates int
+
+	mu sync.RWMutex
+	// entries holds the candidates per namespace, keyed by name
+	entries map[string]map[string]*candidateEntry
+}
+
+// candidateEntry is an object tracked by a CandidateIndex.
+type candidateEntry struct {
+	key     types.NamespacedName
+	uid     types.UID
+	created metav1.Time
+	labels  labels.Set
+}
+
+// CandidateIndexOption configures a CandidateIndex.
+type CandidateIndexOption func(*CandidateIndex)
+
+// WithMaxCandidates bounds the number of candidates read from the cache on each run to the n
+// oldest objects matching the Pruner's namespace and selectors, so that the memory used by a run
+// does not grow with the number of objects. Strategies comparing objects, such as
+// NewPruneByCountStrategy, then only see these n objects, so WithMaxCandidates is best suited to
+// strategies deciding for each object independently, such as NewPruneOlderThan.
+func WithMaxCandidates(n int) CandidateIndexOption {
+	return func(idx *CandidateIndex) {
+		idx.maxCandidates = n
+	}
+}
+
+// NewCandidateIndex returns a CandidateIndex for objects of the given GVK, fed by the informer
+// that c returns for it, e.g. the manager's cache, from which the objects are read when a Pruner
+// runs. The informer is shared with other users of c, so no additional watch is created if one
+// already exists. The GVK must be registered in the scheme of the Pruners using the index.
+func NewCandidateIndex(ctx context.Context, c cache.Cache, gvk schema.GroupVersionKind, opts ...CandidateIndexOption) (*CandidateIndex, error) {
+	informer, err := c.GetInformerForKind(ctx, gvk)
+	if err != nil {
+		return nil, fmt.Errorf("error getting informer for %s: %w", gvk, err)
+	}
+
+	idx := &CandidateIndex{
+		gvk:      gvk,
+		informer: informer,
+		reader:   c,
+		entries:  map[string]map[string]*candidateEntry{},
+	}
+	for _, opt := range opts {
+		opt(idx)
+	}
+	_, err = informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    idx.upsert,
+		UpdateFunc: func(_, newObj interface{}) { idx.upsert(newObj) },
+		DeleteFunc: idx.remove,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error adding event handler for %s: %w", gvk, err)
+	}
+
+	return idx, nil
+}
+
+// GVK returns the schema.GroupVersionKind of the objects in the index.
+func (idx *CandidateIndex) GVK() schema.GroupVersionKind {
+	return idx.gvk
+}
+
+// Len returns the number of objects in the given namespace, or in all namespaces
+// if namespace is empty.
+func (idx *CandidateIndex) Len(namespace string) int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if namespace != "" {
+		return len(idx.entries[namespace])
+	}
+	n := 0
+	for _, entries := range idx.entries {
+		n += len(entries)
+	}
+	return n
+}
+
+// list reads the objects in namespace, or in all namespaces if namespace is empty, that match
+// selector from the cache, oldest first. Objects are created with newObject. Objects that are
+// no longer in the cache, or were recreated since they were indexed, are skipped.
+func (idx *CandidateIndex) list(ctx context.Context, namespace string, selector labels.Selector, newObject func() (client.Object, error)) (*unstructured.UnstructuredList, error) {
+	if !idx.informer.HasSynced() {
+		return nil, fmt.Errorf("candidate index for %s has not synced", idx.gvk)
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(idx.gvk)
+	for _, entry := range idx.oldest(namespace, selector) {
+		obj, err := newObject()
+		if err != nil {
+			return nil, err
+		}
+		if err := idx.reader.Get(ctx, entry.key, obj); apierrors.IsNotFound(err) {
+			idx.forget(entry.key)
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("error reading prune candidate %s: %w", entry.key, err)
+		}
+		if obj.GetUID() != entry.uid {
+			continue
+		}
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		u := unstructured.Unstructured{Object: content}
+		u.SetGroupVersionKind(idx.gvk)
+		list.Items = append(list.Items, u)
+	}
+	return list, nil
+}
+
+// oldest returns the entries in namespace, or in all namespaces if namespace is empty, that
+// match selector, oldest first. With WithMaxCandidates, only the oldest ones are returned.
+func (idx *CandidateIndex) oldest(namespace string, selector labels.Selector) []*candidateEntry {
+	h := &newestFirst{}
+	idx.mu.RLock()
+	for ns, entries := range idx.entries {
+		if namespace != "" && ns != namespace {
+			continue
+		}
+		for _, entry := range entries {
+			if selector != nil && !selector.Matches(entry.labels) {
+				continue
+			}
+			heap.Push(h, entry)
+			if idx.maxCandidates > 0 && h.Len() > idx.maxCandidates {
+				heap.Pop(h)
+			}
+		}
+	}
+	idx.mu.RUnlock()
+
+	oldest := make([]*candidateEntry, h.Len())
+	for i := len(oldest) - 1; i >= 0; i-- {
+		oldest[i] = heap.Pop(h).(*candidateEntry)
+	}
+	return oldest
+}
+
+func (idx *CandidateIndex) upsert(obj interface{}) {
+	o, ok := obj.(client.Object)
+	if !ok {
+		log.Error(fmt.Errorf("unexpected object of type %T", obj), "Failed to index prune candidate", "gvk", idx.gvk)
+		metrics.RecordError(metrics.SubsystemPrune, "index_failed")
+		return
+	}
+	entry := &candidateEntry{
+		key:     client.ObjectKeyFromObject(o),
+		uid:     o.GetUID(),
+		created: o.GetCreationTimestamp(),
+		labels:  o.GetLabels(),
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	entries, ok := idx.entries[entry.key.Namespace]
+	if !ok {
+		entries = map[string]*candidateEntry{}
+		idx.entries[entry.key.Namespace] = entries
+	}
+	entries[entry.key.Name] = entry
+}
+
+func (idx *CandidateIndex) remove(obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	o, ok := obj.(client.Object)
+	if !ok {
+		return
+	}
+	idx.forget(client.ObjectKeyFromObject(o))
+}
+
+func (idx *CandidateIndex) forget(key types.NamespacedName) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if entries, ok := idx.entries[key.Namespace]; ok {
+		delete(entries, key.Name)
+		if len(entries) == 0 {
+			delete(idx.entries, key.Namespace)
+		}
+	}
+}
+
+// newestFirst is a heap of candidate entries, the newest on top, ordered as by SortObjects.
+type newestFirst []*candidateEntry
+
+func (h newestFirst) Len() int { return len(h) }
+func (h newestFirst) Less(i, j int) bool {
+	a, b := h[i], h[j]
+	if !a.created.Equal(&b.created) {
+		return b.created.Before(&a.created)
+	}
+	if a.key.Namespace != b.key.Namespace {
+		return a.key.Namespace > b.key.Namespace
+	}
+	return a.key.Name > b.key.Name
+}
+func (h newestFirst) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *newestFirst) Push(x interface{}) { *h = append(*h, x.(*candidateEntry)) }
+func (h *newestFirst) Pop() interface{} {
+	old := *h
+	entry := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return entry
+}
+
+// WithCandidateIndex can be used to read prune candidates from idx instead of listing them from
+// the API server on every run. The index must be for the same GVK as the Pruner. Objects selected
+// by the strategy are fetched again before being deleted, and are skipped if they no longer exist,
+// were recreated or are no longer prunable.
+func WithCandidateIndex(idx *CandidateIndex) PrunerOption {
+	return func(p *Pruner) {
+		p.index = idx
+	}
+}
+
+// newObject returns a new object of the Pruner's GVK, of the type registered in its scheme, to
+// read candidates from a CandidateIndex.
+func (p Pruner) newObject() (client.Object, error) {
+	obj, err := p.client.Scheme().New(p.gvk)
+	if err != nil {
+		return nil, err
+	}
+	o, ok := obj.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an object", p.gvk)
+	}
+	return o, nil
+}
+
+// verify fetches the current state of obj before it is deleted. It returns nil if obj
+// no longer exists, was recreated with a different UID or is no longer prunable.
+func (p Pruner) verify(ctx context.Context, pctx PruneContext, obj client.Object) (client.Object, error) {
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(p.gvk)
+	if err := p.client.Get(ctx, client.ObjectKeyFromObject(obj), current); apierrors.IsNotFound(err) {
+		p.index.forget(client.ObjectKeyFromObject(obj))
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error verifying prune candidate: %w", err)
+	}
+	if current.GetUID() != obj.GetUID() {
+		return nil, nil
+	}
+
+	converted, err := convert(p.client, p.gvk, current)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.isPrunable(ctx, pctx, converted); IsUnprunable(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return converted, nil
+}
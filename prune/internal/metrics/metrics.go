@@ -0,0 +1,67 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics contains the metrics of prune runs.
+package metrics
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Reasons reported in the "reason" label of Errors.
+const (
+	ErrorReasonDeleteFailed = "delete_failed"
+	ErrorReasonRunFailed    = "run_failed"
+)
+
+// ObjectsPruned counts the objects deleted by prune runs that are not dry runs,
+// with information {"gvk", "namespace"}
+var ObjectsPruned = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "operator_lib_prune_objects_total",
+	Help: "Total number of objects pruned",
+}, []string{"gvk", "namespace"})
+
+// Errors counts the objects that could not be pruned and the prune runs that failed,
+// with information {"gvk", "namespace", "reason"}
+var Errors = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "operator_lib_prune_errors_total",
+	Help: "Total number of errors of prune runs, by reason",
+}, []string{"gvk", "namespace", "reason"})
+
+// RunDuration observes the duration of prune runs, with information {"gvk", "namespace"}
+var RunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "operator_lib_prune_duration_seconds",
+	Help:    "Duration of prune runs",
+	Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300},
+}, []string{"gvk", "namespace"})
+
+// Register registers the prune metrics with reg. Metrics that are already registered
+// with reg are skipped.
+func Register(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{
+		ObjectsPruned,
+		Errors,
+		RunDuration,
+	} {
+		if err := reg.Register(c); err != nil {
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if !errors.As(err, &alreadyRegistered) {
+				return err
+			}
+		}
+	}
+	return nil
+}
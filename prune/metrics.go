@@ -0,0 +1,58 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+	prunemetrics "github.com/operator-framework/operator-lib/prune/internal/metrics"
+)
+
+// WithMetricsRegistry registers the prune metrics with reg, e.g. controller-runtime's
+// metrics.Registry. The metrics report the objects pruned, the objects that could not be pruned
+// and the runs that failed, and the duration of runs, by kind and namespace, so that operators can
+// alert on pruning failures and volume. Objects pruned by dry runs are not counted. The metrics
+// shared by the library are registered as well, see the metrics package.
+func WithMetricsRegistry(reg prometheus.Registerer) PrunerOption {
+	return func(p *Pruner) {
+		if err := prunemetrics.Register(reg); err != nil {
+			p.err = fmt.Errorf("error when creating a new Pruner: error registering prune metrics: %w", err)
+			return
+		}
+		if err := libmetrics.Register(reg); err != nil {
+			p.err = fmt.Errorf("error when creating a new Pruner: error registering operator-lib metrics: %w", err)
+		}
+	}
+}
+
+// recordMetrics records the outcome of a prune run in the prune metrics.
+func (p Pruner) recordMetrics(duration time.Duration, result *Result, err error) {
+	gvk, namespace := p.gvk.String(), p.namespace
+	prunemetrics.RunDuration.WithLabelValues(gvk, namespace).Observe(duration.Seconds())
+	if err != nil {
+		prunemetrics.Errors.WithLabelValues(gvk, namespace, prunemetrics.ErrorReasonRunFailed).Inc()
+		return
+	}
+	if len(result.Failed) > 0 {
+		prunemetrics.Errors.WithLabelValues(gvk, namespace, prunemetrics.ErrorReasonDeleteFailed).Add(float64(len(result.Failed)))
+	}
+	if !p.dryRun {
+		prunemetrics.ObjectsPruned.WithLabelValues(gvk, namespace).Add(float64(len(result.Pruned)))
+	}
+}
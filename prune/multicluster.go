@@ -0,0 +1,155 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultMaxConcurrentClusters is the number of clusters a MultiClusterPruner prunes at the
+// same time when no limit is provided.
+const DefaultMaxConcurrentClusters = 4
+
+// MultiClusterPruner runs the same prune configuration against several clusters, such as the
+// spoke clusters managed by a hub operator, and aggregates their results.
+type MultiClusterPruner struct {
+	pruners        map[string]*Pruner
+	locks          map[string]*sync.Mutex
+	maxConcurrency int
+}
+
+// MultiClusterResult describes a run of a MultiClusterPruner.
+type MultiClusterResult struct {
+	// Results maps the names of the clusters that were pruned to the Result of their run
+	Results map[string]*Result
+
+	// Errors maps the names of the clusters whose run was aborted to the error that aborted it
+	Errors map[string]error
+}
+
+// NewMultiClusterPruner returns a MultiClusterPruner pruning objects of the given kind with
+// strategy and opts in each cluster of clients, which maps cluster names to their client.
+// At most maxConcurrency clusters are pruned at the same time, DefaultMaxConcurrentClusters if
+// maxConcurrency is not positive.
+//
+// opts are applied to the Pruner of each cluster, so the values they carry are shared by all the
+// Pruners: hooks, e.g. WithBeforeRun or WithPreDeleteHook, are called concurrently for the runs
+// of several clusters and must be safe for concurrent use, and a History given to WithHistory
+// only records the last run of any cluster, since all of them prune the same kind. Pruners
+// needing different options, e.g. a History per cluster, can be created with NewPruner instead.
+func NewMultiClusterPruner(clients map[string]client.Client, maxConcurrency int, gvk schema.GroupVersionKind, strategy StrategyFunc, opts ...PrunerOption) (*MultiClusterPruner, error) {
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("error when creating a new MultiClusterPruner: at least one cluster is required")
+	}
+	if maxConcurrency <= 0 {
+		maxConcurrency = DefaultMaxConcurrentClusters
+	}
+
+	m := &MultiClusterPruner{
+		pruners:        make(map[string]*Pruner, len(clients)),
+		locks:          make(map[string]*sync.Mutex, len(clients)),
+		maxConcurrency: maxConcurrency,
+	}
+	for cluster, c := range clients {
+		pruner, err := NewPruner(c, gvk, strategy, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating a new Pruner for cluster %q: %w", cluster, err)
+		}
+		m.pruners[cluster] = pruner
+		m.locks[cluster] = &sync.Mutex{}
+	}
+	return m, nil
+}
+
+// Clusters returns the names of the clusters of the MultiClusterPruner, sorted.
+func (m *MultiClusterPruner) Clusters() []string {
+	clusters := make([]string, 0, len(m.pruners))
+	for cluster := range m.pruners {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+	return clusters
+}
+
+// Pruner returns the Pruner of the given cluster, if any.
+func (m *MultiClusterPruner) Pruner(cluster string) (*Pruner, bool) {
+	p, ok := m.pruners[cluster]
+	return p, ok
+}
+
+// Prune prunes all clusters and returns a MultiClusterResult describing the run. A failure in
+// one cluster does not stop the others. If some clusters could not be pruned, or some objects
+// could not be deleted, an error describing them is returned along with the result. Runs of
+// the same cluster never overlap: a cluster still being pruned by a previous call is pruned
+// once that run is done.
+func (m *MultiClusterPruner) Prune(ctx context.Context) (*MultiClusterResult, error) {
+	result := &MultiClusterResult{
+		Results: map[string]*Result{},
+		Errors:  map[string]error{},
+	}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, m.maxConcurrency)
+	)
+	for _, cluster := range m.Clusters() {
+		wg.Add(1)
+		go func(cluster string) {
+			defer wg.Done()
+
+			var (
+				clusterResult *Result
+				err           error
+			)
+			select {
+			case sem <- struct{}{}:
+				clusterResult, err = m.pruneCluster(ctx, cluster)
+				<-sem
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				log.Error(err, "Failed to prune cluster", "cluster", cluster)
+				result.Errors[cluster] = err
+				return
+			}
+			log.V(1).Info("Pruned cluster", "cluster", cluster, "pruned", len(clusterResult.Pruned),
+				"failed", len(clusterResult.Failed))
+			result.Results[cluster] = clusterResult
+		}(cluster)
+	}
+	wg.Wait()
+
+	return result, joinRunErrors("error pruning clusters", m.Clusters(), func(cluster string) string {
+		return fmt.Sprintf("cluster %q", cluster)
+	}, result.Results, result.Errors)
+}
+
+func (m *MultiClusterPruner) pruneCluster(ctx context.Context, cluster string) (*Result, error) {
+	lock := m.locks[cluster]
+	lock.Lock()
+	defer lock.Unlock()
+	return m.pruners[cluster].PruneWithResult(ctx)
+}
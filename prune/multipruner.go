@@ -0,0 +1,129 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MultiPruner prunes objects of several kinds with a single Prune call, such as all the kinds of
+// dependents of an operator.
+type MultiPruner struct {
+	gvks    []schema.GroupVersionKind
+	pruners map[schema.GroupVersionKind]*Pruner
+}
+
+// MultiResult describes a run of a MultiPruner.
+type MultiResult struct {
+	// Results maps the kinds that were pruned to the Result of their run
+	Results map[schema.GroupVersionKind]*Result
+
+	// Errors maps the kinds whose run was aborted to the error that aborted it
+	Errors map[schema.GroupVersionKind]error
+}
+
+// NewMultiPruner returns a MultiPruner pruning objects of each of the given kinds with
+// prunerClient, strategy and opts. If strategy is nil, the default strategy registered for each
+// kind is used, see RegisterDefaultStrategy.
+//
+// opts are applied to the Pruner of each kind, so the values they carry are shared by all the
+// Pruners: hooks, e.g. WithBeforeRun or WithPreDeleteHook, are called for the runs of every kind,
+// which they can tell apart with PruneContextFrom, and a History given to WithHistory records
+// the last run of each kind. Pruners needing different options can be created with NewPruner
+// instead.
+func NewMultiPruner(prunerClient client.Client, gvks []schema.GroupVersionKind, strategy StrategyFunc, opts ...PrunerOption) (*MultiPruner, error) {
+	if len(gvks) == 0 {
+		return nil, fmt.Errorf("error when creating a new MultiPruner: at least one gvk is required")
+	}
+
+	m := &MultiPruner{
+		gvks:    make([]schema.GroupVersionKind, 0, len(gvks)),
+		pruners: make(map[schema.GroupVersionKind]*Pruner, len(gvks)),
+	}
+	for _, gvk := range gvks {
+		if _, ok := m.pruners[gvk]; ok {
+			return nil, fmt.Errorf("error when creating a new MultiPruner: duplicate gvk %s", gvk)
+		}
+		pruner, err := NewPruner(prunerClient, gvk, strategy, opts...)
+		if err != nil {
+			return nil, err
+		}
+		m.gvks = append(m.gvks, gvk)
+		m.pruners[gvk] = pruner
+	}
+	return m, nil
+}
+
+// GVKs returns the kinds pruned by the MultiPruner, in the order they are pruned.
+func (m *MultiPruner) GVKs() []schema.GroupVersionKind {
+	return append([]schema.GroupVersionKind(nil), m.gvks...)
+}
+
+// Pruner returns the Pruner of the given kind, if any.
+func (m *MultiPruner) Pruner(gvk schema.GroupVersionKind) (*Pruner, bool) {
+	p, ok := m.pruners[gvk]
+	return p, ok
+}
+
+// Prune prunes objects of each kind, one kind after the other in the order they were given to
+// NewMultiPruner, and returns a MultiResult describing the run. A failure for one kind does not
+// stop the others. If some kinds could not be pruned, or some objects could not be deleted, an
+// error describing them is returned along with the result.
+func (m *MultiPruner) Prune(ctx context.Context) (*MultiResult, error) {
+	result := &MultiResult{
+		Results: map[schema.GroupVersionKind]*Result{},
+		Errors:  map[schema.GroupVersionKind]error{},
+	}
+
+	for _, gvk := range m.gvks {
+		gvkResult, err := m.pruners[gvk].PruneWithResult(ctx)
+		if err != nil {
+			log.Error(err, "Failed to prune kind", "gvk", gvk)
+			result.Errors[gvk] = err
+			continue
+		}
+		log.V(1).Info("Pruned kind", "gvk", gvk, "pruned", len(gvkResult.Pruned), "failed", len(gvkResult.Failed))
+		result.Results[gvk] = gvkResult
+	}
+
+	return result, joinRunErrors("error pruning kinds", m.gvks, schema.GroupVersionKind.String, result.Results, result.Errors)
+}
+
+// joinRunErrors returns an error with message describing, for each run of keys in order, the
+// error that aborted it or the objects it could not delete, or nil if there are none. Each run is
+// described with name.
+func joinRunErrors[K comparable](message string, keys []K, name func(K) string, results map[K]*Result, runErrs map[K]error) error {
+	var errs []error
+	for _, key := range keys {
+		if err, ok := runErrs[key]; ok {
+			errs = append(errs, fmt.Errorf("%s: %w", name(key), err))
+			continue
+		}
+		if result, ok := results[key]; ok {
+			for _, failed := range result.Failed {
+				errs = append(errs, fmt.Errorf("%s: %s: %w", name(key), client.ObjectKeyFromObject(failed.Obj), failed.Err))
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", message, errors.Join(errs...))
+}
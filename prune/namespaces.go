@@ -0,0 +1,76 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WithPerNamespaceStrategy evaluates the strategy separately for the resources of each namespace,
+// when pruning in all namespaces, see WithAllNamespaces. Namespaces are found at run time from the
+// listed resources. Strategies comparing resources then apply within each namespace rather than
+// globally, ex. NewPruneByCountStrategy keeps the given number of resources in every namespace,
+// which matches the expectations of tenants in multi-tenant clusters better than a global count.
+//
+// The strategy is called once per namespace, with the Namespace of the PruneContext set to that
+// namespace. Their results are merged before the BeforeRunFuncs are called, so that they are
+// called once per run with the Plan of every namespace. With WithStreaming, the resources of each
+// page are grouped by namespace, and the strategy is called once per namespace of each page.
+func WithPerNamespaceStrategy() PrunerOption {
+	return func(p *Pruner) {
+		p.perNamespace = true
+	}
+}
+
+// evaluate runs the Pruner's strategy on objs, once per namespace with WithPerNamespaceStrategy.
+func (p Pruner) evaluate(ctx context.Context, pctx PruneContext, objs []client.Object) (StrategyResult, error) {
+	if !p.perNamespace {
+		return p.strategy(ctx, pctx, objs)
+	}
+
+	byNamespace := map[string][]client.Object{}
+	for _, obj := range objs {
+		byNamespace[obj.GetNamespace()] = append(byNamespace[obj.GetNamespace()], obj)
+	}
+	namespaces := make([]string, 0, len(byNamespace))
+	for ns := range byNamespace {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	merged := StrategyResult{}
+	for _, ns := range namespaces {
+		nsCtx := pctx
+		nsCtx.Namespace = ns
+		result, err := p.strategy(WithPruneContext(ctx, nsCtx), nsCtx, byNamespace[ns])
+		if err != nil {
+			return StrategyResult{}, err
+		}
+		merged.Objects = append(merged.Objects, result.Objects...)
+		if next := result.NextRun; next > 0 && (merged.NextRun == 0 || next < merged.NextRun) {
+			merged.NextRun = next
+		}
+		for key, selection := range result.Selections {
+			if merged.Selections == nil {
+				merged.Selections = map[client.ObjectKey]Selection{}
+			}
+			merged.Selections[key] = selection
+		}
+	}
+	return merged, nil
+}
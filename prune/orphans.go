@@ -0,0 +1,167 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-lib/handler"
+)
+
+// OrphanedLabel is set to "true" on dependents that were re-labeled by the orphan cleanup
+// pass, see WithOrphanCleanup.
+const OrphanedLabel = "operator-lib.operatorframework.io/orphaned"
+
+// OrphanPolicy defines what happens to the dependents of a pruned object.
+type OrphanPolicy string
+
+const (
+	// OrphanPolicyDelete deletes the dependents of pruned objects, except protected ones.
+	OrphanPolicyDelete OrphanPolicy = "Delete"
+	// OrphanPolicyRelabel removes the references to pruned objects from their dependents
+	// and sets OrphanedLabel on them, so that they can be found and handled later. Protected
+	// dependents are left untouched.
+	OrphanPolicyRelabel OrphanPolicy = "Relabel"
+)
+
+// WithOrphanCleanup enables a follow-up pass after pruning that looks for objects of the
+// given dependent kinds that reference a pruned object, either through an ownerReference or
+// through the owner annotations of the handler package, and handles them according to policy.
+// This covers dependents that garbage collection does not collect, such as annotation-owned
+// objects or objects orphaned by the deletion propagation policy.
+// Dependents are looked up in the Pruner's namespace. Dependents protected by the
+// ProtectAnnotation are kept as they are, whatever the policy.
+func WithOrphanCleanup(policy OrphanPolicy, dependents ...schema.GroupVersionKind) PrunerOption {
+	return func(p *Pruner) {
+		p.orphanPolicy = policy
+		p.orphanDependents = dependents
+	}
+}
+
+// cleanupOrphans handles the dependents of the pruned objects and returns them.
+func (p Pruner) cleanupOrphans(ctx context.Context, pruned []client.Object) ([]client.Object, error) {
+	if len(p.orphanDependents) == 0 || len(pruned) == 0 {
+		return nil, nil
+	}
+
+	var orphans []client.Object
+	for _, gvk := range p.orphanDependents {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk)
+		if err := p.client.List(ctx, list, client.InNamespace(p.namespace)); err != nil {
+			return orphans, fmt.Errorf("error listing dependents of kind %s: %w", gvk, err)
+		}
+
+		for i := range list.Items {
+			dependent := &list.Items[i]
+			owner := findOwner(dependent, pruned)
+			if owner == nil {
+				continue
+			}
+			if err := checkProtected(dependent); err != nil {
+				log.V(1).Info("Keeping protected dependent", "object", client.ObjectKeyFromObject(dependent),
+					"owner", client.ObjectKeyFromObject(owner))
+				continue
+			}
+
+			var err error
+			switch p.orphanPolicy {
+			case OrphanPolicyRelabel:
+				err = p.relabelOrphan(ctx, dependent, owner)
+			default:
+				err = p.deleteWithRetry(ctx, dependent)
+			}
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return orphans, fmt.Errorf("error cleaning up orphaned dependent %s: %w", client.ObjectKeyFromObject(dependent), err)
+			}
+
+			log.V(1).Info("Cleaned up orphaned dependent", "object", client.ObjectKeyFromObject(dependent),
+				"owner", client.ObjectKeyFromObject(owner), "policy", p.orphanPolicy)
+			orphans = append(orphans, dependent)
+		}
+	}
+
+	return orphans, nil
+}
+
+// relabelOrphan removes the references to owner from dependent and marks it as orphaned.
+func (p Pruner) relabelOrphan(ctx context.Context, dependent, owner client.Object) error {
+	refs := dependent.GetOwnerReferences()
+	kept := refs[:0]
+	for _, ref := range refs {
+		if !ownerRefMatches(ref, owner) {
+			kept = append(kept, ref)
+		}
+	}
+	dependent.SetOwnerReferences(kept)
+
+	if annotations := dependent.GetAnnotations(); annotationsMatch(annotations, owner) {
+		delete(annotations, handler.NamespacedNameAnnotation)
+		delete(annotations, handler.TypeAnnotation)
+		dependent.SetAnnotations(annotations)
+	}
+
+	labels := dependent.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[OrphanedLabel] = "true"
+	dependent.SetLabels(labels)
+
+	var opts []client.UpdateOption
+	if p.dryRun {
+		opts = append(opts, client.DryRunAll)
+	}
+	return p.client.Update(ctx, dependent, opts...)
+}
+
+// findOwner returns the object of owners that dependent references, or nil.
+func findOwner(dependent client.Object, owners []client.Object) client.Object {
+	for _, owner := range owners {
+		for _, ref := range dependent.GetOwnerReferences() {
+			if ownerRefMatches(ref, owner) {
+				return owner
+			}
+		}
+		if annotationsMatch(dependent.GetAnnotations(), owner) {
+			return owner
+		}
+	}
+	return nil
+}
+
+func ownerRefMatches(ref metav1.OwnerReference, owner client.Object) bool {
+	return owner.GetUID() != "" && ref.UID == owner.GetUID()
+}
+
+// annotationsMatch returns true if the owner annotations set by handler.SetOwnerAnnotations
+// identify owner.
+func annotationsMatch(annotations map[string]string, owner client.Object) bool {
+	if annotations == nil {
+		return false
+	}
+	return annotations[handler.NamespacedNameAnnotation] == fmt.Sprintf("%s/%s", owner.GetNamespace(), owner.GetName()) &&
+		annotations[handler.TypeAnnotation] == owner.GetObjectKind().GroupVersionKind().GroupKind().String()
+}
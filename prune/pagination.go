@@ -0,0 +1,58 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"fmt"
+)
+
+// DefaultStreamingPageSize is the number of resources listed per request by Pruners using
+// WithStreaming without a page size.
+const DefaultStreamingPageSize = 500
+
+// WithPageSize lists resources in pages of size resources, using the limit and continue
+// parameters of list requests, instead of in a single request, so that large lists do not
+// strain the API server. Without WithStreaming, all pages are still gathered before the strategy
+// is run, and the BeforeRunFuncs are called once with the Plan of the whole run.
+func WithPageSize(size int64) PrunerOption {
+	return func(p *Pruner) {
+		if size <= 0 {
+			p.err = fmt.Errorf("error when creating a new Pruner: page size must be positive, got %d", size)
+			return
+		}
+		p.pageSize = size
+	}
+}
+
+// WithStreaming evaluates and prunes resources one page at a time, see WithPageSize, so that the
+// memory used by a run stays bounded by the page size on clusters with many matching resources.
+// The page size defaults to DefaultStreamingPageSize.
+//
+// The strategy is called once per page with the resources of that page only. This gives the
+// same result as a single evaluation for strategies that decide for each resource
+// independently, such as NewPruneOlderThan or NewPruneByDateStrategy, but not for strategies
+// comparing resources, such as NewPruneByCountStrategy, which apply to each page. Pages are
+// pruned as soon as they are evaluated, so a strategy error stops the run after the previous
+// pages were pruned. Since no Plan of the whole run exists before resources are pruned,
+// WithStreaming can not be used with WithBeforeRun, and NewPruner returns an error if both are
+// given.
+func WithStreaming() PrunerOption {
+	return func(p *Pruner) {
+		p.streaming = true
+		if p.pageSize == 0 {
+			p.pageSize = DefaultStreamingPageSize
+		}
+	}
+}
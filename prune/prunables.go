@@ -15,10 +15,18 @@
 package prune
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/utils/clock"
+	"k8s.io/utils/lru"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DefaultPodIsPrunable is a default IsPrunableFunc to be used specifically with Pod resources.
@@ -50,3 +58,331 @@ func DefaultJobIsPrunable(obj client.Object) error {
 
 	return nil
 }
+
+// FinishedPodIsPrunable is an IsPrunableFunc for Pod resources that marks a Pod as prunable if
+// it succeeded or failed. Pods evicted by the kubelet are failed, and therefore prunable.
+func FinishedPodIsPrunable(obj client.Object) error {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return fmt.Errorf("object of type %T is not a Pod", obj)
+	}
+	if done, _ := podFinished(pod); !done {
+		return &Unprunable{
+			Obj:    &obj,
+			Reason: "Pod has not finished",
+		}
+	}
+
+	return nil
+}
+
+// ReleasedPersistentVolumeIsPrunable is an IsPrunableFunc for PersistentVolume resources that
+// marks a PersistentVolume as prunable if it was released by its claim, i.e. the claim was
+// deleted and the volume was retained by its reclaim policy.
+func ReleasedPersistentVolumeIsPrunable(obj client.Object) error {
+	pv, ok := obj.(*corev1.PersistentVolume)
+	if !ok {
+		return fmt.Errorf("object of type %T is not a PersistentVolume", obj)
+	}
+	if pv.Status.Phase != corev1.VolumeReleased {
+		return &Unprunable{
+			Obj:    &obj,
+			Reason: "PersistentVolume has not been released",
+		}
+	}
+
+	return nil
+}
+
+// CronJobJobIsPrunable is an IsPrunableFunc for Job resources that marks a Job as prunable if it
+// was created by a CronJob and completed or failed. Jobs that were not created by a CronJob are
+// left to the operator that created them.
+func CronJobJobIsPrunable(obj client.Object) error {
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		return fmt.Errorf("object of type %T is not a Job", obj)
+	}
+	owner := metav1.GetControllerOf(job)
+	if owner == nil || owner.Kind != "CronJob" || owner.APIVersion != batchv1.SchemeGroupVersion.String() {
+		return &Unprunable{
+			Obj:    &obj,
+			Reason: "Job was not created by a CronJob",
+		}
+	}
+	if done, _ := jobFinished(job); !done {
+		return &Unprunable{
+			Obj:    &obj,
+			Reason: "Job has not finished",
+		}
+	}
+
+	return nil
+}
+
+// rootCAConfigMapName is the name of the ConfigMap published in every namespace by the
+// kube-controller-manager.
+const rootCAConfigMapName = "kube-root-ca.crt"
+
+// unusedConsumersCacheSize is the number of namespaces of prune runs whose consumers are cached
+// by the IsPrunableFuncV2 returned by NewUnusedIsPrunable.
+const unusedConsumersCacheSize = 64
+
+// NewUnusedIsPrunable returns an IsPrunableFuncV2 for ConfigMap and Secret resources that marks a
+// ConfigMap or Secret as prunable if it was created more than minAge ago and no consumer of its
+// namespace references it. Consumers are Pods and the Pod templates of Deployments, StatefulSets,
+// DaemonSets, ReplicaSets, Jobs and CronJobs, which reference ConfigMaps and Secrets in a volume,
+// an environment variable or as an image pull secret, and ServiceAccounts, which reference
+// Secrets as image pull secrets or mountable secrets. Consumers are listed with reader, once per
+// namespace and prune run, so reader must be allowed to list all these kinds. The current time is
+// read from the Clock of the PruneContext, see WithClock.
+//
+// References from other objects, such as the TLS Secrets of Ingresses or the ConfigMaps and
+// Secrets referenced by custom resources, are not detected: such objects are pruned while still
+// in use unless the Pruner only selects ConfigMaps and Secrets the operator manages itself, e.g.
+// with WithLabels.
+//
+// ConfigMaps and Secrets that have owner references are never prunable, since they are deleted
+// along with their owners by the garbage collector. Neither are service account token Secrets and
+// the kube-root-ca.crt ConfigMap.
+func NewUnusedIsPrunable(reader client.Reader, minAge time.Duration) IsPrunableFuncV2 {
+	type runNamespace struct {
+		runID     string
+		namespace string
+	}
+	consumersByRun := lru.New(unusedConsumersCacheSize)
+
+	return func(ctx context.Context, pctx PruneContext, obj client.Object) error {
+		unprunable := func(reason string, args ...interface{}) error {
+			return &Unprunable{Obj: &obj, Reason: fmt.Sprintf(reason, args...)}
+		}
+
+		var kind string
+		switch o := obj.(type) {
+		case *corev1.ConfigMap:
+			if o.Name == rootCAConfigMapName {
+				return unprunable("ConfigMap is managed by the cluster")
+			}
+			kind = "ConfigMap"
+		case *corev1.Secret:
+			if o.Type == corev1.SecretTypeServiceAccountToken {
+				return unprunable("Secret is a service account token")
+			}
+			kind = "Secret"
+		default:
+			return fmt.Errorf("object of type %T is not a ConfigMap or Secret", obj)
+		}
+
+		if len(obj.GetOwnerReferences()) > 0 {
+			return unprunable("%s has owners", kind)
+		}
+
+		var c clock.PassiveClock = clock.RealClock{}
+		if pctx.Clock != nil {
+			c = pctx.Clock
+		}
+		if obj.GetCreationTimestamp().Time.After(c.Now().Add(-minAge)) {
+			return unprunable("%s was created less than %s ago", kind, minAge)
+		}
+
+		key := runNamespace{runID: pctx.RunID, namespace: obj.GetNamespace()}
+		var consumers []consumer
+		if cached, ok := consumersByRun.Get(key); ok && pctx.RunID != "" {
+			consumers = cached.([]consumer)
+		} else {
+			var err error
+			if consumers, err = listConsumers(ctx, reader, obj.GetNamespace()); err != nil {
+				return fmt.Errorf("error listing objects referencing %s: %w", kind, err)
+			}
+			if pctx.RunID != "" {
+				consumersByRun.Add(key, consumers)
+			}
+		}
+		for _, consumer := range consumers {
+			if consumer.references(kind, obj.GetName()) {
+				return unprunable("%s is referenced by %s", kind, consumer.name)
+			}
+		}
+
+		return nil
+	}
+}
+
+// consumer is an object that may reference ConfigMaps and Secrets by name.
+type consumer struct {
+	// name describes the object, e.g. "Pod web-0"
+	name string
+	// references returns true if the object references the ConfigMap or Secret, depending on
+	// kind, with the given name
+	references func(kind, name string) bool
+}
+
+// podSpecConsumer returns the consumer of the Pod, or Pod template, spec of the object of kind
+// named name.
+func podSpecConsumer(kind, name string, spec *corev1.PodSpec) consumer {
+	return consumer{
+		name: kind + " " + name,
+		references: func(refKind, refName string) bool {
+			return podSpecReferences(spec, refKind, refName)
+		},
+	}
+}
+
+// listConsumers lists the consumers of ConfigMaps and Secrets in namespace with reader, see
+// NewUnusedIsPrunable.
+func listConsumers(ctx context.Context, reader client.Reader, namespace string) ([]consumer, error) {
+	var consumers []consumer
+	inNamespace := client.InNamespace(namespace)
+
+	pods := &corev1.PodList{}
+	if err := reader.List(ctx, pods, inNamespace); err != nil {
+		return nil, err
+	}
+	for i := range pods.Items {
+		consumers = append(consumers, podSpecConsumer("Pod", pods.Items[i].Name, &pods.Items[i].Spec))
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := reader.List(ctx, deployments, inNamespace); err != nil {
+		return nil, err
+	}
+	for i := range deployments.Items {
+		consumers = append(consumers, podSpecConsumer("Deployment", deployments.Items[i].Name, &deployments.Items[i].Spec.Template.Spec))
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := reader.List(ctx, statefulSets, inNamespace); err != nil {
+		return nil, err
+	}
+	for i := range statefulSets.Items {
+		consumers = append(consumers, podSpecConsumer("StatefulSet", statefulSets.Items[i].Name, &statefulSets.Items[i].Spec.Template.Spec))
+	}
+
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := reader.List(ctx, daemonSets, inNamespace); err != nil {
+		return nil, err
+	}
+	for i := range daemonSets.Items {
+		consumers = append(consumers, podSpecConsumer("DaemonSet", daemonSets.Items[i].Name, &daemonSets.Items[i].Spec.Template.Spec))
+	}
+
+	replicaSets := &appsv1.ReplicaSetList{}
+	if err := reader.List(ctx, replicaSets, inNamespace); err != nil {
+		return nil, err
+	}
+	for i := range replicaSets.Items {
+		consumers = append(consumers, podSpecConsumer("ReplicaSet", replicaSets.Items[i].Name, &replicaSets.Items[i].Spec.Template.Spec))
+	}
+
+	jobs := &batchv1.JobList{}
+	if err := reader.List(ctx, jobs, inNamespace); err != nil {
+		return nil, err
+	}
+	for i := range jobs.Items {
+		consumers = append(consumers, podSpecConsumer("Job", jobs.Items[i].Name, &jobs.Items[i].Spec.Template.Spec))
+	}
+
+	cronJobs := &batchv1.CronJobList{}
+	if err := reader.List(ctx, cronJobs, inNamespace); err != nil {
+		return nil, err
+	}
+	for i := range cronJobs.Items {
+		consumers = append(consumers, podSpecConsumer("CronJob", cronJobs.Items[i].Name, &cronJobs.Items[i].Spec.JobTemplate.Spec.Template.Spec))
+	}
+
+	serviceAccounts := &corev1.ServiceAccountList{}
+	if err := reader.List(ctx, serviceAccounts, inNamespace); err != nil {
+		return nil, err
+	}
+	for i := range serviceAccounts.Items {
+		sa := &serviceAccounts.Items[i]
+		consumers = append(consumers, consumer{
+			name: "ServiceAccount " + sa.Name,
+			references: func(kind, name string) bool {
+				return kind == "Secret" && serviceAccountReferences(sa, name)
+			},
+		})
+	}
+
+	return consumers, nil
+}
+
+// serviceAccountReferences returns true if sa references the Secret with the given name.
+func serviceAccountReferences(sa *corev1.ServiceAccount, name string) bool {
+	for _, ref := range sa.ImagePullSecrets {
+		if ref.Name == name {
+			return true
+		}
+	}
+	for _, ref := range sa.Secrets {
+		if ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// podSpecReferences returns true if the Pod spec references the ConfigMap or Secret, depending
+// on kind, with the given name.
+func podSpecReferences(spec *corev1.PodSpec, kind, name string) bool {
+	isRef := func(ref *corev1.LocalObjectReference, refKind string) bool {
+		return ref != nil && refKind == kind && ref.Name == name
+	}
+
+	if kind == "Secret" {
+		for _, ref := range spec.ImagePullSecrets {
+			if ref.Name == name {
+				return true
+			}
+		}
+	}
+
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil && isRef(&volume.ConfigMap.LocalObjectReference, "ConfigMap") {
+			return true
+		}
+		if volume.Secret != nil && kind == "Secret" && volume.Secret.SecretName == name {
+			return true
+		}
+		if volume.Projected == nil {
+			continue
+		}
+		for _, source := range volume.Projected.Sources {
+			if source.ConfigMap != nil && isRef(&source.ConfigMap.LocalObjectReference, "ConfigMap") {
+				return true
+			}
+			if source.Secret != nil && isRef(&source.Secret.LocalObjectReference, "Secret") {
+				return true
+			}
+		}
+	}
+
+	var containers []corev1.Container
+	containers = append(containers, spec.InitContainers...)
+	containers = append(containers, spec.Containers...)
+	for _, container := range spec.EphemeralContainers {
+		containers = append(containers, corev1.Container(container.EphemeralContainerCommon))
+	}
+	for _, container := range containers {
+		for _, env := range container.EnvFrom {
+			if env.ConfigMapRef != nil && isRef(&env.ConfigMapRef.LocalObjectReference, "ConfigMap") {
+				return true
+			}
+			if env.SecretRef != nil && isRef(&env.SecretRef.LocalObjectReference, "Secret") {
+				return true
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil && isRef(&ref.LocalObjectReference, "ConfigMap") {
+				return true
+			}
+			if ref := env.ValueFrom.SecretKeyRef; ref != nil && isRef(&ref.LocalObjectReference, "Secret") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -18,22 +18,34 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+
+	"github.com/operator-framework/operator-lib/internal/metrics"
 )
 
+var log = logf.Log.WithName("prune")
+
 func init() {
 	RegisterIsPrunableFunc(corev1.SchemeGroupVersion.WithKind("Pod"), DefaultPodIsPrunable)
+	RegisterDefaultStrategy(corev1.SchemeGroupVersion.WithKind("Pod"), NewPruneOlderThan(DefaultPodMaxAge))
 
 	RegisterIsPrunableFunc(batchv1.SchemeGroupVersion.WithKind("Job"), DefaultJobIsPrunable)
+	RegisterDefaultStrategy(batchv1.SchemeGroupVersion.WithKind("Job"), keepNewest(DefaultJobHistoryLimit))
 }
 
 // Pruner is an object that runs a prune job.
@@ -49,13 +61,121 @@ type Pruner struct {
 	gvk schema.GroupVersionKind
 
 	// strategy is the function used to determine a list of resources that are pruneable
-	strategy StrategyFunc
+	strategy StrategyFuncV2
 
 	// labels is a map of the labels to use for label matching when looking for resources
 	labels map[string]string
 
+	// labelSelector is combined with labels when looking for resources, if set
+	labelSelector labels.Selector
+
 	// namespace is the namespace to use when looking for resources
 	namespace string
+
+	// fieldSelector is passed to the API server when looking for resources, if set
+	fieldSelector fields.Selector
+
+	// dryRun is true if deletions should only be simulated
+	dryRun bool
+
+	// clock is passed to strategies in the PruneContext
+	clock clock.PassiveClock
+
+	// deleteBackoff is the backoff used to retry deletions that fail with a retriable error
+	deleteBackoff wait.Backoff
+
+	// deleteOpts are the options of the delete requests sent by the Pruner
+	deleteOpts []client.DeleteOption
+
+	// deleter, if set, replaces the deletion of the objects selected by the strategy
+	deleter DeleterFunc
+
+	// index, if set, is used to read prune candidates instead of listing them
+	index *CandidateIndex
+
+	// missingTimestamps defines how objects without a creationTimestamp are handled
+	missingTimestamps MissingTimestampPolicy
+
+	// safeMode, if set, keeps the objects that another controller may still manage
+	safeMode *safeMode
+
+	// beforeRun and afterRun are called before and after each run
+	beforeRun []BeforeRunFunc
+	afterRun  []AfterRunFunc
+
+	// preDelete and postDelete are called before and after each object is pruned
+	preDelete  []PreDeleteHookFunc
+	postDelete []PostDeleteHookFunc
+
+	// pageSize, if positive, is the number of resources listed per request
+	pageSize int64
+
+	// streaming is true if resources are evaluated and pruned one page at a time
+	streaming bool
+
+	// cursors, if set, stores the continue token of the page listed by the next run
+	cursors CursorStore
+
+	// perNamespace is true if the strategy is evaluated separately for each namespace
+	perNamespace bool
+
+	// history, if set, records the runs of the Pruner
+	history *History
+
+	// graph, if set, describes the dependents pruned along with each object
+	graph *PruneGraph
+
+	// orphanPolicy and orphanDependents configure the cleanup of dependents of pruned objects
+	orphanPolicy     OrphanPolicy
+	orphanDependents []schema.GroupVersionKind
+
+	// err records an invalid option, returned by NewPruner
+	err error
+}
+
+// Result describes the outcome of a prune run.
+type Result struct {
+	// Pruned contains the objects that were deleted, in the order of deletion, see SortObjects
+	Pruned []client.Object
+
+	// AlreadyGone contains the objects selected for pruning that no longer existed when they
+	// were deleted, e.g. because they were removed concurrently by another controller
+	AlreadyGone []client.Object
+
+	// Skipped contains the objects selected for pruning that were kept by a pre-delete hook,
+	// see WithPreDeleteHook
+	Skipped []client.Object
+
+	// Failed contains the objects that could not be deleted because of transient errors
+	// that persisted after all retries were exhausted
+	Failed []FailedDeletion
+
+	// MissingTimestamps contains the objects considered for pruning that had no creationTimestamp,
+	// see WithMissingTimestampPolicy
+	MissingTimestamps []client.Object
+
+	// NextRun is the time after which the next object becomes prunable, as hinted by the strategy,
+	// or zero if the strategy gives no hint, see StrategyResult.NextRun. A controller running the
+	// Pruner can requeue after NextRun instead of polling on a fixed interval.
+	NextRun time.Duration
+
+	// Dependents contains the dependents pruned along with the pruned objects, see WithPruneGraph
+	Dependents []client.Object
+
+	// Orphans contains the dependents of pruned objects that were deleted or re-labeled,
+	// see WithOrphanCleanup
+	Orphans []client.Object
+
+	// Selections describe the policy that selected each object for pruning, for the objects
+	// whose strategy gave one, see StrategyResult.Selections
+	Selections map[client.ObjectKey]Selection
+}
+
+// FailedDeletion records an object that could not be pruned and the last error returned
+// when attempting to delete it.
+type FailedDeletion struct {
+	Obj client.Object
+	Err error
 }
 
 // Unprunable indicates that it is not allowed to prune a specific object.
@@ -80,7 +200,8 @@ type IsPrunableFunc func(obj client.Object) error
 // PrunerOption configures the pruner.
 type PrunerOption func(p *Pruner)
 
-// WithNamespace can be used to set the Namespace field when configuring a Pruner
+// WithNamespace can be used to set the Namespace field when configuring a Pruner. Resources are
+// pruned in all namespaces if it is not set, see WithAllNamespaces.
 func WithNamespace(namespace string) PrunerOption {
 	return func(p *Pruner) {
 		p.namespace = namespace
@@ -94,6 +215,35 @@ func WithLabels(labels map[string]string) PrunerOption {
 	}
 }
 
+// WithLabelSelector can be used to select the resources to prune with a set-based label selector,
+// ex. "tier in (cache, queue)". It is combined with the labels set with WithLabels, if any.
+func WithLabelSelector(selector labels.Selector) PrunerOption {
+	return func(p *Pruner) {
+		p.labelSelector = selector
+	}
+}
+
+// WithLabelSelectorString is like WithLabelSelector, with a selector parsed by labels.Parse.
+// NewPruner returns an error if the selector is invalid.
+func WithLabelSelectorString(selector string) PrunerOption {
+	return func(p *Pruner) {
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			p.err = fmt.Errorf("error when creating a new Pruner: invalid label selector %q: %w", selector, err)
+			return
+		}
+		p.labelSelector = parsed
+	}
+}
+
+// WithDeleteBackoff can be used to set the backoff used to retry deletions that fail with
+// a retriable error, see IsRetriable. Setting backoff.Steps to 1 disables retries.
+func WithDeleteBackoff(backoff wait.Backoff) PrunerOption {
+	return func(p *Pruner) {
+		p.deleteBackoff = backoff
+	}
+}
+
 // GVK returns the schema.GroupVersionKind that the Pruner has set
 func (p Pruner) GVK() schema.GroupVersionKind {
 	return p.gvk
@@ -104,12 +254,26 @@ func (p Pruner) Labels() map[string]string {
 	return p.labels
 }
 
+// LabelSelector returns the label selector that the Pruner is using to find resources to prune,
+// combining the labels set with WithLabels and the selector set with WithLabelSelector
+func (p Pruner) LabelSelector() labels.Selector {
+	selector := labels.Set(p.labels).AsSelector()
+	if p.labelSelector == nil {
+		return selector
+	}
+	requirements, _ := p.labelSelector.Requirements()
+	return selector.Add(requirements...)
+}
+
 // Namespace returns the namespace that the Pruner is using to find resources to prune
 func (p Pruner) Namespace() string {
 	return p.namespace
 }
 
-// NewPruner returns a pruner that uses the given strategy to prune objects that have the given GVK
+// NewPruner returns a pruner that uses the given strategy to prune objects that have the given GVK.
+// If strategy is nil and no strategy is set with WithStrategyV2, the default strategy registered
+// for the GVK is used, see RegisterDefaultStrategy. By default, the 3 most recent Jobs are kept
+// and Pods are pruned after an hour.
 func NewPruner(prunerClient client.Client, gvk schema.GroupVersionKind, strategy StrategyFunc, opts ...PrunerOption) (*Pruner, error) {
 	if gvk.Empty() {
 		return nil, fmt.Errorf("error when creating a new Pruner: gvk parameter can not be empty")
@@ -119,39 +283,147 @@ func NewPruner(prunerClient client.Client, gvk schema.GroupVersionKind, strategy
 		registry: defaultRegistry,
 		client:   prunerClient,
 		gvk:      gvk,
-		strategy: strategy,
+
+		deleteBackoff:     DefaultDeleteBackoff,
+		clock:             clock.RealClock{},
+		missingTimestamps: MissingTimestampAsOldest,
+	}
+	if strategy != nil {
+		pruner.strategy = StrategyV2(strategy)
 	}
 
 	for _, opt := range opts {
 		opt(&pruner)
 	}
+	if pruner.err != nil {
+		return nil, pruner.err
+	}
+	if pruner.streaming && len(pruner.beforeRun) > 0 {
+		return nil, fmt.Errorf("error when creating a new Pruner: WithBeforeRun can not be used with WithStreaming, which prunes each page before the next one is listed")
+	}
+
+	if pruner.strategy == nil {
+		strategy, ok := pruner.registry.DefaultStrategy(gvk)
+		if !ok {
+			return nil, fmt.Errorf("error when creating a new Pruner: no strategy given and no default strategy registered for %s", gvk)
+		}
+		pruner.strategy = strategy
+	}
 
 	return &pruner, nil
 }
 
-// Prune runs the pruner.
+// Prune runs the pruner and returns the objects that were pruned.
+// If some objects could not be deleted after retrying, the objects that were pruned are
+// returned along with an error describing the failed deletions.
 func (p Pruner) Prune(ctx context.Context) ([]client.Object, error) {
+	result, err := p.PruneWithResult(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Failed) > 0 {
+		errs := make([]error, 0, len(result.Failed))
+		for _, failed := range result.Failed {
+			errs = append(errs, fmt.Errorf("%s: %w", client.ObjectKeyFromObject(failed.Obj), failed.Err))
+		}
+		return result.Pruned, fmt.Errorf("error pruning objects: %w", errors.Join(errs...))
+	}
+
+	return result.Pruned, nil
+}
+
+// PruneWithResult runs the pruner and returns a Result describing the run.
+// The objects selected by the strategy are deleted in the order defined by SortObjects.
+// Deletions failing with a retriable error are retried using the Pruner's backoff and are
+// recorded in Result.Failed if they still fail, without aborting the run. Objects that no
+// longer exist when they are deleted are recorded in Result.AlreadyGone. Any other error
+// aborts the run and is returned. Runs are recorded in the Pruner's History, if any.
+func (p Pruner) PruneWithResult(ctx context.Context) (*Result, error) {
+	start := p.clock.Now()
+	result, err := p.pruneWithResult(ctx)
+	duration := p.clock.Since(start)
+	p.recordMetrics(duration, result, err)
+	if p.history != nil {
+		p.history.record(p.gvk, start, duration, p.dryRun, result, err)
+	}
+	return result, err
+}
+
+func (p Pruner) pruneWithResult(ctx context.Context) (*Result, error) {
+	clusterScoped, err := p.clusterScoped()
+	if err != nil {
+		return nil, err
+	}
+
 	listOpts := client.ListOptions{
-		LabelSelector: labels.Set(p.labels).AsSelector(),
+		LabelSelector: p.LabelSelector(),
 		Namespace:     p.namespace,
 	}
 
-	var unstructuredObjs unstructured.UnstructuredList
-	unstructuredObjs.SetGroupVersionKind(p.gvk)
-	if err := p.client.List(ctx, &unstructuredObjs, &listOpts); err != nil {
-		return nil, fmt.Errorf("error getting a list of resources: %w", err)
+	pctx := PruneContext{
+		GVK:           p.gvk,
+		Namespace:     p.namespace,
+		ClusterScoped: clusterScoped,
+		LabelSelector: listOpts.LabelSelector,
+		FieldSelector: p.fieldSelector,
+		RunID:         string(uuid.NewUUID()),
+		DryRun:        p.dryRun,
+		Clock:         p.clock,
+	}
+	ctx = WithPruneContext(ctx, pctx)
+
+	result := &Result{}
+	if p.streaming {
+		err := p.listPages(ctx, listOpts, func(page *unstructured.UnstructuredList) error {
+			objs, err := p.candidates(ctx, pctx, page)
+			if err != nil {
+				return err
+			}
+			return p.pruneCandidates(ctx, pctx, objs, result)
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		unstructuredObjs, err := p.list(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		objs, err := p.candidates(ctx, pctx, unstructuredObjs)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.pruneCandidates(ctx, pctx, objs, result); err != nil {
+			return nil, err
+		}
+	}
+
+	gone := make([]client.Object, 0, len(result.Pruned)+len(result.AlreadyGone))
+	gone = append(append(gone, result.Pruned...), result.AlreadyGone...)
+	result.Orphans, err = p.cleanupOrphans(ctx, gone)
+	if err != nil {
+		return nil, err
 	}
 
-	objs := make([]client.Object, 0, len(unstructuredObjs.Items))
+	for _, hook := range p.afterRun {
+		hook(ctx, result)
+	}
+	return result, nil
+}
+
+// candidates converts the listed resources and returns the ones that are prunable.
+func (p Pruner) candidates(ctx context.Context, pctx PruneContext, list *unstructured.UnstructuredList) ([]client.Object, error) {
+	objs := make([]client.Object, 0, len(list.Items))
 
-	for i := range unstructuredObjs.Items {
-		unsObj := unstructuredObjs.Items[i]
+	for i := range list.Items {
+		unsObj := list.Items[i]
 		obj, err := convert(p.client, p.gvk, &unsObj)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := p.registry.IsPrunable(obj); IsUnprunable(err) {
+		if err := p.isPrunable(ctx, pctx, obj); IsUnprunable(err) {
 			continue
 		} else if err != nil {
 			return nil, err
@@ -160,19 +432,77 @@ func (p Pruner) Prune(ctx context.Context) ([]client.Object, error) {
 		objs = append(objs, obj)
 	}
 
-	objsToPrune, err := p.strategy(ctx, objs)
+	return objs, nil
+}
+
+// pruneCandidates runs the strategy on the prunable resources objs, and prunes the resources it
+// selects. The outcome is recorded in result.
+func (p Pruner) pruneCandidates(ctx context.Context, pctx PruneContext, objs []client.Object, result *Result) error {
+	objs, missing, restore := p.applyMissingTimestampPolicy(pctx, objs)
+	result.MissingTimestamps = append(result.MissingTimestamps, missing...)
+
+	strategyResult, err := p.evaluate(ctx, pctx, objs)
 	if err != nil {
-		return nil, fmt.Errorf("error determining prunable objects: %w", err)
+		return fmt.Errorf("error determining prunable objects: %w", err)
+	}
+	objsToPrune := restore(strategyResult.Objects)
+	recordSelections(result, objsToPrune, strategyResult.Selections)
+	if next := strategyResult.NextRun; next > 0 && (result.NextRun == 0 || next < result.NextRun) {
+		result.NextRun = next
+	}
+	SortObjects(objsToPrune)
+
+	plan := Plan{PruneContext: pctx, Objects: objsToPrune}
+	for _, hook := range p.beforeRun {
+		if err := hook(ctx, plan); err != nil {
+			return fmt.Errorf("%w: %w", ErrRunVetoed, err)
+		}
 	}
 
 	// Prune the resources
 	for _, obj := range objsToPrune {
-		if err = p.client.Delete(ctx, obj); err != nil {
-			return nil, fmt.Errorf("error pruning object: %w", err)
+		if p.index != nil {
+			verified, err := p.verify(ctx, pctx, obj)
+			if err != nil {
+				return err
+			}
+			if verified == nil {
+				continue
+			}
+			obj = verified
+		}
+
+		ok, err := p.runPreDeleteHooks(ctx, obj)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			result.Skipped = append(result.Skipped, obj)
+			continue
+		}
+
+		err = p.pruneGroup(ctx, obj, result)
+		switch {
+		case err == nil:
+			log.V(1).Info("Pruned object", "object", client.ObjectKeyFromObject(obj), "selectedBy", selectedBy(result, obj))
+			result.Pruned = append(result.Pruned, obj)
+			p.runPostDeleteHooks(ctx, obj)
+		case errors.Is(err, errDependentsRemain):
+			log.V(1).Info("Keeping object whose dependents could not be pruned", "object", client.ObjectKeyFromObject(obj))
+		case apierrors.IsNotFound(err):
+			log.V(1).Info("Object was already deleted", "object", client.ObjectKeyFromObject(obj))
+			result.AlreadyGone = append(result.AlreadyGone, obj)
+		case IsRetriable(err):
+			log.Error(err, "Giving up on pruning object", "object", client.ObjectKeyFromObject(obj))
+			metrics.RecordError(metrics.SubsystemPrune, "delete_failed")
+			result.Failed = append(result.Failed, FailedDeletion{Obj: obj, Err: err})
+		default:
+			metrics.RecordError(metrics.SubsystemPrune, "delete_failed")
+			return fmt.Errorf("error pruning object: %w", err)
 		}
 	}
 
-	return objsToPrune, nil
+	return nil
 }
 
 // IsUnprunable checks if a given error is that of Unprunable.
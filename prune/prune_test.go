@@ -16,23 +16,54 @@ package prune
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+	clocktesting "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
 	crFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/operator-framework/operator-lib/conditions"
+	"github.com/operator-framework/operator-lib/handler"
+	"github.com/operator-framework/operator-lib/internal/metrics"
+	prunemetrics "github.com/operator-framework/operator-lib/prune/internal/metrics"
 )
 
 const namespace = "default"
@@ -101,6 +132,42 @@ var _ = Describe("Prune", func() {
 			})
 		})
 
+		Describe("IsPrunable() with the ProtectAnnotation", func() {
+			var registry *Registry
+			BeforeEach(func() {
+				registry = NewRegistry()
+				registry.RegisterIsPrunableFunc(podGVK, myIsPrunable)
+			})
+
+			newPod := func(annotations map[string]string) *corev1.Pod {
+				pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+					Name:        app,
+					Namespace:   namespace,
+					Annotations: annotations,
+				}}
+				pod.SetGroupVersionKind(podGVK)
+				return pod
+			}
+
+			It("Should Return Unprunable if the object is protected", func() {
+				err := registry.IsPrunable(newPod(map[string]string{ProtectAnnotation: "true"}))
+				Expect(IsUnprunable(err)).Should(BeTrue())
+				Expect(err).Should(MatchError(ContainSubstring(ProtectAnnotation)))
+			})
+
+			It("Should Return Unprunable if the object is protected and its GVK is not registered", func() {
+				obj := &unstructured.Unstructured{}
+				obj.SetGroupVersionKind(schema.GroupVersionKind{Group: "group", Version: "v1", Kind: "NotReal"})
+				obj.SetAnnotations(map[string]string{ProtectAnnotation: "True"})
+				Expect(IsUnprunable(NewRegistry().IsPrunable(obj))).Should(BeTrue())
+			})
+
+			It("Should Defer to the IsPrunableFunc if the annotation is not truthy", func() {
+				Expect(registry.IsPrunable(newPod(map[string]string{ProtectAnnotation: "false"}))).Should(Succeed())
+				Expect(registry.IsPrunable(newPod(map[string]string{ProtectAnnotation: "maybe"}))).Should(Succeed())
+				Expect(registry.IsPrunable(newPod(nil))).Should(Succeed())
+			})
+		})
 	})
 	Describe("Pruner", func() {
 		Describe("NewPruner()", func() {
@@ -128,6 +195,51 @@ var _ = Describe("Prune", func() {
 				Expect(pruner.client).Should(Equal(fakeClient))
 			})
 
+			It("Should Use the Default Strategy of the GVK When No Strategy is Given", func() {
+				for name, age := range map[string]time.Duration{"old": 2 * time.Hour, "recent": time.Minute} {
+					pod := &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              name,
+							Namespace:         namespace,
+							CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+						},
+						Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					}
+					Expect(fakeClient.Create(context.Background(), pod)).To(Succeed())
+				}
+
+				pruner, err := NewPruner(fakeClient, podGVK, nil, WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				// The default Pod strategy prunes the Pods older than an hour
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(1))
+				Expect(prunedObjects[0].GetName()).Should(Equal("old"))
+			})
+
+			It("Should Use a Default Strategy Registered Globally", func() {
+				Expect(createTestJobs(fakeClient)).To(Succeed())
+
+				previous, _ := DefaultRegistry().DefaultStrategy(jobGVK)
+				DeferCleanup(func() { RegisterDefaultStrategyV2(jobGVK, previous) })
+				RegisterDefaultStrategy(jobGVK, keepNewest(1))
+
+				pruner, err := NewPruner(fakeClient, jobGVK, nil, WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(2))
+			})
+
+			It("Should Error if No Strategy is Given and the GVK Has No Default Strategy", func() {
+				gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+				pruner, err := NewPruner(fakeClient, gvk, nil)
+				Expect(err).Should(MatchError(ContainSubstring("no default strategy registered for example.com/v1, Kind=Widget")))
+				Expect(pruner).Should(BeNil())
+			})
+
 			It("Should Error if schema.GroupVersionKind Parameter is empty", func() {
 				// empty GVK struct
 				pruner, err := NewPruner(fakeClient, schema.GroupVersionKind{}, myStrategy)
@@ -231,6 +343,41 @@ var _ = Describe("Prune", func() {
 					Expect(pods.Items).Should(HaveLen(3))
 				})
 
+				It("Should Treat Resources Deleted Concurrently as Pruned", func() {
+					// Create the test resources - in this case Jobs
+					Expect(createTestJobs(fakeClient)).To(Succeed())
+
+					// Make sure the job resources are properly created
+					jobs := &unstructured.UnstructuredList{}
+					jobs.SetGroupVersionKind(jobGVK)
+					Expect(fakeClient.List(context.Background(), jobs)).To(Succeed())
+					Expect(jobs.Items).Should(HaveLen(3))
+
+					pruner, err := NewPruner(fakeClient, jobGVK, myStrategy, WithLabels(appLabels), WithNamespace(namespace))
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(pruner).ShouldNot(BeNil())
+
+					// IsPrunableFunc that returns nil but also deletes the object, racing
+					// with the Pruner so that the deletion returns NotFound
+					prunableFunc := func(obj client.Object) error {
+						_ = fakeClient.Delete(context.TODO(), obj, &client.DeleteOptions{})
+						return nil
+					}
+
+					// Register our custom IsPrunableFunc
+					RegisterIsPrunableFunc(jobGVK, prunableFunc)
+
+					result, err := pruner.PruneWithResult(context.Background())
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(result.Pruned).Should(BeEmpty())
+					Expect(result.AlreadyGone).Should(HaveLen(2))
+					Expect(result.Failed).Should(BeEmpty())
+
+					// Get a list of the jobs to make sure they are all gone
+					Expect(fakeClient.List(context.Background(), jobs)).To(Succeed())
+					Expect(jobs.Items).Should(BeEmpty())
+				})
+
 				It("Should Skip Pruning a Resource If IsPrunable Returns an Error of Type Unprunable", func() {
 					// Create the test resources - in this case Jobs
 					Expect(createTestJobs(fakeClient)).To(Succeed())
@@ -313,73 +460,2398 @@ var _ = Describe("Prune", func() {
 						return nil, fmt.Errorf("TESTERROR")
 					}
 
-					pruner, err := NewPruner(fakeClient, jobGVK, prunerStrategy, WithLabels(appLabels), WithNamespace(namespace))
-					Expect(err).ShouldNot(HaveOccurred())
-					Expect(pruner).ShouldNot(BeNil())
+					pruner, err := NewPruner(fakeClient, jobGVK, prunerStrategy, WithLabels(appLabels), WithNamespace(namespace))
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(pruner).ShouldNot(BeNil())
+
+					// Register our custom IsPrunableFunc
+					RegisterIsPrunableFunc(jobGVK, myIsPrunable)
+
+					prunedObjects, err := pruner.Prune(context.Background())
+					Expect(err).Should(MatchError("error determining prunable objects: TESTERROR"))
+					Expect(prunedObjects).Should(BeNil())
+
+					// Get a list of the jobs to make sure we have pruned the ones we expected
+					Expect(fakeClient.List(context.Background(), jobs)).To(Succeed())
+					Expect(jobs.Items).Should(HaveLen(3))
+				})
+
+			})
+		})
+
+		Describe("PruneWithResult()", func() {
+			var (
+				testScheme *runtime.Scheme
+				backoff    wait.Backoff
+				attempts   map[string]int
+			)
+			BeforeEach(func() {
+				var err error
+				testScheme, err = createSchemes()
+				Expect(err).ShouldNot(HaveOccurred())
+				backoff = wait.Backoff{Steps: 3, Duration: time.Millisecond, Factor: 1}
+				attempts = map[string]int{}
+
+				RegisterIsPrunableFunc(jobGVK, myIsPrunable)
+			})
+
+			// newFailingClient returns a client whose deletions of the named object fail with err
+			// the given number of times before being passed through.
+			newFailingClient := func(name string, failures int, err error) client.Client {
+				return crFake.NewClientBuilder().WithScheme(testScheme).WithInterceptorFuncs(interceptor.Funcs{
+					Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+						attempts[obj.GetName()]++
+						if obj.GetName() == name && attempts[name] <= failures {
+							return err
+						}
+						return c.Delete(ctx, obj, opts...)
+					},
+				}).Build()
+			}
+
+			It("Should Retry Deletions That Fail With a Retriable Error", func() {
+				conflict := apierrors.NewConflict(schema.GroupResource{Group: "batch", Resource: "jobs"}, "churro1", fmt.Errorf("TEST"))
+				c := newFailingClient("churro1", 2, conflict)
+				Expect(createTestJobs(c)).To(Succeed())
+
+				pruner, err := NewPruner(c, jobGVK, myStrategy, WithNamespace(namespace), WithDeleteBackoff(backoff))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(2))
+				Expect(result.Failed).Should(BeEmpty())
+				Expect(attempts).Should(HaveKeyWithValue("churro1", 3))
+			})
+
+			It("Should Record Deletions That Keep Failing With a Retriable Error", func() {
+				throttled := apierrors.NewTooManyRequests("TEST", 1)
+				c := newFailingClient("churro1", 10, throttled)
+				Expect(createTestJobs(c)).To(Succeed())
+
+				pruner, err := NewPruner(c, jobGVK, myStrategy, WithNamespace(namespace), WithDeleteBackoff(backoff))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(1))
+				Expect(result.Pruned[0].GetName()).Should(Equal("churro2"))
+				Expect(result.Failed).Should(HaveLen(1))
+				Expect(result.Failed[0].Obj.GetName()).Should(Equal("churro1"))
+				Expect(apierrors.IsTooManyRequests(result.Failed[0].Err)).Should(BeTrue())
+				Expect(attempts).Should(HaveKeyWithValue("churro1", backoff.Steps))
+
+				By("returning the pruned objects and an error from Prune()")
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).Should(MatchError(ContainSubstring("error pruning objects")))
+				Expect(prunedObjects).Should(BeEmpty())
+			})
+
+			It("Should Not Retry Deletions of Resources That Are Already Gone", func() {
+				notFound := apierrors.NewNotFound(schema.GroupResource{Group: "batch", Resource: "jobs"}, "churro1")
+				c := newFailingClient("churro1", 10, notFound)
+				Expect(createTestJobs(c)).To(Succeed())
+
+				pruner, err := NewPruner(c, jobGVK, myStrategy, WithNamespace(namespace), WithDeleteBackoff(backoff))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(1))
+				Expect(result.Pruned[0].GetName()).Should(Equal("churro2"))
+				Expect(result.AlreadyGone).Should(HaveLen(1))
+				Expect(result.AlreadyGone[0].GetName()).Should(Equal("churro1"))
+				Expect(result.Failed).Should(BeEmpty())
+				Expect(attempts).Should(HaveKeyWithValue("churro1", 1))
+			})
+
+			It("Should Not Retry Deletions That Fail With a Non-Retriable Error", func() {
+				forbidden := apierrors.NewForbidden(schema.GroupResource{Group: "batch", Resource: "jobs"}, "churro1", fmt.Errorf("TEST"))
+				c := newFailingClient("churro1", 10, forbidden)
+				Expect(createTestJobs(c)).To(Succeed())
+
+				pruner, err := NewPruner(c, jobGVK, myStrategy, WithNamespace(namespace), WithDeleteBackoff(backoff))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				deleteErrors := metrics.Errors.WithLabelValues(metrics.SubsystemPrune, "delete_failed")
+				before := testutil.ToFloat64(deleteErrors)
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(apierrors.IsForbidden(err)).Should(BeTrue())
+				Expect(result).Should(BeNil())
+				Expect(attempts).Should(HaveKeyWithValue("churro1", 1))
+				Expect(testutil.ToFloat64(deleteErrors)).Should(Equal(before + 1))
+			})
+
+			It("Should Delete Resources Oldest First Regardless of the Strategy's Order", func() {
+				c := crFake.NewClientBuilder().WithScheme(testScheme).Build()
+				Expect(createTestJobs(c)).To(Succeed())
+
+				reversed := func(_ context.Context, objs []client.Object) ([]client.Object, error) {
+					var objsToPrune []client.Object
+					for i := len(objs) - 1; i >= 0; i-- {
+						objsToPrune = append(objsToPrune, objs[i])
+					}
+					return objsToPrune, nil
+				}
+				pruner, err := NewPruner(c, jobGVK, reversed, WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				var names []string
+				for _, obj := range result.Pruned {
+					names = append(names, obj.GetName())
+				}
+				Expect(names).Should(Equal([]string{"churro0", "churro1", "churro2"}))
+			})
+		})
+
+		Describe("IsRetriable()", func() {
+			gr := schema.GroupResource{Group: "batch", Resource: "jobs"}
+			It("Should Return true for Transient Errors", func() {
+				Expect(IsRetriable(apierrors.NewConflict(gr, "churro", fmt.Errorf("TEST")))).Should(BeTrue())
+				Expect(IsRetriable(apierrors.NewTooManyRequests("TEST", 1))).Should(BeTrue())
+				Expect(IsRetriable(apierrors.NewTimeoutError("TEST", 1))).Should(BeTrue())
+				Expect(IsRetriable(apierrors.NewServerTimeout(gr, "delete", 1))).Should(BeTrue())
+				Expect(IsRetriable(apierrors.NewServiceUnavailable("TEST"))).Should(BeTrue())
+			})
+
+			It("Should Return false for Other Errors", func() {
+				Expect(IsRetriable(apierrors.NewNotFound(gr, "churro"))).Should(BeFalse())
+				Expect(IsRetriable(apierrors.NewForbidden(gr, "churro", fmt.Errorf("TEST")))).Should(BeFalse())
+				Expect(IsRetriable(fmt.Errorf("TEST"))).Should(BeFalse())
+			})
+		})
+
+		Describe("WithOrphanCleanup()", func() {
+			var c client.Client
+			BeforeEach(func() {
+				testScheme, err := createSchemes()
+				Expect(err).ShouldNot(HaveOccurred())
+				c = crFake.NewClientBuilder().WithScheme(testScheme).Build()
+				RegisterIsPrunableFunc(jobGVK, myIsPrunable)
+
+				for i := 0; i < 3; i++ {
+					job := &unstructured.Unstructured{}
+					job.SetGroupVersionKind(jobGVK)
+					job.SetName(fmt.Sprintf("churro%d", i))
+					job.SetNamespace(namespace)
+					job.SetUID(types.UID(fmt.Sprintf("uid-churro%d", i)))
+					Expect(c.Create(context.Background(), job)).To(Succeed())
+				}
+
+				newPod := func(name string, mutate func(pod *unstructured.Unstructured)) {
+					pod := &unstructured.Unstructured{}
+					pod.SetGroupVersionKind(podGVK)
+					pod.SetName(name)
+					pod.SetNamespace(namespace)
+					mutate(pod)
+					Expect(c.Create(context.Background(), pod)).To(Succeed())
+				}
+				newPod("owned-by-ref", func(pod *unstructured.Unstructured) {
+					pod.SetOwnerReferences([]metav1.OwnerReference{
+						{APIVersion: "batch/v1", Kind: "Job", Name: "churro1", UID: "uid-churro1"},
+						{APIVersion: "v1", Kind: "ConfigMap", Name: "other", UID: "uid-other"},
+					})
+				})
+				newPod("owned-by-annotation", func(pod *unstructured.Unstructured) {
+					pod.SetAnnotations(map[string]string{
+						handler.NamespacedNameAnnotation: namespace + "/churro2",
+						handler.TypeAnnotation:           "Job.batch",
+					})
+				})
+				newPod("owned-by-kept", func(pod *unstructured.Unstructured) {
+					pod.SetOwnerReferences([]metav1.OwnerReference{
+						{APIVersion: "batch/v1", Kind: "Job", Name: "churro0", UID: "uid-churro0"},
+					})
+				})
+			})
+
+			listPods := func() []unstructured.Unstructured {
+				pods := &unstructured.UnstructuredList{}
+				pods.SetGroupVersionKind(podGVK)
+				Expect(c.List(context.Background(), pods)).To(Succeed())
+				return pods.Items
+			}
+
+			It("Should Delete the Dependents of Pruned Objects", func() {
+				pruner, err := NewPruner(c, jobGVK, myStrategy, WithNamespace(namespace),
+					WithOrphanCleanup(OrphanPolicyDelete, podGVK))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(2))
+				Expect(result.Orphans).Should(HaveLen(2))
+
+				pods := listPods()
+				Expect(pods).Should(HaveLen(1))
+				Expect(pods[0].GetName()).Should(Equal("owned-by-kept"))
+			})
+
+			It("Should Re-label the Dependents of Pruned Objects", func() {
+				pruner, err := NewPruner(c, jobGVK, myStrategy, WithNamespace(namespace),
+					WithOrphanCleanup(OrphanPolicyRelabel, podGVK))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Orphans).Should(HaveLen(2))
+
+				pods := listPods()
+				Expect(pods).Should(HaveLen(3))
+				for _, pod := range pods {
+					switch pod.GetName() {
+					case "owned-by-ref":
+						Expect(pod.GetLabels()).Should(HaveKeyWithValue(OrphanedLabel, "true"))
+						Expect(pod.GetOwnerReferences()).Should(HaveLen(1))
+						Expect(pod.GetOwnerReferences()[0].Name).Should(Equal("other"))
+					case "owned-by-annotation":
+						Expect(pod.GetLabels()).Should(HaveKeyWithValue(OrphanedLabel, "true"))
+						Expect(pod.GetAnnotations()).ShouldNot(HaveKey(handler.NamespacedNameAnnotation))
+						Expect(pod.GetAnnotations()).ShouldNot(HaveKey(handler.TypeAnnotation))
+					default:
+						Expect(pod.GetLabels()).ShouldNot(HaveKey(OrphanedLabel))
+					}
+				}
+			})
+
+			for _, policy := range []OrphanPolicy{OrphanPolicyDelete, OrphanPolicyRelabel} {
+				It(fmt.Sprintf("Should Keep Protected Dependents with the %s Policy", policy), func() {
+					pod := &unstructured.Unstructured{}
+					pod.SetGroupVersionKind(podGVK)
+					Expect(c.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: "owned-by-ref"}, pod)).To(Succeed())
+					pod.SetAnnotations(map[string]string{ProtectAnnotation: "true"})
+					Expect(c.Update(context.Background(), pod)).To(Succeed())
+
+					pruner, err := NewPruner(c, jobGVK, myStrategy, WithNamespace(namespace),
+						WithOrphanCleanup(policy, podGVK))
+					Expect(err).ShouldNot(HaveOccurred())
+
+					result, err := pruner.PruneWithResult(context.Background())
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(result.Pruned).Should(HaveLen(2))
+					Expect(result.Orphans).Should(HaveLen(1))
+
+					Expect(c.Get(context.Background(), client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+					Expect(pod.GetLabels()).ShouldNot(HaveKey(OrphanedLabel))
+					Expect(pod.GetOwnerReferences()).Should(HaveLen(2))
+				})
+			}
+
+			It("Should Not Look for Dependents When Disabled", func() {
+				pruner, err := NewPruner(c, jobGVK, myStrategy, WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Orphans).Should(BeEmpty())
+				Expect(listPods()).Should(HaveLen(3))
+			})
+		})
+
+		Describe("WithCandidateIndex()", func() {
+			var (
+				c        client.Client
+				lists    int
+				informer *controllertest.FakeInformer
+				idx      *CandidateIndex
+				jobs     []*unstructured.Unstructured
+			)
+			BeforeEach(func() {
+				ctx := context.Background()
+				testScheme, err := createSchemes()
+				Expect(err).ShouldNot(HaveOccurred())
+				lists = 0
+				c = crFake.NewClientBuilder().WithScheme(testScheme).WithInterceptorFuncs(interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						lists++
+						return c.List(ctx, list, opts...)
+					},
+				}).Build()
+				RegisterIsPrunableFunc(jobGVK, myIsPrunable)
+
+				informers := &informertest.FakeInformers{Scheme: testScheme}
+				idx, err = NewCandidateIndex(ctx, readerCache{FakeInformers: informers, reader: c}, jobGVK)
+				Expect(err).ShouldNot(HaveOccurred())
+				informer, err = informers.FakeInformerForKind(ctx, jobGVK)
+				Expect(err).ShouldNot(HaveOccurred())
+				informer.Synced = true
+
+				jobs = nil
+				for i := 0; i < 3; i++ {
+					job := &unstructured.Unstructured{}
+					job.SetGroupVersionKind(jobGVK)
+					job.SetName(fmt.Sprintf("churro%d", i))
+					job.SetNamespace(namespace)
+					job.SetUID(types.UID(fmt.Sprintf("uid-churro%d", i)))
+					Expect(c.Create(ctx, job)).To(Succeed())
+					informer.Add(job)
+					jobs = append(jobs, job)
+				}
+			})
+
+			It("Should Prune Objects From the Index Without Listing Them", func() {
+				Expect(idx.Len(namespace)).Should(Equal(3))
+				Expect(idx.Len("")).Should(Equal(3))
+
+				pruner, err := NewPruner(c, jobGVK, myStrategy, WithNamespace(namespace), WithCandidateIndex(idx))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(2))
+				Expect(lists).Should(Equal(0))
+
+				remaining := &unstructured.UnstructuredList{}
+				remaining.SetGroupVersionKind(jobGVK)
+				Expect(c.List(context.Background(), remaining)).To(Succeed())
+				Expect(remaining.Items).Should(HaveLen(1))
+				Expect(remaining.Items[0].GetName()).Should(Equal("churro0"))
+			})
+
+			It("Should Skip Objects That Were Deleted or Recreated Since They Were Indexed", func() {
+				Expect(c.Delete(context.Background(), jobs[2])).To(Succeed())
+				Expect(c.Delete(context.Background(), jobs[1])).To(Succeed())
+				recreated := jobs[1].DeepCopy()
+				recreated.SetResourceVersion("")
+				recreated.SetUID("uid-churro1-new")
+				Expect(c.Create(context.Background(), recreated)).To(Succeed())
+
+				pruner, err := NewPruner(c, jobGVK, myStrategy, WithNamespace(namespace), WithCandidateIndex(idx))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(BeEmpty())
+				Expect(idx.Len(namespace)).Should(Equal(2))
+			})
+
+			It("Should Only Read the Oldest Candidates When Bounded", func() {
+				bounded, err := NewCandidateIndex(context.Background(), readerCache{FakeInformers: &informertest.FakeInformers{Scheme: c.Scheme()}, reader: c}, jobGVK, WithMaxCandidates(2))
+				Expect(err).ShouldNot(HaveOccurred())
+				for i, job := range jobs {
+					job.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-time.Duration(i) * time.Hour)))
+					bounded.upsert(job)
+				}
+				bounded.informer.(*controllertest.FakeInformer).Synced = true
+
+				var seen []string
+				pruner, err := NewPruner(c, jobGVK, func(_ context.Context, objs []client.Object) ([]client.Object, error) {
+					for _, obj := range objs {
+						seen = append(seen, obj.GetName())
+					}
+					return nil, nil
+				}, WithNamespace(namespace), WithCandidateIndex(bounded))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(seen).Should(Equal([]string{"churro2", "churro1"}))
+				Expect(bounded.Len(namespace)).Should(Equal(3))
+			})
+
+			It("Should Forget Objects on Delete Events", func() {
+				informer.Delete(jobs[0])
+				Expect(idx.Len(namespace)).Should(Equal(2))
+			})
+
+			It("Should Return an Error if the Index Has Not Synced", func() {
+				informer.Synced = false
+				pruner, err := NewPruner(c, jobGVK, myStrategy, WithNamespace(namespace), WithCandidateIndex(idx))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = pruner.Prune(context.Background())
+				Expect(err).Should(MatchError(ContainSubstring("has not synced")))
+			})
+
+			It("Should Return an Error if the Index is for Another GVK", func() {
+				pruner, err := NewPruner(c, podGVK, myStrategy, WithNamespace(namespace), WithCandidateIndex(idx))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = pruner.Prune(context.Background())
+				Expect(err).Should(MatchError(ContainSubstring("candidate index is for")))
+			})
+		})
+
+		Describe("WithFieldSelector()", func() {
+			var (
+				testScheme *runtime.Scheme
+				selector   fields.Selector
+				selectors  []string
+			)
+			BeforeEach(func() {
+				var err error
+				testScheme, err = createSchemes()
+				Expect(err).ShouldNot(HaveOccurred())
+				selector = fields.OneTermNotEqualSelector("metadata.name", "churro1")
+				selectors = nil
+			})
+
+			// newSelectorClient returns a client recording the field selectors it is given. If supported is
+			// false, lists with a field selector are rejected the way the API server rejects unsupported fields.
+			newSelectorClient := func(supported bool) client.Client {
+				return crFake.NewClientBuilder().WithScheme(testScheme).WithInterceptorFuncs(interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						listOpts := &client.ListOptions{}
+						listOpts.ApplyOptions(opts)
+						if listOpts.FieldSelector == nil {
+							return c.List(ctx, list, opts...)
+						}
+						selectors = append(selectors, listOpts.FieldSelector.String())
+						if !supported {
+							return apierrors.NewBadRequest("field label not supported: " + listOpts.FieldSelector.String())
+						}
+						// The fake client requires an index for field selectors, so let it match everything.
+						listOpts.FieldSelector = nil
+						return c.List(ctx, list, listOpts)
+					},
+				}).Build()
+			}
+
+			pruneAll := func(_ context.Context, objs []client.Object) ([]client.Object, error) {
+				return objs, nil
+			}
+
+			It("Should Pass the Field Selector to the API Server", func() {
+				c := newSelectorClient(true)
+				Expect(createTestPods(c)).To(Succeed())
+
+				pruner, err := NewPruner(c, podGVK, pruneAll, WithNamespace(namespace), WithFieldSelector(selector))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruner.FieldSelector()).Should(Equal(selector))
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(3))
+				Expect(selectors).Should(Equal([]string{"metadata.name!=churro1"}))
+			})
+
+			It("Should Filter Client-Side When the Field Selector is not Supported", func() {
+				c := newSelectorClient(false)
+				Expect(createTestPods(c)).To(Succeed())
+
+				pruner, err := NewPruner(c, podGVK, pruneAll, WithNamespace(namespace), WithFieldSelector(selector))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(2))
+				Expect(prunedObjects[0].GetName()).Should(Equal("churro0"))
+				Expect(prunedObjects[1].GetName()).Should(Equal("churro2"))
+
+				pods := &unstructured.UnstructuredList{}
+				pods.SetGroupVersionKind(podGVK)
+				Expect(c.List(context.Background(), pods)).To(Succeed())
+				Expect(pods.Items).Should(HaveLen(1))
+				Expect(pods.Items[0].GetName()).Should(Equal("churro1"))
+			})
+
+			It("Should Parse Field Selector Strings", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithFieldSelectorString("status.phase=Succeeded"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruner.FieldSelector().String()).Should(Equal("status.phase=Succeeded"))
+
+				_, err = NewPruner(fakeClient, podGVK, pruneAll, WithFieldSelectorString("status.phase"))
+				Expect(err).Should(MatchError(ContainSubstring("invalid field selector")))
+			})
+
+			It("Should Filter on Nested Fields Client-Side", func() {
+				pod := &unstructured.Unstructured{Object: map[string]interface{}{
+					"status": map[string]interface{}{"phase": "Succeeded"},
+				}}
+				matches, err := matchesFieldSelector(pod, fields.OneTermEqualSelector("status.phase", "Succeeded"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(matches).Should(BeTrue())
+
+				matches, err = matchesFieldSelector(pod, fields.OneTermEqualSelector("status.reason", "Evicted"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(matches).Should(BeFalse())
+			})
+		})
+
+		Describe("PruneContext", func() {
+			It("Should Pass the PruneContext to a StrategyFuncV2", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+
+				var got PruneContext
+				strategy := func(_ context.Context, pctx PruneContext, objs []client.Object) (StrategyResult, error) {
+					got = pctx
+					return StrategyResult{Objects: objs}, nil
+				}
+				pruner, err := NewPruner(fakeClient, podGVK, nil, WithStrategyV2(strategy), WithLabels(appLabels), WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(3))
+				Expect(got.GVK).Should(Equal(podGVK))
+				Expect(got.Namespace).Should(Equal(namespace))
+				Expect(got.LabelSelector.String()).Should(Equal("app=churro"))
+				Expect(got.RunID).ShouldNot(BeEmpty())
+				Expect(got.DryRun).Should(BeFalse())
+			})
+
+			It("Should Make the PruneContext Available to a StrategyFunc", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+
+				var got PruneContext
+				var found bool
+				strategy := func(ctx context.Context, objs []client.Object) ([]client.Object, error) {
+					got, found = PruneContextFrom(ctx)
+					return nil, nil
+				}
+				pruner, err := NewPruner(fakeClient, podGVK, strategy, WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(found).Should(BeTrue())
+				Expect(got.GVK).Should(Equal(podGVK))
+			})
+
+			It("Should Pass the PruneContext to an IsPrunableFuncV2", func() {
+				Expect(createTestJobs(fakeClient)).To(Succeed())
+
+				var runIDs []string
+				previous := DefaultRegistry().prunables[jobGVK]
+				DeferCleanup(func() { DefaultRegistry().prunables[jobGVK] = previous })
+				RegisterIsPrunableFuncV2(jobGVK, func(_ context.Context, pctx PruneContext, _ client.Object) error {
+					runIDs = append(runIDs, pctx.RunID)
+					return nil
+				})
+				pruner, err := NewPruner(fakeClient, jobGVK, myStrategy, WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(runIDs).Should(HaveLen(3))
+				Expect(runIDs[0]).ShouldNot(BeEmpty())
+				Expect(runIDs).Should(HaveEach(runIDs[0]))
+			})
+		})
+
+		Describe("WithClock()", func() {
+			It("Should Pass the Clock to Strategies", func() {
+				for name, age := range map[string]time.Duration{"old": 2 * time.Hour, "recent": time.Minute} {
+					pod := &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              name,
+							Namespace:         namespace,
+							CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+						},
+						Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					}
+					Expect(fakeClient.Create(context.Background(), pod)).To(Succeed())
+				}
+
+				fakeClock := clocktesting.NewFakePassiveClock(time.Now())
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneOlderThan(3*time.Hour), WithNamespace(namespace), WithClock(fakeClock))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(BeEmpty())
+
+				fakeClock.SetTime(time.Now().Add(2 * time.Hour))
+				prunedObjects, err = pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(1))
+				Expect(prunedObjects[0].GetName()).Should(Equal("old"))
+			})
+		})
+
+		Describe("NextRun", func() {
+			It("Should Report When the Next Object Becomes Prunable", func() {
+				now := time.Now()
+				for name, age := range map[string]time.Duration{"old": 2 * time.Hour, "recent": 30 * time.Minute, "new": time.Minute} {
+					pod := &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              name,
+							Namespace:         namespace,
+							CreationTimestamp: metav1.NewTime(now.Add(-age)),
+						},
+						Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					}
+					Expect(fakeClient.Create(context.Background(), pod)).To(Succeed())
+				}
+
+				fakeClock := clocktesting.NewFakePassiveClock(now)
+				pruner, err := NewPruner(fakeClient, podGVK, nil, WithStrategyV2(NewPruneOlderThanV2(time.Hour)),
+					WithNamespace(namespace), WithClock(fakeClock))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(1))
+				Expect(result.Pruned[0].GetName()).Should(Equal("old"))
+				Expect(result.NextRun).Should(BeNumerically("~", 30*time.Minute, time.Second))
+				Expect(result.Selections).Should(HaveLen(1))
+				Expect(result.Selections[client.ObjectKeyFromObject(result.Pruned[0])].String()).Should(Equal("OlderThan(maxAge=1h0m0s)"))
+			})
+
+			It("Should Not Give a Hint Without Remaining Objects", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, nil, WithStrategyV2(NewPruneOlderThanV2(time.Hour)), WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.NextRun).Should(BeZero())
+			})
+
+			It("Should Not Give a Hint With StrategyFuncs", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.NextRun).Should(BeZero())
+			})
+		})
+
+		Describe("Selections", func() {
+			It("Should Record the Policy That Selected Each Pruned Object", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				selection := Selection{Strategy: "ByCount", Parameters: map[string]string{"count": "1"}}
+				pruner, err := NewPruner(fakeClient, podGVK, nil, WithNamespace(namespace),
+					WithStrategyV2(StrategyV2WithSelection(NewPruneByCountStrategy(1), selection)))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(2))
+				Expect(result.Selections).Should(HaveLen(2))
+				for _, obj := range result.Pruned {
+					Expect(result.Selections).Should(HaveKeyWithValue(client.ObjectKeyFromObject(obj), selection))
+				}
+			})
+
+			It("Should Not Record Selections for StrategyFuncs", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(2))
+				Expect(result.Selections).Should(BeEmpty())
+			})
+
+			It("Should Describe a Selection With Its Sorted Parameters", func() {
+				selection := Selection{Strategy: "Custom", Parameters: map[string]string{"b": "2", "a": "1"}}
+				Expect(selection.String()).Should(Equal("Custom(a=1, b=2)"))
+				Expect(Selection{Strategy: "Custom"}.String()).Should(Equal("Custom()"))
+			})
+		})
+
+		Describe("WithBeforeRun() and WithAfterRun()", func() {
+			BeforeEach(func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+			})
+
+			It("Should Pass the Plan Before Pruning and the Result After", func() {
+				var plan Plan
+				var remaining int
+				var after *Result
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace),
+					WithBeforeRun(func(ctx context.Context, p Plan) error {
+						plan = p
+						pods := &corev1.PodList{}
+						Expect(fakeClient.List(ctx, pods, client.InNamespace(namespace))).To(Succeed())
+						remaining = len(pods.Items)
+						return nil
+					}),
+					WithAfterRun(func(_ context.Context, r *Result) {
+						after = r
+					}))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(2))
+				Expect(plan.Objects).Should(Equal(result.Pruned))
+				Expect(plan.GVK).Should(Equal(podGVK))
+				Expect(remaining).Should(Equal(3))
+				Expect(after).Should(BeIdenticalTo(result))
+			})
+
+			It("Should Not Prune When Vetoed", func() {
+				errBackup := errors.New("backup failed")
+				var calls []string
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace),
+					WithBeforeRun(func(context.Context, Plan) error {
+						calls = append(calls, "first")
+						return errBackup
+					}),
+					WithBeforeRun(func(context.Context, Plan) error {
+						calls = append(calls, "second")
+						return nil
+					}),
+					WithAfterRun(func(context.Context, *Result) {
+						calls = append(calls, "after")
+					}))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = pruner.PruneWithResult(context.Background())
+				Expect(err).Should(MatchError(ErrRunVetoed))
+				Expect(err).Should(MatchError(errBackup))
+				Expect(calls).Should(Equal([]string{"first"}))
+
+				pods := &corev1.PodList{}
+				Expect(fakeClient.List(context.Background(), pods, client.InNamespace(namespace))).To(Succeed())
+				Expect(pods.Items).Should(HaveLen(3))
+			})
+		})
+
+		Describe("WithPreDeleteHook() and WithPostDeleteHook()", func() {
+			BeforeEach(func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+			})
+
+			It("Should Call the Hooks Around Each Deletion", func() {
+				var calls []string
+				var dryRun bool
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace),
+					WithPreDeleteHook(func(ctx context.Context, obj client.Object) error {
+						pctx, ok := PruneContextFrom(ctx)
+						Expect(ok).Should(BeTrue())
+						dryRun = pctx.DryRun
+						Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), &corev1.Pod{})).To(Succeed())
+						calls = append(calls, "pre "+obj.GetName())
+						return nil
+					}),
+					WithPostDeleteHook(func(ctx context.Context, obj client.Object) error {
+						err := fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), &corev1.Pod{})
+						Expect(apierrors.IsNotFound(err)).Should(BeTrue())
+						calls = append(calls, "post "+obj.GetName())
+						return errors.New("ignored")
+					}))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(2))
+				Expect(dryRun).Should(BeFalse())
+				Expect(calls).Should(Equal([]string{
+					"pre " + result.Pruned[0].GetName(), "post " + result.Pruned[0].GetName(),
+					"pre " + result.Pruned[1].GetName(), "post " + result.Pruned[1].GetName(),
+				}))
+			})
+
+			It("Should Keep Objects Skipped by a Pre-Delete Hook", func() {
+				var postCalls int
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace),
+					WithPreDeleteHook(func(_ context.Context, obj client.Object) error {
+						if obj.GetName() == "churro1" {
+							return fmt.Errorf("still archiving: %w", ErrSkipDeletion)
+						}
+						return nil
+					}),
+					WithPostDeleteHook(func(context.Context, client.Object) error {
+						postCalls++
+						return nil
+					}))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(1))
+				Expect(result.Skipped).Should(HaveLen(1))
+				Expect(result.Skipped[0].GetName()).Should(Equal("churro1"))
+				Expect(postCalls).Should(Equal(1))
+
+				pods := &corev1.PodList{}
+				Expect(fakeClient.List(context.Background(), pods, client.InNamespace(namespace))).To(Succeed())
+				Expect(pods.Items).Should(HaveLen(2))
+			})
+
+			It("Should Abort the Run When a Pre-Delete Hook Fails", func() {
+				errDrain := errors.New("drain failed")
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace),
+					WithPreDeleteHook(func(context.Context, client.Object) error {
+						return errDrain
+					}))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = pruner.PruneWithResult(context.Background())
+				Expect(err).Should(MatchError(errDrain))
+
+				pods := &corev1.PodList{}
+				Expect(fakeClient.List(context.Background(), pods, client.InNamespace(namespace))).To(Succeed())
+				Expect(pods.Items).Should(HaveLen(3))
+			})
+		})
+
+		Describe("WithMissingTimestampPolicy()", func() {
+			BeforeEach(func() {
+				dated := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "dated",
+						Namespace:         namespace,
+						CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+					},
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+				}
+				Expect(fakeClient.Create(context.Background(), dated)).To(Succeed())
+				undated := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "undated", Namespace: namespace},
+					Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+				}
+				Expect(fakeClient.Create(context.Background(), undated)).To(Succeed())
+			})
+
+			It("Should Treat Objects Without a creationTimestamp as the Oldest by Default", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneOlderThan(2*time.Hour), WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(1))
+				Expect(result.Pruned[0].GetName()).Should(Equal("undated"))
+				Expect(result.MissingTimestamps).Should(HaveLen(1))
+				Expect(result.MissingTimestamps[0].GetName()).Should(Equal("undated"))
+			})
+
+			It("Should Treat Objects Without a creationTimestamp as the Newest", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneOlderThan(30*time.Minute), WithNamespace(namespace),
+					WithMissingTimestampPolicy(MissingTimestampAsNewest))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(1))
+				Expect(result.Pruned[0].GetName()).Should(Equal("dated"))
+				Expect(result.MissingTimestamps).Should(HaveLen(1))
+				Expect(result.MissingTimestamps[0].GetName()).Should(Equal("undated"))
+			})
+
+			It("Should Report the Original Objects When Treating Them as the Newest", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(0), WithNamespace(namespace),
+					WithMissingTimestampPolicy(MissingTimestampAsNewest))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(2))
+				Expect(result.Pruned).Should(ContainElement(result.MissingTimestamps[0]))
+				for _, obj := range result.Pruned {
+					if obj.GetName() == "undated" {
+						created := obj.GetCreationTimestamp()
+						Expect(created.IsZero()).Should(BeTrue())
+					}
+				}
+			})
+
+			It("Should Skip Objects Without a creationTimestamp", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(0), WithNamespace(namespace),
+					WithMissingTimestampPolicy(MissingTimestampSkip))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(1))
+				Expect(result.Pruned[0].GetName()).Should(Equal("dated"))
+				Expect(result.MissingTimestamps).Should(HaveLen(1))
+				Expect(result.MissingTimestamps[0].GetName()).Should(Equal("undated"))
+			})
+
+			It("Should Return an Error for an Unknown Policy", func() {
+				_, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(1), WithMissingTimestampPolicy("Sometimes"))
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		Describe("WithDryRun()", func() {
+			It("Should Not Delete the Objects Selected by the Strategy", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+
+				var dryRun bool
+				strategy := func(_ context.Context, pctx PruneContext, objs []client.Object) (StrategyResult, error) {
+					dryRun = pctx.DryRun
+					return StrategyResult{Objects: objs}, nil
+				}
+				pruner, err := NewPruner(fakeClient, podGVK, nil, WithStrategyV2(strategy), WithNamespace(namespace), WithDryRun())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(3))
+				Expect(dryRun).Should(BeTrue())
+
+				pods := &unstructured.UnstructuredList{}
+				pods.SetGroupVersionKind(podGVK)
+				Expect(fakeClient.List(context.Background(), pods)).To(Succeed())
+				Expect(pods.Items).Should(HaveLen(3))
+			})
+		})
+
+		Describe("WithSafeMode()", func() {
+			ownerKind := schema.GroupKind{Group: "example.com", Kind: "Memcached"}
+
+			newSafeModePod := func(name string, mutate func(pod *corev1.Pod)) *corev1.Pod {
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+				}
+				if mutate != nil {
+					mutate(pod)
+				}
+				return pod
+			}
+
+			BeforeEach(func() {
+				for _, pod := range []*corev1.Pod{
+					newSafeModePod("free", nil),
+					newSafeModePod("owned", func(pod *corev1.Pod) {
+						pod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "example.com/v1", Kind: "Memcached", Name: "cache", UID: "cache-uid"}}
+					}),
+					newSafeModePod("foreign", func(pod *corev1.Pod) {
+						pod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs", UID: "rs-uid"}}
+					}),
+					newSafeModePod("protected", func(pod *corev1.Pod) {
+						pod.Finalizers = []string{"example.com/in-use-protection"}
+					}),
+				} {
+					Expect(fakeClient.Create(context.Background(), pod)).To(Succeed())
+				}
+				deleting := newSafeModePod("deleting", func(pod *corev1.Pod) {
+					pod.Finalizers = []string{"example.com/cleanup"}
+				})
+				Expect(fakeClient.Create(context.Background(), deleting)).To(Succeed())
+				Expect(fakeClient.Delete(context.Background(), deleting)).To(Succeed())
+			})
+
+			pruneAll := func(_ context.Context, objs []client.Object) ([]client.Object, error) {
+				return objs, nil
+			}
+
+			It("Should Keep Objects Another Controller May Still Manage", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithNamespace(namespace), WithSafeMode(ownerKind))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				names := make([]string, 0, len(prunedObjects))
+				for _, obj := range prunedObjects {
+					names = append(names, obj.GetName())
+				}
+				Expect(names).Should(ConsistOf("free", "owned"))
+			})
+
+			It("Should Not Check Objects Without Safe Mode", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(5))
+			})
+		})
+
+		Describe("ConfigLoader", func() {
+			var (
+				c         client.Client
+				key       types.NamespacedName
+				condition *fakeCondition
+				loader    *ConfigLoader
+			)
+
+			newConfigMap := func(data string) *corev1.ConfigMap {
+				return &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+					Data:       map[string]string{RetentionConfigKey: data},
+				}
+			}
+
+			BeforeEach(func() {
+				// the test scheme has no ConfigMaps
+				c = crFake.NewClientBuilder().Build()
+				Expect(createTestPods(c)).To(Succeed())
+				key = types.NamespacedName{Namespace: "operators", Name: "retention"}
+				condition = &fakeCondition{}
+				loader = NewConfigLoader(c, key, WithConfigCondition(condition))
+			})
+
+			It("Should Restrict the Cache to the ConfigMap", func() {
+				selector := ConfigCacheByObject(key).Field
+				Expect(selector.Matches(fields.Set{"metadata.name": key.Name, "metadata.namespace": key.Namespace})).Should(BeTrue())
+				Expect(selector.Matches(fields.Set{"metadata.name": key.Name, "metadata.namespace": "other"})).Should(BeFalse())
+				Expect(selector.Matches(fields.Set{"metadata.name": "other", "metadata.namespace": key.Namespace})).Should(BeFalse())
+			})
+
+			It("Should Not Prune Without a ConfigMap", func() {
+				Expect(loader.Load(context.Background())).To(Succeed())
+				Expect(loader.Pruners()).Should(BeEmpty())
+				Expect(condition.status).Should(Equal(metav1.ConditionTrue))
+				Expect(condition.reason).Should(Equal(RetentionConfigLoadedReason))
+			})
+
+			It("Should Load the Pruners of the Retention Rules", func() {
+				Expect(c.Create(context.Background(), newConfigMap(`
+rules:
+- apiVersion: v1
+  kind: Pod
+  namespace: `+namespace+`
+  labelSelector: app in (churro)
+  maxCount: 1
+`))).To(Succeed())
+
+				_, err := loader.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+				Expect(err).ShouldNot(HaveOccurred())
+				pruners := loader.Pruners()
+				Expect(pruners).Should(HaveLen(1))
+				Expect(pruners[0].GVK()).Should(Equal(podGVK))
+				Expect(pruners[0].Namespace()).Should(Equal(namespace))
+				Expect(condition.status).Should(Equal(metav1.ConditionTrue))
+
+				Expect(loader.Prune(context.Background())).To(Succeed())
+				pods := &corev1.PodList{}
+				Expect(c.List(context.Background(), pods, client.InNamespace(namespace))).To(Succeed())
+				Expect(pods.Items).Should(HaveLen(1))
+			})
+
+			It("Should Keep the Previous Pruners When the Configuration Becomes Invalid", func() {
+				cm := newConfigMap("rules:\n- apiVersion: v1\n  kind: Pod\n  maxAge: 1h\n")
+				Expect(c.Create(context.Background(), cm)).To(Succeed())
+				Expect(loader.Load(context.Background())).To(Succeed())
+				Expect(loader.Pruners()).Should(HaveLen(1))
+
+				cm.Data[RetentionConfigKey] = "rules:\n- apiVersion: v1\n  kind: Pod\n  maxCount: -1\n"
+				Expect(c.Update(context.Background(), cm)).To(Succeed())
+				Expect(loader.Load(context.Background())).Should(MatchError(ContainSubstring("maxCount must not be negative")))
+				Expect(loader.Pruners()).Should(HaveLen(1))
+				Expect(condition.status).Should(Equal(metav1.ConditionFalse))
+				Expect(condition.reason).Should(Equal(RetentionConfigInvalidReason))
+				Expect(condition.message).Should(ContainSubstring("invalid retention rule 0"))
+
+				_, err := loader.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("Should Reject Invalid Retention Rules", func() {
+				for data, message := range map[string]string{
+					"rules:\n- apiVersion: example.com/v1\n  kind: Unknown\n":                "not registered in the scheme",
+					"rules:\n- apiVersion: v1\n  kind: Pod\n  maxAge: -1h\n":                 "maxAge must be positive",
+					"rules:\n- apiVersion: v1\n  kind: Pod\n  typo: true\n":                  "error parsing retention configuration",
+					"rules:\n- apiVersion: v1\n  kind: Pod\n  fieldSelector: status.phase\n": "invalid field selector",
+				} {
+					config, err := ParseRetentionConfig([]byte(data))
+					if err == nil {
+						_, err = config.NewPruners(c)
+					}
+					Expect(err).Should(MatchError(ContainSubstring(message)), data)
+				}
+			})
+		})
+
+		Describe("WithDeleteOptions()", func() {
+			It("Should Send the Options With Every Deletion", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+
+				var deletions []client.DeleteOptions
+				c := interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+					Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+						deleteOpts := client.DeleteOptions{}
+						deleteOpts.ApplyOptions(opts)
+						deletions = append(deletions, deleteOpts)
+						return c.Delete(ctx, obj, opts...)
+					},
+				})
+				pruner, err := NewPruner(c, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace),
+					WithPropagationPolicy(metav1.DeletePropagationForeground), WithGracePeriod(30*time.Second),
+					WithDeleteOptions(client.Preconditions{}), WithDryRun())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(2))
+				Expect(deletions).Should(HaveLen(2))
+				for _, opts := range deletions {
+					Expect(opts.PropagationPolicy).Should(HaveValue(Equal(metav1.DeletePropagationForeground)))
+					Expect(opts.GracePeriodSeconds).Should(HaveValue(BeEquivalentTo(30)))
+					Expect(opts.Preconditions).ShouldNot(BeNil())
+					Expect(opts.DryRun).Should(Equal([]string{metav1.DryRunAll}))
+				}
+			})
+		})
+
+		Describe("WithDeleter()", func() {
+			pruneAll := func(_ context.Context, objs []client.Object) ([]client.Object, error) {
+				return objs, nil
+			}
+			archive := func(ctx context.Context, c client.Client, obj client.Object) error {
+				labels := obj.GetLabels()
+				labels["archived"] = "true"
+				obj.SetLabels(labels)
+				return c.Update(ctx, obj)
+			}
+			listArchived := func() []unstructured.Unstructured {
+				pods := &unstructured.UnstructuredList{}
+				pods.SetGroupVersionKind(podGVK)
+				Expect(fakeClient.List(context.Background(), pods, client.MatchingLabels{"archived": "true"})).To(Succeed())
+				return pods.Items
+			}
+
+			It("Should Prune Objects With the Deleter", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithNamespace(namespace), WithDeleter(archive))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(3))
+				Expect(listArchived()).Should(HaveLen(3))
+			})
+
+			It("Should Retry Retriable Errors of the Deleter", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+
+				attempts := 0
+				deleter := func(ctx context.Context, c client.Client, obj client.Object) error {
+					attempts++
+					if attempts == 1 {
+						return apierrors.NewConflict(schema.GroupResource{Resource: "pods"}, obj.GetName(), fmt.Errorf("TEST"))
+					}
+					return archive(ctx, c, obj)
+				}
+				backoff := wait.Backoff{Steps: 3, Duration: time.Millisecond, Factor: 1}
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithNamespace(namespace), WithDeleter(deleter), WithDeleteBackoff(backoff))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(3))
+				Expect(attempts).Should(Equal(4))
+			})
+
+			It("Should Pass a Dry-Run Client to the Deleter With WithDryRun", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithNamespace(namespace), WithDeleter(archive), WithDryRun())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(3))
+				Expect(listArchived()).Should(BeEmpty())
+			})
+		})
+
+		Describe("WithPruneGraph()", func() {
+			cmGVK := corev1.SchemeGroupVersion.WithKind("ConfigMap")
+			graph := PruneGraph{Dependents: []PruneGraphEdge{
+				{GVK: corev1.SchemeGroupVersion.WithKind("Pod"), Relationship: RelationshipOwnerReference},
+				{GVK: cmGVK, Relationship: RelationshipLabel, Label: "run"},
+			}}
+
+			newObj := func(gvk schema.GroupVersionKind, name string) *unstructured.Unstructured {
+				obj := &unstructured.Unstructured{}
+				obj.SetGroupVersionKind(gvk)
+				obj.SetName(name)
+				obj.SetNamespace(namespace)
+				return obj
+			}
+			names := func(objs []client.Object) []string {
+				var result []string
+				for _, obj := range objs {
+					result = append(result, obj.GetObjectKind().GroupVersionKind().Kind+"/"+obj.GetName())
+				}
+				return result
+			}
+
+			BeforeEach(func() {
+				for i := 0; i < 3; i++ {
+					job := newObj(jobGVK, fmt.Sprintf("churro%d", i))
+					job.SetUID(types.UID(job.GetName()))
+					job.SetLabels(appLabels)
+					Expect(fakeClient.Create(context.Background(), job)).To(Succeed())
+
+					pod := newObj(podGVK, fmt.Sprintf("churro%d-pod", i))
+					pod.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "Job", Name: job.GetName(), UID: job.GetUID()}})
+					Expect(fakeClient.Create(context.Background(), pod)).To(Succeed())
+
+					cm := newObj(cmGVK, fmt.Sprintf("churro%d-cm", i))
+					cm.SetLabels(map[string]string{"run": job.GetName()})
+					Expect(fakeClient.Create(context.Background(), cm)).To(Succeed())
+				}
+			})
+
+			remaining := func(gvk schema.GroupVersionKind) []string {
+				list := &unstructured.UnstructuredList{}
+				list.SetGroupVersionKind(gvk)
+				Expect(fakeClient.List(context.Background(), list)).To(Succeed())
+				var result []string
+				for _, item := range list.Items {
+					result = append(result, item.GetName())
+				}
+				return result
+			}
+
+			It("Should Prune the Dependents Before Their Owner", func() {
+				var deletions []string
+				fakeClient = interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+					Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+						deletions = append(deletions, obj.GetName())
+						return c.Delete(ctx, obj, opts...)
+					},
+				})
+				pruner, err := NewPruner(fakeClient, jobGVK, myStrategy, WithNamespace(namespace), WithLabels(appLabels), WithPruneGraph(graph))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(names(result.Pruned)).Should(Equal([]string{"Job/churro1", "Job/churro2"}))
+				Expect(names(result.Dependents)).Should(ConsistOf("Pod/churro1-pod", "ConfigMap/churro1-cm", "Pod/churro2-pod", "ConfigMap/churro2-cm"))
+				Expect(deletions).Should(Equal([]string{"churro1-pod", "churro1-cm", "churro1", "churro2-pod", "churro2-cm", "churro2"}))
+
+				Expect(remaining(jobGVK)).Should(ConsistOf("churro0"))
+				Expect(remaining(podGVK)).Should(ConsistOf("churro0-pod"))
+				Expect(remaining(cmGVK)).Should(ConsistOf("churro0-cm"))
+			})
+
+			It("Should Prune the Owner Before Its Dependents", func() {
+				var deletions []string
+				fakeClient = interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+					Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+						deletions = append(deletions, obj.GetName())
+						return c.Delete(ctx, obj, opts...)
+					},
+				})
+				ownerFirst := graph
+				ownerFirst.Order = OwnerFirst
+				pruner, err := NewPruner(fakeClient, jobGVK, myStrategy, WithNamespace(namespace), WithLabels(appLabels), WithPruneGraph(ownerFirst))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).Should(HaveLen(2))
+				Expect(result.Dependents).Should(HaveLen(4))
+				Expect(deletions).Should(Equal([]string{"churro1", "churro1-pod", "churro1-cm", "churro2", "churro2-pod", "churro2-cm"}))
+			})
+
+			It("Should Keep the Owner of a Protected Dependent", func() {
+				cm := &unstructured.Unstructured{}
+				cm.SetGroupVersionKind(cmGVK)
+				Expect(fakeClient.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: "churro1-cm"}, cm)).To(Succeed())
+				cm.SetAnnotations(map[string]string{ProtectAnnotation: "true"})
+				Expect(fakeClient.Update(context.Background(), cm)).To(Succeed())
+
+				pruner, err := NewPruner(fakeClient, jobGVK, myStrategy, WithNamespace(namespace), WithLabels(appLabels), WithPruneGraph(graph))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(names(result.Pruned)).Should(Equal([]string{"Job/churro2"}))
+				Expect(remaining(jobGVK)).Should(ConsistOf("churro0", "churro1"))
+				Expect(remaining(cmGVK)).Should(ConsistOf("churro0-cm", "churro1-cm"))
+			})
+
+			It("Should Prune Nested Dependents", func() {
+				nested := PruneGraph{Dependents: []PruneGraphEdge{{
+					GVK:          podGVK,
+					Relationship: RelationshipOwnerReference,
+					Dependents:   []PruneGraphEdge{{GVK: cmGVK, Relationship: RelationshipAnnotation}},
+				}}}
+				pod := &unstructured.Unstructured{}
+				pod.SetGroupVersionKind(podGVK)
+				Expect(fakeClient.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: "churro1-pod"}, pod)).To(Succeed())
+				pod.SetUID("churro1-pod")
+				cm := newObj(cmGVK, "churro1-pod-cm")
+				Expect(handler.SetOwnerAnnotations(pod, cm)).To(Succeed())
+				Expect(fakeClient.Create(context.Background(), cm)).To(Succeed())
+
+				pruner, err := NewPruner(fakeClient, jobGVK, myStrategy, WithNamespace(namespace), WithLabels(appLabels), WithPruneGraph(nested))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(names(result.Dependents)).Should(ConsistOf("ConfigMap/churro1-pod-cm", "Pod/churro1-pod", "Pod/churro2-pod"))
+				Expect(remaining(cmGVK)).Should(ConsistOf("churro0-cm", "churro1-cm", "churro2-cm"))
+			})
+
+			It("Should Return an Error for an Invalid Graph", func() {
+				_, err := NewPruner(fakeClient, jobGVK, myStrategy, WithPruneGraph(PruneGraph{Order: "Random"}))
+				Expect(err).Should(MatchError(ContainSubstring("unknown prune order")))
+
+				_, err = NewPruner(fakeClient, jobGVK, myStrategy, WithPruneGraph(PruneGraph{Dependents: []PruneGraphEdge{
+					{GVK: podGVK, Relationship: RelationshipOwnerReference, Dependents: []PruneGraphEdge{{GVK: cmGVK, Relationship: RelationshipLabel}}},
+				}}))
+				Expect(err).Should(MatchError(ContainSubstring("no label is set")))
+			})
+		})
+
+		Describe("NewMultiClusterPruner()", func() {
+			var clients map[string]client.Client
+
+			BeforeEach(func() {
+				testScheme, err := createSchemes()
+				Expect(err).ShouldNot(HaveOccurred())
+				clients = map[string]client.Client{}
+				for _, cluster := range []string{"spoke-a", "spoke-b", "spoke-c"} {
+					c := crFake.NewClientBuilder().WithScheme(testScheme).Build()
+					Expect(createTestPods(c)).To(Succeed())
+					clients[cluster] = c
+				}
+			})
+
+			It("Should Prune All Clusters and Aggregate Their Results", func() {
+				pruner, err := NewMultiClusterPruner(clients, 0, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruner.Clusters()).Should(Equal([]string{"spoke-a", "spoke-b", "spoke-c"}))
+
+				result, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Errors).Should(BeEmpty())
+				Expect(result.Results).Should(HaveLen(3))
+				for cluster, c := range clients {
+					Expect(result.Results[cluster].Pruned).Should(HaveLen(2))
+					pods := &unstructured.UnstructuredList{}
+					pods.SetGroupVersionKind(podGVK)
+					Expect(c.List(context.Background(), pods)).To(Succeed())
+					Expect(pods.Items).Should(HaveLen(1))
+				}
+			})
+
+			It("Should Keep Pruning Other Clusters When One Fails", func() {
+				clients["spoke-b"] = interceptor.NewClient(clients["spoke-b"].(client.WithWatch), interceptor.Funcs{
+					List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+						return errors.New("TEST")
+					},
+				})
+				pruner, err := NewMultiClusterPruner(clients, 0, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.Prune(context.Background())
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).Should(ContainSubstring(`cluster "spoke-b"`))
+				Expect(result.Errors).Should(HaveKey("spoke-b"))
+				Expect(result.Results).Should(HaveKey("spoke-a"))
+				Expect(result.Results).Should(HaveKey("spoke-c"))
+				Expect(result.Results).ShouldNot(HaveKey("spoke-b"))
+			})
+
+			It("Should Limit the Number of Clusters Pruned at the Same Time", func() {
+				var running, maxRunning atomic.Int32
+				for cluster, c := range clients {
+					clients[cluster] = interceptor.NewClient(c.(client.WithWatch), interceptor.Funcs{
+						List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+							n := running.Add(1)
+							defer running.Add(-1)
+							for {
+								m := maxRunning.Load()
+								if n <= m || maxRunning.CompareAndSwap(m, n) {
+									break
+								}
+							}
+							time.Sleep(10 * time.Millisecond)
+							return c.List(ctx, list, opts...)
+						},
+					})
+				}
+				pruner, err := NewMultiClusterPruner(clients, 1, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(maxRunning.Load()).Should(Equal(int32(1)))
+			})
+
+			It("Should Return an Error Without Clusters", func() {
+				_, err := NewMultiClusterPruner(nil, 0, podGVK, NewPruneByCountStrategy(1))
+				Expect(err).Should(HaveOccurred())
+			})
+
+			It("Should Return an Error for an Invalid Configuration", func() {
+				_, err := NewMultiClusterPruner(clients, 0, schema.GroupVersionKind{}, NewPruneByCountStrategy(1))
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		Describe("NewMultiPruner()", func() {
+			BeforeEach(func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				Expect(createTestJobs(fakeClient)).To(Succeed())
+			})
+
+			It("Should Prune All Kinds and Group Results by Kind", func() {
+				pruner, err := NewMultiPruner(fakeClient, []schema.GroupVersionKind{podGVK, jobGVK}, NewPruneByCountStrategy(1),
+					WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruner.GVKs()).Should(Equal([]schema.GroupVersionKind{podGVK, jobGVK}))
+
+				result, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Errors).Should(BeEmpty())
+				Expect(result.Results).Should(HaveLen(2))
+				Expect(result.Results[podGVK].Pruned).Should(HaveLen(2))
+				Expect(result.Results[jobGVK].Pruned).Should(HaveLen(2))
+				for _, gvk := range pruner.GVKs() {
+					list := &unstructured.UnstructuredList{}
+					list.SetGroupVersionKind(gvk)
+					Expect(fakeClient.List(context.Background(), list)).To(Succeed())
+					Expect(list.Items).Should(HaveLen(1))
+				}
+			})
+
+			It("Should Keep Pruning Other Kinds When One Fails", func() {
+				failing := interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						if list.GetObjectKind().GroupVersionKind() == podGVK {
+							return errors.New("TEST")
+						}
+						return c.List(ctx, list, opts...)
+					},
+				})
+				pruner, err := NewMultiPruner(failing, []schema.GroupVersionKind{podGVK, jobGVK}, NewPruneByCountStrategy(1),
+					WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.Prune(context.Background())
+				Expect(err).Should(MatchError(ContainSubstring("TEST")))
+				Expect(result.Errors).Should(HaveKey(podGVK))
+				Expect(result.Results).ShouldNot(HaveKey(podGVK))
+				Expect(result.Results[jobGVK].Pruned).Should(HaveLen(2))
+			})
+
+			It("Should Return an Error for Invalid Kinds", func() {
+				_, err := NewMultiPruner(fakeClient, nil, NewPruneByCountStrategy(1))
+				Expect(err).Should(HaveOccurred())
+
+				_, err = NewMultiPruner(fakeClient, []schema.GroupVersionKind{podGVK, podGVK}, NewPruneByCountStrategy(1))
+				Expect(err).Should(MatchError(ContainSubstring("duplicate gvk")))
+			})
+		})
+
+		Describe("RequireHarvested()", func() {
+			It("Should Only Prune Objects Whose Logs Were Harvested", func() {
+				Expect(createTestJobs(fakeClient)).To(Succeed())
+				job := &batchv1.Job{}
+				Expect(fakeClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: "churro1"}, job)).To(Succeed())
+				Expect(IsHarvested(job)).Should(BeFalse())
+				Expect(MarkHarvested(context.Background(), fakeClient, job)).To(Succeed())
+				Expect(IsHarvested(job)).Should(BeTrue())
+
+				pruner, err := NewPruner(fakeClient, jobGVK, myStrategy, WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+				pruner.registry = Registry{}
+				pruner.registry.RegisterIsPrunableFunc(jobGVK, RequireHarvested(DefaultJobIsPrunable))
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(1))
+				Expect(prunedObjects[0].GetName()).Should(Equal("churro1"))
+			})
+
+			It("Should Defer to the Given IsPrunableFunc", func() {
+				var obj client.Object = &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+					Name:        "churro",
+					Annotations: map[string]string{HarvestedAnnotation: time.Now().Format(time.RFC3339)},
+				}}
+				Expect(RequireHarvested(nil)(obj)).Should(Succeed())
+				Expect(IsUnprunable(RequireHarvested(DefaultJobIsPrunable)(obj))).Should(BeTrue())
+			})
+		})
+
+		Describe("WithPageSize(), WithStreaming() and WithPagePerRun()", func() {
+			var requests int
+
+			// pagingClient emulates the pagination of the API server, which the fake client does
+			// not implement, using the name of the last resource of a page as continue token.
+			pagingClient := func() client.Client {
+				return interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						requests++
+						listOpts := &client.ListOptions{}
+						listOpts.ApplyOptions(opts)
+						limit, cont := listOpts.Limit, listOpts.Continue
+						listOpts.Limit, listOpts.Continue = 0, ""
+						if err := c.List(ctx, list, listOpts); err != nil {
+							return err
+						}
+
+						ul := list.(*unstructured.UnstructuredList)
+						sort.Slice(ul.Items, func(i, j int) bool { return ul.Items[i].GetName() < ul.Items[j].GetName() })
+						var items []unstructured.Unstructured
+						for _, item := range ul.Items {
+							if item.GetName() > cont {
+								items = append(items, item)
+							}
+						}
+						ul.SetContinue("")
+						if limit > 0 && int64(len(items)) > limit {
+							items = items[:limit]
+							ul.SetContinue(items[limit-1].GetName())
+						}
+						ul.Items = items
+						return nil
+					},
+				})
+			}
+
+			BeforeEach(func() {
+				requests = 0
+				Expect(createTestPods(fakeClient)).To(Succeed())
+			})
+
+			It("Should List Resources in Pages", func() {
+				var plans []Plan
+				pruner, err := NewPruner(pagingClient(), podGVK, myStrategy, WithNamespace(namespace), WithPageSize(2),
+					WithBeforeRun(func(_ context.Context, plan Plan) error {
+						plans = append(plans, plan)
+						return nil
+					}))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(2))
+				Expect(requests).Should(Equal(2))
+				Expect(plans).Should(HaveLen(1))
+			})
+
+			It("Should Evaluate and Prune One Page at a Time When Streaming", func() {
+				var pages [][]client.Object
+				pageStrategy := func(ctx context.Context, objs []client.Object) ([]client.Object, error) {
+					pages = append(pages, objs)
+					return myStrategy(ctx, objs)
+				}
+				pruner, err := NewPruner(pagingClient(), podGVK, pageStrategy, WithNamespace(namespace), WithStreaming(), WithPageSize(1))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(2))
+				Expect(requests).Should(Equal(3))
+				Expect(pages).Should(HaveLen(3))
+				for _, page := range pages {
+					Expect(len(page)).Should(BeNumerically("<=", 1))
+				}
+
+				pods := &corev1.PodList{}
+				Expect(fakeClient.List(context.Background(), pods, client.InNamespace(namespace))).To(Succeed())
+				Expect(pods.Items).Should(HaveLen(1))
+			})
+
+			It("Should Not Allow Before-Run Hooks When Streaming", func() {
+				_, err := NewPruner(pagingClient(), podGVK, myStrategy, WithNamespace(namespace), WithStreaming(),
+					WithBeforeRun(func(context.Context, Plan) error { return nil }))
+				Expect(err).Should(MatchError(ContainSubstring("can not be used with WithStreaming")))
+			})
+
+			It("Should Call Before-Run Hooks Once per Run When Listing a Page per Run", func() {
+				var plans []Plan
+				pruner, err := NewPruner(pagingClient(), podGVK, myStrategy, WithNamespace(namespace),
+					WithPagePerRun(NewMemoryCursorStore()), WithPageSize(2),
+					WithBeforeRun(func(_ context.Context, plan Plan) error {
+						plans = append(plans, plan)
+						return nil
+					}))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(plans).Should(HaveLen(1))
+			})
+
+			It("Should List a Page per Run When Resuming From a Stored Continue Token", func() {
+				store := NewMemoryCursorStore()
+				pruner, err := NewPruner(pagingClient(), podGVK, myStrategy, WithNamespace(namespace),
+					WithPagePerRun(store), WithPageSize(1))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				var pruned []string
+				for i := 0; i < 3; i++ {
+					prunedObjects, err := pruner.Prune(context.Background())
+					Expect(err).ShouldNot(HaveOccurred())
+					for _, obj := range prunedObjects {
+						pruned = append(pruned, obj.GetName())
+					}
+				}
+				Expect(pruned).Should(Equal([]string{"churro1", "churro2"}))
+				Expect(requests).Should(Equal(3))
+
+				token, err := store.Load(context.Background(), pruner.cursorKey())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(token).Should(BeEmpty())
+			})
+
+			It("Should Start Over When the Continue Token Expired", func() {
+				store := NewMemoryCursorStore()
+				paging := pagingClient()
+				expiring := interceptor.NewClient(paging.(client.WithWatch), interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						listOpts := &client.ListOptions{}
+						listOpts.ApplyOptions(opts)
+						if listOpts.Continue == "expired" {
+							return apierrors.NewResourceExpired("continue token expired")
+						}
+						return c.List(ctx, list, opts...)
+					},
+				})
+				pruner, err := NewPruner(expiring, podGVK, myStrategy, WithNamespace(namespace),
+					WithPagePerRun(store), WithPageSize(2))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(store.Save(context.Background(), pruner.cursorKey(), "expired")).To(Succeed())
+
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(1))
+				Expect(prunedObjects[0].GetName()).Should(Equal("churro1"))
+				token, err := store.Load(context.Background(), pruner.cursorKey())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(token).Should(Equal("churro1"))
+			})
+
+			It("Should Resume After the Last Listed Resource When the Continue Token Expired Between Runs", func() {
+				store := NewMemoryCursorStore()
+				expireNext := false
+				expiring := interceptor.NewClient(pagingClient().(client.WithWatch), interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						listOpts := &client.ListOptions{}
+						listOpts.ApplyOptions(opts)
+						if expireNext && listOpts.Continue != "" {
+							expireNext = false
+							return apierrors.NewResourceExpired("continue token expired")
+						}
+						return c.List(ctx, list, opts...)
+					},
+				})
+				var listed []string
+				keepAll := func(_ context.Context, objs []client.Object) ([]client.Object, error) {
+					for _, obj := range objs {
+						listed = append(listed, obj.GetName())
+					}
+					return nil, nil
+				}
+				pruner, err := NewPruner(expiring, podGVK, keepAll, WithNamespace(namespace),
+					WithPagePerRun(store), WithPageSize(1))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				for i := 0; i < 3; i++ {
+					expireNext = true
+					_, err := pruner.Prune(context.Background())
+					Expect(err).ShouldNot(HaveOccurred())
+				}
+				Expect(listed).Should(Equal([]string{"churro0", "churro1", "churro2"}))
+
+				position, err := store.Load(context.Background(), pruner.cursorKey()+cursorPositionSuffix)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(position).Should(BeEmpty())
+			})
+
+			It("Should Store Continue Tokens in a ConfigMap", func() {
+				c := crFake.NewClientBuilder().Build()
+				key := client.ObjectKey{Namespace: namespace, Name: "prune-cursors"}
+				store := NewConfigMapCursorStore(c, key)
+
+				token, err := store.Load(context.Background(), "Pod.abc")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(token).Should(BeEmpty())
+				Expect(store.Save(context.Background(), "Pod.abc", "")).To(Succeed())
+				Expect(apierrors.IsNotFound(c.Get(context.Background(), key, &corev1.ConfigMap{}))).Should(BeTrue())
+
+				Expect(store.Save(context.Background(), "Pod.abc", "token1")).To(Succeed())
+				Expect(store.Save(context.Background(), "Job.def", "token2")).To(Succeed())
+				token, err = store.Load(context.Background(), "Pod.abc")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(token).Should(Equal("token1"))
+
+				Expect(store.Save(context.Background(), "Pod.abc", "")).To(Succeed())
+				cm := &corev1.ConfigMap{}
+				Expect(c.Get(context.Background(), key, cm)).To(Succeed())
+				Expect(cm.Data).Should(Equal(map[string]string{"Job.def": "token2"}))
+			})
+
+			It("Should Return an Error for an Invalid Page Size", func() {
+				_, err := NewPruner(fakeClient, podGVK, myStrategy, WithPageSize(0))
+				Expect(err).Should(MatchError(ContainSubstring("page size must be positive")))
+			})
+		})
+
+		Describe("WithMetricsRegistry()", func() {
+			It("Should Report Pruned Objects, Errors and Durations", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				reg := prometheus.NewRegistry()
+				pruned := prunemetrics.ObjectsPruned.WithLabelValues(podGVK.String(), namespace)
+				before := testutil.ToFloat64(pruned)
+
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace), WithMetricsRegistry(reg))
+				Expect(err).ShouldNot(HaveOccurred())
+				_, err = pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(testutil.ToFloat64(pruned) - before).Should(BeEquivalentTo(2))
+				Expect(testutil.CollectAndCount(reg, "operator_lib_prune_duration_seconds")).Should(BeNumerically(">=", 1))
+
+				failing := interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+					List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+						return errors.New("TEST")
+					},
+				})
+				runFailed := prunemetrics.Errors.WithLabelValues(podGVK.String(), namespace, prunemetrics.ErrorReasonRunFailed)
+				before = testutil.ToFloat64(runFailed)
+				pruner, err = NewPruner(failing, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace), WithMetricsRegistry(reg))
+				Expect(err).ShouldNot(HaveOccurred())
+				_, err = pruner.Prune(context.Background())
+				Expect(err).Should(HaveOccurred())
+				Expect(testutil.ToFloat64(runFailed) - before).Should(BeEquivalentTo(1))
+			})
+
+			It("Should Not Count Objects Pruned by Dry Runs", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				pruned := prunemetrics.ObjectsPruned.WithLabelValues(podGVK.String(), namespace)
+				before := testutil.ToFloat64(pruned)
+
+				pruner, err := NewPruner(fakeClient, podGVK, NewPruneByCountStrategy(1), WithNamespace(namespace), WithDryRun())
+				Expect(err).ShouldNot(HaveOccurred())
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(2))
+				Expect(testutil.ToFloat64(pruned)).Should(Equal(before))
+			})
+		})
+
+		Describe("WithHistory()", func() {
+			It("Should Record the Last Runs", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				history := NewHistory(2)
+				pruner, err := NewPruner(fakeClient, podGVK, myStrategy, WithNamespace(namespace), WithHistory(history))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruner.History()).Should(BeIdenticalTo(history))
+				_, ok := history.Last()
+				Expect(ok).Should(BeFalse())
+				Expect(testutil.CollectAndCount(history)).Should(BeZero())
+
+				for i := 0; i < 3; i++ {
+					_, err := pruner.PruneWithResult(context.Background())
+					Expect(err).ShouldNot(HaveOccurred())
+				}
+
+				records := history.Records()
+				Expect(records).Should(HaveLen(2))
+				Expect(records[0].Pruned).Should(BeZero())
+				Expect(records[1].Start).ShouldNot(BeTemporally("<", records[0].Start))
+				last, ok := history.Last()
+				Expect(ok).Should(BeTrue())
+				Expect(last).Should(Equal(records[1]))
+				Expect(testutil.CollectAndCount(history)).Should(Equal(5))
+			})
+
+			It("Should Record the Runs of Several Pruners", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				history := NewHistory(0)
+				pruner, err := NewMultiPruner(fakeClient, []schema.GroupVersionKind{podGVK, jobGVK}, myStrategy,
+					WithNamespace(namespace), WithHistory(history))
+				Expect(err).ShouldNot(HaveOccurred())
+				_, err = pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				var kinds []string
+				for _, record := range history.Records() {
+					kinds = append(kinds, record.Kind)
+				}
+				Expect(kinds).Should(ConsistOf("Pod", "Job"))
+				Expect(testutil.CollectAndCount(history, "operator_lib_prune_last_run_pruned_objects")).Should(Equal(2))
+
+				By("registering several Histories with the same registry")
+				reg := prometheus.NewRegistry()
+				Expect(reg.Register(history)).To(Succeed())
+				Expect(reg.Register(NewHistory(0))).To(Succeed())
+			})
+
+			It("Should Record Aborted Runs", func() {
+				failing := interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+					List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+						return errors.New("TEST")
+					},
+				})
+				history := NewHistory(0)
+				pruner, err := NewPruner(failing, podGVK, myStrategy, WithHistory(history))
+				Expect(err).ShouldNot(HaveOccurred())
+				_, err = pruner.PruneWithResult(context.Background())
+				Expect(err).Should(HaveOccurred())
+
+				last, ok := history.Last()
+				Expect(ok).Should(BeTrue())
+				Expect(last.Error).Should(ContainSubstring("TEST"))
+			})
+
+			It("Should Serve the Runs as JSON", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				history := NewHistory(5)
+				pruner, err := NewPruner(fakeClient, podGVK, myStrategy, WithNamespace(namespace), WithHistory(history))
+				Expect(err).ShouldNot(HaveOccurred())
+				_, err = pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				recorder := httptest.NewRecorder()
+				history.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/prune", nil))
+				Expect(recorder.Code).Should(Equal(http.StatusOK))
+				var records []RunRecord
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &records)).To(Succeed())
+				Expect(records).Should(HaveLen(1))
+				Expect(records[0].Pruned).Should(Equal(2))
+			})
+		})
+
+		Describe("NewRunnable()", func() {
+			It("Should Prune Periodically Until Stopped", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				var runs atomic.Int32
+				pruner, err := NewPruner(fakeClient, podGVK, myStrategy, WithNamespace(namespace),
+					WithAfterRun(func(context.Context, *Result) { runs.Add(1) }))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				runnable := NewRunnable(pruner, 10*time.Millisecond)
+				Expect(runnable.NeedLeaderElection()).Should(BeTrue())
+
+				ctx, cancel := context.WithCancel(context.Background())
+				done := make(chan error)
+				go func() { done <- runnable.Start(ctx) }()
+
+				Eventually(runs.Load).Should(BeNumerically(">=", 2))
+				pods := &corev1.PodList{}
+				Expect(fakeClient.List(context.Background(), pods, client.InNamespace(namespace))).To(Succeed())
+				Expect(pods.Items).Should(HaveLen(1))
+
+				cancel()
+				Eventually(done).Should(Receive(BeNil()))
+			})
+
+			It("Should Use the Default Interval", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, myStrategy)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(NewRunnable(pruner, 0).interval).Should(Equal(DefaultPruneInterval))
+			})
+		})
+
+		Describe("ProtectionWebhook", func() {
+			deleteRequest := func(user string, obj client.Object) admission.Request {
+				raw, err := json.Marshal(obj)
+				Expect(err).ShouldNot(HaveOccurred())
+				return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Delete,
+					Name:      obj.GetName(),
+					Namespace: obj.GetNamespace(),
+					UserInfo:  authenticationv1.UserInfo{Username: user},
+					OldObject: runtime.RawExtension{Raw: raw},
+				}}
+			}
+			newJob := func(annotations map[string]string) *batchv1.Job {
+				return &batchv1.Job{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+					ObjectMeta: metav1.ObjectMeta{Name: "churro", Namespace: namespace, Annotations: annotations},
+				}
+			}
+
+			It("Should Deny the Deletion of Objects Protected by the Annotation", func() {
+				w := &ProtectionWebhook{}
+				resp := w.Handle(context.Background(), deleteRequest("alice", newJob(map[string]string{ProtectAnnotation: "true"})))
+				Expect(resp.Allowed).Should(BeFalse())
+				Expect(resp.Result.Message).Should(ContainSubstring(ProtectAnnotation))
+			})
+
+			It("Should Allow the Deletion of Unprotected Objects", func() {
+				w := &ProtectionWebhook{}
+				resp := w.Handle(context.Background(), deleteRequest("alice", newJob(map[string]string{ProtectAnnotation: "false"})))
+				Expect(resp.Allowed).Should(BeTrue())
+			})
+
+			It("Should Deny the Deletion of Objects Still Needed by the Operator", func() {
+				w := &ProtectionWebhook{Check: func(_ context.Context, obj client.Object) error {
+					return &Unprunable{Obj: &obj, Reason: "results not collected yet"}
+				}}
+				resp := w.Handle(context.Background(), deleteRequest("alice", newJob(nil)))
+				Expect(resp.Allowed).Should(BeFalse())
+				Expect(resp.Result.Message).Should(ContainSubstring("results not collected yet"))
+			})
+
+			It("Should Report Errors of the Check", func() {
+				w := &ProtectionWebhook{Check: func(context.Context, client.Object) error {
+					return errors.New("TEST")
+				}}
+				resp := w.Handle(context.Background(), deleteRequest("alice", newJob(nil)))
+				Expect(resp.Allowed).Should(BeFalse())
+				Expect(resp.Result.Code).Should(BeEquivalentTo(http.StatusInternalServerError))
+			})
+
+			It("Should Allow Deletions by Allowed Users", func() {
+				w := &ProtectionWebhook{AllowedUsers: []string{"system:serviceaccount:operators:my-operator"}}
+				job := newJob(map[string]string{ProtectAnnotation: "true"})
+				Expect(w.Handle(context.Background(), deleteRequest("system:serviceaccount:operators:my-operator", job)).Allowed).Should(BeTrue())
+				Expect(w.Handle(context.Background(), deleteRequest(DefaultProtectionWebhookAllowedUsers[0], job)).Allowed).Should(BeTrue())
+			})
+
+			It("Should Allow Other Operations", func() {
+				w := &ProtectionWebhook{}
+				req := deleteRequest("alice", newJob(map[string]string{ProtectAnnotation: "true"}))
+				req.Operation = admissionv1.Update
+				Expect(w.Handle(context.Background(), req).Allowed).Should(BeTrue())
+			})
+		})
+
+		Describe("NewDiscoveredPruners()", func() {
+			var disc *fakediscovery.FakeDiscovery
+			BeforeEach(func() {
+				verbs := metav1.Verbs{"get", "list", "watch", "delete"}
+				disc = &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{
+					{
+						GroupVersion: "v1",
+						APIResources: []metav1.APIResource{
+							{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: verbs},
+							{Name: "pods/log", Kind: "Pod", Namespaced: true, Verbs: metav1.Verbs{"get"}},
+							{Name: "namespaces", Kind: "Namespace", Verbs: verbs},
+						},
+					},
+					{
+						GroupVersion: "batch/v1",
+						APIResources: []metav1.APIResource{
+							{Name: "jobs", Kind: "Job", Namespaced: true, Verbs: verbs},
+						},
+					},
+				}}}
+			})
+
+			It("Should Discover the Kinds With Matching Objects", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+
+				gvks, err := DiscoverGVKs(context.Background(), disc, fakeClient, namespace, labels.SelectorFromSet(appLabels))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(gvks).Should(ConsistOf(podGVK))
+
+				Expect(createTestJobs(fakeClient)).To(Succeed())
+				gvks, err = DiscoverGVKs(context.Background(), disc, fakeClient, namespace, labels.SelectorFromSet(appLabels))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(gvks).Should(ConsistOf(podGVK, jobGVK))
+
+				gvks, err = DiscoverGVKs(context.Background(), disc, fakeClient, namespace, labels.SelectorFromSet(map[string]string{"app": "other"}))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(gvks).Should(BeEmpty())
+			})
+
+			It("Should Skip the Kinds That Cannot Be Listed", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				Expect(createTestJobs(fakeClient)).To(Succeed())
+				forbidden := interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						if list.GetObjectKind().GroupVersionKind().Group == "batch" {
+							return apierrors.NewForbidden(schema.GroupResource{Group: "batch", Resource: "jobs"}, "", errors.New("TEST"))
+						}
+						return c.List(ctx, list, opts...)
+					},
+				})
+
+				gvks, err := DiscoverGVKs(context.Background(), disc, forbidden, namespace, labels.SelectorFromSet(appLabels))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(gvks).Should(ConsistOf(podGVK))
+			})
+
+			It("Should Construct a Pruner for Each Discovered Kind", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				Expect(createTestJobs(fakeClient)).To(Succeed())
+
+				selector := labels.SelectorFromSet(appLabels)
+				pruners, err := NewDiscoveredPruners(context.Background(), fakeClient, disc, namespace, selector, myStrategy)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruners).Should(HaveLen(2))
+
+				for _, pruner := range pruners {
+					Expect(pruner.Namespace()).Should(Equal(namespace))
+					Expect(pruner.LabelSelector().String()).Should(Equal(selector.String()))
+
+					prunedObjects, err := pruner.Prune(context.Background())
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(prunedObjects).Should(HaveLen(2))
+				}
+			})
+
+			It("Should Use the Default Strategies If No Strategy is Given", func() {
+				Expect(createTestJobs(fakeClient)).To(Succeed())
+
+				pruners, err := NewDiscoveredPruners(context.Background(), fakeClient, disc, namespace, labels.Everything(), nil)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruners).Should(HaveLen(1))
+				Expect(pruners[0].GVK()).Should(Equal(jobGVK))
+			})
+		})
+
+		Describe("WithLabelSelector()", func() {
+			pruneAll := func(_ context.Context, objs []client.Object) ([]client.Object, error) {
+				return objs, nil
+			}
+
+			It("Should Select Resources With a Set-Based Selector", func() {
+				Expect(createTestPods(fakeClient)).To(Succeed())
+
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithLabelSelectorString("app notin (churro)"))
+				Expect(err).ShouldNot(HaveOccurred())
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(BeEmpty())
+
+				pruner, err = NewPruner(fakeClient, podGVK, pruneAll, WithLabelSelectorString("app in (churro, tortilla)"))
+				Expect(err).ShouldNot(HaveOccurred())
+				prunedObjects, err = pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(HaveLen(3))
+			})
+
+			It("Should Combine the Selector With the Labels", func() {
+				selector, err := labels.Parse("tier")
+				Expect(err).ShouldNot(HaveOccurred())
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithLabels(appLabels), WithLabelSelector(selector))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruner.LabelSelector().String()).Should(Equal("app=churro,tier"))
+
+				Expect(createTestPods(fakeClient)).To(Succeed())
+				prunedObjects, err := pruner.Prune(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(prunedObjects).Should(BeEmpty())
+			})
+
+			It("Should Error if the Selector is Invalid", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithLabelSelectorString("app in churro"))
+				Expect(err).Should(MatchError(ContainSubstring("invalid label selector \"app in churro\"")))
+				Expect(pruner).Should(BeNil())
+			})
+		})
+
+		Describe("GVK()", func() {
+			It("Should return the GVK field in the Pruner", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, myStrategy)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruner).ShouldNot(BeNil())
+				Expect(pruner.GVK()).Should(Equal(podGVK))
+			})
+		})
+
+		Describe("Labels()", func() {
+			It("Should return the Labels field in the Pruner", func() {
+				pruner, err := NewPruner(fakeClient, podGVK, myStrategy, WithLabels(appLabels))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruner).ShouldNot(BeNil())
+				Expect(pruner.Labels()).Should(Equal(appLabels))
+			})
+		})
+
+		Describe("WithAllNamespaces()", func() {
+			var (
+				c        client.Client
+				crbGVK   schema.GroupVersionKind
+				cmGVK    schema.GroupVersionKind
+				pruneAll StrategyFuncV2
+				pctx     PruneContext
+			)
+
+			BeforeEach(func() {
+				crbGVK = rbacv1.SchemeGroupVersion.WithKind("ClusterRoleBinding")
+				cmGVK = corev1.SchemeGroupVersion.WithKind("ConfigMap")
+				mapper := meta.NewDefaultRESTMapper(nil)
+				mapper.Add(crbGVK, meta.RESTScopeRoot)
+				mapper.Add(cmGVK, meta.RESTScopeNamespace)
+				c = crFake.NewClientBuilder().WithRESTMapper(mapper).WithObjects(
+					&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "stale", Labels: appLabels}},
+					&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+					&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns1", Labels: appLabels}},
+					&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "ns2", Labels: appLabels}},
+				).Build()
+				pruneAll = func(_ context.Context, runCtx PruneContext, objs []client.Object) (StrategyResult, error) {
+					pctx = runCtx
+					return StrategyResult{Objects: objs}, nil
+				}
+			})
+
+			It("Should prune cluster-scoped resources", func() {
+				pruner, err := NewPruner(c, crbGVK, nil, WithStrategyV2(pruneAll), WithLabels(appLabels), WithAllNamespaces())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruner.AllNamespaces()).To(BeTrue())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).To(HaveLen(1))
+				Expect(result.Pruned[0].GetName()).To(Equal("stale"))
+				Expect(pctx.ClusterScoped).To(BeTrue())
+
+				err = c.Get(context.Background(), client.ObjectKey{Name: "other"}, &rbacv1.ClusterRoleBinding{})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("Should refuse to prune cluster-scoped resources in a namespace", func() {
+				pruner, err := NewPruner(c, crbGVK, nil, WithStrategyV2(pruneAll), WithNamespace(namespace))
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = pruner.PruneWithResult(context.Background())
+				Expect(err).To(MatchError(ContainSubstring("cannot prune cluster-scoped")))
+				Expect(c.Get(context.Background(), client.ObjectKey{Name: "stale"}, &rbacv1.ClusterRoleBinding{})).To(Succeed())
+			})
+
+			It("Should override WithNamespace for namespaced resources", func() {
+				pruner, err := NewPruner(c, cmGVK, nil, WithStrategyV2(pruneAll), WithLabels(appLabels),
+					WithNamespace("ns1"), WithAllNamespaces())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruner.Namespace()).To(BeEmpty())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).To(HaveLen(2))
+				Expect(pctx.ClusterScoped).To(BeFalse())
+			})
+
+			It("Should restrict namespaced resources to the namespace otherwise", func() {
+				pruner, err := NewPruner(c, cmGVK, nil, WithStrategyV2(pruneAll), WithLabels(appLabels), WithNamespace("ns1"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pruner.AllNamespaces()).To(BeFalse())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).To(HaveLen(1))
+				Expect(result.Pruned[0].GetNamespace()).To(Equal("ns1"))
+			})
+		})
+
+		Describe("WithPerNamespaceStrategy()", func() {
+			var c client.Client
+
+			BeforeEach(func() {
+				var objs []client.Object
+				for ns, count := range map[string]int{"ns1": 3, "ns2": 2, "ns3": 1} {
+					for i := 0; i < count; i++ {
+						objs = append(objs, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+							Name: fmt.Sprintf("cm%d", i), Namespace: ns, Labels: appLabels,
+						}})
+					}
+				}
+				c = crFake.NewClientBuilder().WithObjects(objs...).Build()
+			})
+
+			countByNamespace := func(objs []client.Object) map[string]int {
+				counts := map[string]int{}
+				for _, obj := range objs {
+					counts[obj.GetNamespace()]++
+				}
+				return counts
+			}
+
+			configMapGVK := corev1.SchemeGroupVersion.WithKind("ConfigMap")
+
+			It("Should Apply the Strategy Within Each Namespace", func() {
+				var namespaces []string
+				strategy := func(ctx context.Context, pctx PruneContext, objs []client.Object) (StrategyResult, error) {
+					namespaces = append(namespaces, pctx.Namespace)
+					fromCtx, ok := PruneContextFrom(ctx)
+					Expect(ok).To(BeTrue())
+					Expect(fromCtx.Namespace).To(Equal(pctx.Namespace))
+					return StrategyV2(NewPruneByCountStrategy(1))(ctx, pctx, objs)
+				}
+				pruner, err := NewPruner(c, configMapGVK, nil, WithStrategyV2(strategy), WithLabels(appLabels),
+					WithAllNamespaces(), WithPerNamespaceStrategy())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(namespaces).To(Equal([]string{"ns1", "ns2", "ns3"}))
+				Expect(countByNamespace(result.Pruned)).To(Equal(map[string]int{"ns1": 2, "ns2": 1}))
+			})
+
+			It("Should Apply the Strategy Globally by Default", func() {
+				pruner, err := NewPruner(c, configMapGVK, NewPruneByCountStrategy(1), WithLabels(appLabels), WithAllNamespaces())
+				Expect(err).ShouldNot(HaveOccurred())
 
-					// Register our custom IsPrunableFunc
-					RegisterIsPrunableFunc(jobGVK, myIsPrunable)
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).To(HaveLen(5))
+			})
+		})
 
-					prunedObjects, err := pruner.Prune(context.Background())
-					Expect(err).Should(MatchError("error determining prunable objects: TESTERROR"))
-					Expect(prunedObjects).Should(BeNil())
+		Describe("WithOwnerSummary()", func() {
+			databaseKind := schema.GroupKind{Group: "example.com", Kind: "Database"}
+			pruneAll := func(_ context.Context, objs []client.Object) ([]client.Object, error) {
+				return objs, nil
+			}
 
-					// Get a list of the jobs to make sure we have pruned the ones we expected
-					Expect(fakeClient.List(context.Background(), jobs)).To(Succeed())
-					Expect(jobs.Items).Should(HaveLen(3))
-				})
+			BeforeEach(func() {
+				owners := map[string]metav1.OwnerReference{
+					"db-a-0": {APIVersion: "example.com/v1", Kind: "Database", Name: "db-a", UID: "a"},
+					"db-a-1": {APIVersion: "example.com/v1", Kind: "Database", Name: "db-a", UID: "a"},
+					"rs-0":   {APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs", UID: "rs"},
+				}
+				controller := true
+				for name, owner := range owners {
+					owner.Controller = &controller
+					Expect(fakeClient.Create(context.Background(), &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, OwnerReferences: []metav1.OwnerReference{owner}},
+						Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+					})).To(Succeed())
+				}
+				Expect(fakeClient.Create(context.Background(), &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "unowned", Namespace: namespace},
+					Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+				})).To(Succeed())
+			})
 
-				It("Should Return an Error if it can not Prune a Resource", func() {
-					// Create the test resources - in this case Jobs
-					Expect(createTestJobs(fakeClient)).To(Succeed())
+			It("Should Summarize the Pruned Children of Each Owner", func() {
+				var summaries []OwnerSummary
+				record := func(_ context.Context, summary OwnerSummary) error {
+					summaries = append(summaries, summary)
+					return nil
+				}
+				now := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithNamespace(namespace),
+					WithClock(clocktesting.NewFakePassiveClock(now)), WithOwnerSummary(record))
+				Expect(err).ShouldNot(HaveOccurred())
 
-					// Make sure the job resources are properly created
-					jobs := &unstructured.UnstructuredList{}
-					jobs.SetGroupVersionKind(jobGVK)
-					Expect(fakeClient.List(context.Background(), jobs)).To(Succeed())
-					Expect(jobs.Items).Should(HaveLen(3))
+				result, err := pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.Pruned).To(HaveLen(4))
+				Expect(summaries).To(HaveLen(2))
+				Expect(summaries[0].Owner.Name).To(Equal("db-a"))
+				Expect(summaries[0].Namespace).To(Equal(namespace))
+				Expect(summaries[0].Pruned).To(HaveLen(2))
+				Expect(summaries[0].Time).To(Equal(now))
+				Expect(summaries[1].Owner.Name).To(Equal("rs"))
+				Expect(summaries[1].Pruned).To(HaveLen(1))
+			})
 
-					pruner, err := NewPruner(fakeClient, jobGVK, myStrategy, WithLabels(appLabels), WithNamespace(namespace))
-					Expect(err).ShouldNot(HaveOccurred())
-					Expect(pruner).ShouldNot(BeNil())
+			It("Should Only Summarize Owners of the Given Kinds", func() {
+				var owners []string
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithNamespace(namespace),
+					WithOwnerSummary(func(_ context.Context, summary OwnerSummary) error {
+						owners = append(owners, summary.Owner.Name)
+						return errors.New("owner is gone")
+					}, databaseKind))
+				Expect(err).ShouldNot(HaveOccurred())
 
-					// IsPrunableFunc that returns nil but also deletes the object
-					// so that it will throw an error when attempting to remove the object
-					prunableFunc := func(obj client.Object) error {
-						_ = fakeClient.Delete(context.TODO(), obj, &client.DeleteOptions{})
+				_, err = pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(owners).To(Equal([]string{"db-a"}))
+			})
+
+			It("Should Not Summarize Dry Runs", func() {
+				called := false
+				pruner, err := NewPruner(fakeClient, podGVK, pruneAll, WithNamespace(namespace), WithDryRun(),
+					WithOwnerSummary(func(context.Context, OwnerSummary) error {
+						called = true
 						return nil
-					}
+					}))
+				Expect(err).ShouldNot(HaveOccurred())
 
-					// Register our custom IsPrunableFunc
-					RegisterIsPrunableFunc(jobGVK, prunableFunc)
+				_, err = pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(called).To(BeFalse())
+			})
 
-					prunedObjects, err := pruner.Prune(context.Background())
-					Expect(err).Should(MatchError(ContainSubstring("error pruning object: jobs.batch \"churro1\" not found")))
-					Expect(prunedObjects).Should(BeEmpty())
+			newDatabase := func(uid types.UID, conditions ...interface{}) *unstructured.Unstructured {
+				db := &unstructured.Unstructured{}
+				db.SetAPIVersion("example.com/v1")
+				db.SetKind("Database")
+				db.SetName("db-a")
+				db.SetNamespace(namespace)
+				db.SetUID(uid)
+				if len(conditions) > 0 {
+					Expect(unstructured.SetNestedSlice(db.Object, conditions, "status", "conditions")).To(Succeed())
+				}
+				return db
+			}
 
-					// Get a list of the jobs to make sure we have pruned the ones we expected
-					Expect(fakeClient.List(context.Background(), jobs)).To(Succeed())
-					Expect(jobs.Items).Should(BeEmpty())
+			It("Should Apply the Summary to the Owner", func() {
+				Expect(fakeClient.Create(context.Background(), newDatabase("a", map[string]interface{}{
+					"type":               "Pruned",
+					"status":             "True",
+					"lastTransitionTime": "2021-01-01T00:00:00Z",
+				}))).To(Succeed())
+				var patches []string
+				c := interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+					Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, patch client.Patch, _ ...client.PatchOption) error {
+						Expect(patch.Type()).To(Equal(types.ApplyPatchType))
+						data, err := patch.Data(obj)
+						Expect(err).ShouldNot(HaveOccurred())
+						patches = append(patches, string(data))
+						return nil
+					},
+					SubResourcePatch: func(_ context.Context, _ client.Client, subResource string, obj client.Object, patch client.Patch, _ ...client.SubResourcePatchOption) error {
+						Expect(subResource).To(Equal("status"))
+						Expect(patch.Type()).To(Equal(types.ApplyPatchType))
+						data, err := patch.Data(obj)
+						Expect(err).ShouldNot(HaveOccurred())
+						patches = append(patches, string(data))
+						return nil
+					},
 				})
+				pruner, err := NewPruner(c, podGVK, pruneAll, WithNamespace(namespace),
+					WithOwnerSummary(NewOwnerConditionSummary(c, "Pruned", "pruner"), databaseKind),
+					WithOwnerSummary(NewOwnerAnnotationSummary(c, "pruner"), databaseKind))
+				Expect(err).ShouldNot(HaveOccurred())
 
+				_, err = pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(patches).To(HaveLen(2))
+				Expect(patches[0]).To(ContainSubstring(`"kind":"Database"`))
+				Expect(patches[0]).To(ContainSubstring(`"reason":"PrunedChildren"`))
+				Expect(patches[0]).To(ContainSubstring(`"message":"Pruned 2 objects"`))
+				Expect(patches[0]).To(ContainSubstring(`"lastTransitionTime":"2021-01-01T00:00:00Z"`))
+				Expect(patches[0]).To(ContainSubstring(`"uid":"a"`))
+				Expect(patches[1]).To(ContainSubstring(`"` + PrunedCountAnnotation + `":"2"`))
+				Expect(patches[1]).To(ContainSubstring(LastPruneTimeAnnotation))
+				Expect(patches[1]).To(ContainSubstring(`"uid":"a"`))
 			})
-		})
 
-		Describe("GVK()", func() {
-			It("Should return the GVK field in the Pruner", func() {
-				pruner, err := NewPruner(fakeClient, podGVK, myStrategy)
-				Expect(err).ShouldNot(HaveOccurred())
-				Expect(pruner).ShouldNot(BeNil())
-				Expect(pruner.GVK()).Should(Equal(podGVK))
+			It("Should Skip Owners That Are Gone", func() {
+				patched := false
+				c := interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+					Patch: func(context.Context, client.WithWatch, client.Object, client.Patch, ...client.PatchOption) error {
+						patched = true
+						return apierrors.NewConflict(schema.GroupResource{Group: "example.com", Resource: "databases"}, "db-a",
+							errors.New("uid mismatch: the provided object specified uid a, and no existing object was found"))
+					},
+					SubResourcePatch: func(context.Context, client.Client, string, client.Object, client.Patch, ...client.SubResourcePatchOption) error {
+						patched = true
+						return nil
+					},
+				})
+				summary := OwnerSummary{
+					Owner:     metav1.OwnerReference{APIVersion: "example.com/v1", Kind: "Database", Name: "db-a", UID: "a"},
+					Namespace: namespace,
+					Time:      time.Now(),
+				}
+
+				By("skipping deleted owners")
+				Expect(NewOwnerConditionSummary(c, "Pruned", "pruner")(context.Background(), summary)).To(Succeed())
+				Expect(NewOwnerAnnotationSummary(c, "pruner")(context.Background(), summary)).To(Succeed())
+
+				By("skipping owners replaced by an object with the same name")
+				Expect(fakeClient.Create(context.Background(), newDatabase("b"))).To(Succeed())
+				patched = false
+				Expect(NewOwnerConditionSummary(c, "Pruned", "pruner")(context.Background(), summary)).To(Succeed())
+				Expect(patched).To(BeFalse())
 			})
-		})
 
-		Describe("Labels()", func() {
-			It("Should return the Labels field in the Pruner", func() {
-				pruner, err := NewPruner(fakeClient, podGVK, myStrategy, WithLabels(appLabels))
+			It("Should Summarize Cluster-Scoped Owners Without a Namespace", func() {
+				mapper := meta.NewDefaultRESTMapper(nil)
+				mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"}, meta.RESTScopeRoot)
+				mapper.Add(podGVK, meta.RESTScopeNamespace)
+				pods := &corev1.PodList{}
+				Expect(fakeClient.List(context.Background(), pods)).To(Succeed())
+				objs := make([]client.Object, 0, len(pods.Items))
+				for i := range pods.Items {
+					objs = append(objs, &pods.Items[i])
+				}
+				c := crFake.NewClientBuilder().WithRESTMapper(mapper).WithObjects(objs...).Build()
+
+				var summaries []OwnerSummary
+				pruner, err := NewPruner(c, podGVK, pruneAll, WithNamespace(namespace),
+					WithOwnerSummary(func(_ context.Context, summary OwnerSummary) error {
+						summaries = append(summaries, summary)
+						return nil
+					}, databaseKind))
 				Expect(err).ShouldNot(HaveOccurred())
-				Expect(pruner).ShouldNot(BeNil())
-				Expect(pruner.Labels()).Should(Equal(appLabels))
+
+				_, err = pruner.PruneWithResult(context.Background())
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(summaries).To(HaveLen(1))
+				Expect(summaries[0].Namespace).To(BeEmpty())
+				Expect(summaries[0].Pruned).To(HaveLen(2))
 			})
 		})
 
@@ -499,6 +2971,219 @@ var _ = Describe("Prune", func() {
 		})
 	})
 
+	Context("FinishedPodIsPrunable", func() {
+		It("Should Mark Succeeded, Failed and Evicted Pods as Prunable", func() {
+			for _, status := range []corev1.PodStatus{
+				{Phase: corev1.PodSucceeded},
+				{Phase: corev1.PodFailed},
+				{Phase: corev1.PodFailed, Reason: "Evicted"},
+			} {
+				pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: app, Namespace: namespace}, Status: status}
+				Expect(FinishedPodIsPrunable(pod)).To(Succeed())
+			}
+		})
+
+		It("Should Not Mark Running Pods as Prunable", func() {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: app, Namespace: namespace}, Status: corev1.PodStatus{Phase: corev1.PodRunning}}
+			Expect(IsUnprunable(FinishedPodIsPrunable(pod))).To(BeTrue())
+		})
+
+		It("Should Return An Error When client.Object is not of type 'Pod'", func() {
+			err := FinishedPodIsPrunable(&corev1.ConfigMap{})
+			Expect(err).To(HaveOccurred())
+			Expect(IsUnprunable(err)).To(BeFalse())
+		})
+	})
+
+	Context("ReleasedPersistentVolumeIsPrunable", func() {
+		It("Should Only Mark Released PersistentVolumes as Prunable", func() {
+			pv := &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: app}}
+			pv.Status.Phase = corev1.VolumeReleased
+			Expect(ReleasedPersistentVolumeIsPrunable(pv)).To(Succeed())
+
+			pv.Status.Phase = corev1.VolumeBound
+			Expect(IsUnprunable(ReleasedPersistentVolumeIsPrunable(pv))).To(BeTrue())
+		})
+	})
+
+	Context("CronJobJobIsPrunable", func() {
+		var job *batchv1.Job
+
+		BeforeEach(func() {
+			job = &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: app, Namespace: namespace},
+				Status:     batchv1.JobStatus{CompletionTime: &metav1.Time{Time: time.Now()}},
+			}
+			isController := true
+			job.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: "batch/v1",
+				Kind:       "CronJob",
+				Name:       "nightly",
+				UID:        "cron-uid",
+				Controller: &isController,
+			}}
+		})
+
+		It("Should Mark Finished Jobs of CronJobs as Prunable", func() {
+			Expect(CronJobJobIsPrunable(job)).To(Succeed())
+		})
+
+		It("Should Not Mark Running Jobs of CronJobs as Prunable", func() {
+			job.Status.CompletionTime = nil
+			err := CronJobJobIsPrunable(job)
+			Expect(IsUnprunable(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("Job has not finished")))
+		})
+
+		It("Should Not Mark Jobs Not Created by a CronJob as Prunable", func() {
+			job.OwnerReferences = nil
+			err := CronJobJobIsPrunable(job)
+			Expect(IsUnprunable(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("not created by a CronJob")))
+		})
+	})
+
+	Context("NewUnusedIsPrunable", func() {
+		var (
+			c         client.Client
+			pctx      PruneContext
+			fakeClock *clocktesting.FakePassiveClock
+			old       metav1.Time
+		)
+
+		BeforeEach(func() {
+			fakeClock = clocktesting.NewFakePassiveClock(time.Now())
+			pctx = PruneContext{Clock: fakeClock}
+			old = metav1.NewTime(fakeClock.Now().Add(-2 * time.Hour))
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "consumer", Namespace: namespace},
+				Spec: corev1.PodSpec{
+					ImagePullSecrets: []corev1.LocalObjectReference{{Name: "pull"}},
+					Volumes: []corev1.Volume{{
+						Name: "config",
+						VolumeSource: corev1.VolumeSource{
+							ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "mounted"}},
+						},
+					}},
+					Containers: []corev1.Container{{
+						Name: "app",
+						Env: []corev1.EnvVar{{
+							Name: "PASSWORD",
+							ValueFrom: &corev1.EnvVarSource{
+								SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "password"},
+							},
+						}},
+					}},
+				},
+			}
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "scaled-down", Namespace: namespace},
+				Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					Volumes: []corev1.Volume{{
+						Name: "config",
+						VolumeSource: corev1.VolumeSource{
+							ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "templated"}},
+						},
+					}},
+				}}},
+			}
+			cronJob := &batchv1.CronJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: namespace},
+				Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					ImagePullSecrets: []corev1.LocalObjectReference{{Name: "nightly-pull"}},
+				}}}}},
+			}
+			serviceAccount := &corev1.ServiceAccount{
+				ObjectMeta:       metav1.ObjectMeta{Name: "builder", Namespace: namespace},
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}},
+			}
+			c = crFake.NewClientBuilder().WithObjects(pod, deployment, cronJob, serviceAccount).Build()
+		})
+
+		It("Should Mark Old Unreferenced ConfigMaps and Secrets as Prunable", func() {
+			isPrunable := NewUnusedIsPrunable(c, time.Hour)
+			Expect(isPrunable(context.Background(), pctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "unused", Namespace: namespace, CreationTimestamp: old}})).To(Succeed())
+			Expect(isPrunable(context.Background(), pctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unused", Namespace: namespace, CreationTimestamp: old}})).To(Succeed())
+		})
+
+		It("Should Not Mark Referenced ConfigMaps and Secrets as Prunable", func() {
+			isPrunable := NewUnusedIsPrunable(c, time.Hour)
+			for _, obj := range []client.Object{
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "mounted", Namespace: namespace, CreationTimestamp: old}},
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: namespace, CreationTimestamp: old}},
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "pull", Namespace: namespace, CreationTimestamp: old}},
+			} {
+				err := isPrunable(context.Background(), pctx, obj)
+				Expect(IsUnprunable(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("referenced by Pod consumer")))
+			}
+		})
+
+		It("Should Not Mark ConfigMaps and Secrets Referenced by Workloads or ServiceAccounts as Prunable", func() {
+			isPrunable := NewUnusedIsPrunable(c, time.Hour)
+			for obj, consumer := range map[client.Object]string{
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "templated", Namespace: namespace, CreationTimestamp: old}}: "Deployment scaled-down",
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "nightly-pull", Namespace: namespace, CreationTimestamp: old}}: "CronJob nightly",
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "registry", Namespace: namespace, CreationTimestamp: old}}:     "ServiceAccount builder",
+			} {
+				err := isPrunable(context.Background(), pctx, obj)
+				Expect(IsUnprunable(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("referenced by " + consumer)))
+			}
+		})
+
+		It("Should List Consumers Once per Run and Namespace", func() {
+			lists := 0
+			counting := interceptor.NewClient(c.(client.WithWatch), interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if _, ok := list.(*corev1.PodList); ok {
+						lists++
+					}
+					return c.List(ctx, list, opts...)
+				},
+			})
+			isPrunable := NewUnusedIsPrunable(counting, time.Hour)
+			pctx.RunID = "run-1"
+			for _, name := range []string{"a", "b", "c"} {
+				Expect(isPrunable(context.Background(), pctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, CreationTimestamp: old}})).To(Succeed())
+			}
+			Expect(lists).To(Equal(1))
+
+			pctx.RunID = "run-2"
+			Expect(isPrunable(context.Background(), pctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: namespace, CreationTimestamp: old}})).To(Succeed())
+			Expect(lists).To(Equal(2))
+		})
+
+		It("Should Not Mark Recent, Owned or Cluster-Managed Objects as Prunable", func() {
+			isPrunable := NewUnusedIsPrunable(c, time.Hour)
+			recent := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "recent", Namespace: namespace, CreationTimestamp: metav1.NewTime(fakeClock.Now())}}
+			owned := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owned", Namespace: namespace, CreationTimestamp: old,
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: "owner", UID: "owner-uid"}}}}
+			rootCA := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kube-root-ca.crt", Namespace: namespace, CreationTimestamp: old}}
+			token := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: namespace, CreationTimestamp: old},
+				Type: corev1.SecretTypeServiceAccountToken}
+			for _, obj := range []client.Object{recent, owned, rootCA, token} {
+				Expect(IsUnprunable(isPrunable(context.Background(), pctx, obj))).To(BeTrue())
+			}
+		})
+
+		It("Should Be Registered for ConfigMaps and Secrets", func() {
+			registry := NewRegistry()
+			registry.RegisterUnusedIsPrunable(c, time.Hour)
+			registry.RegisterFinishedPodIsPrunable()
+			registry.RegisterReleasedPersistentVolumeIsPrunable()
+			registry.RegisterCronJobJobIsPrunable()
+
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "mounted", Namespace: namespace}}
+			cm.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+			Expect(IsUnprunable(registry.IsPrunableWithContext(context.Background(), pctx, cm))).To(BeTrue())
+
+			pod := &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed}}
+			pod.SetGroupVersionKind(podGVK)
+			Expect(registry.IsPrunable(pod)).To(Succeed())
+		})
+	})
+
 	Context("NewPruneByCountStrategy", func() {
 		resources := createDatedResources()
 		It("Should return the 3 oldest resources", func() {
@@ -514,6 +3199,162 @@ var _ = Describe("Prune", func() {
 		})
 	})
 
+	Context("SortObjects", func() {
+		It("Should Sort Resources Oldest First", func() {
+			resources := createDatedResources()
+			sorted := []client.Object{resources[3], resources[0], resources[4], resources[2], resources[1]}
+			SortObjects(sorted)
+			Expect(sorted).Should(Equal(resources))
+		})
+
+		It("Should Sort Resources With the Same Creation Time by Namespace and Name", func() {
+			created := metav1.Now()
+			var resources []client.Object
+			for _, key := range []string{"b/y", "a/z", "b/x", "a/y"} {
+				ns, name, _ := strings.Cut(key, "/")
+				obj := &unstructured.Unstructured{}
+				obj.SetNamespace(ns)
+				obj.SetName(name)
+				obj.SetCreationTimestamp(created)
+				resources = append(resources, obj)
+			}
+			SortObjects(resources)
+			var keys []string
+			for _, obj := range resources {
+				keys = append(keys, client.ObjectKeyFromObject(obj).String())
+			}
+			Expect(keys).Should(Equal([]string{"a/y", "a/z", "b/x", "b/y"}))
+		})
+	})
+
+	Context("keepNewest", func() {
+		resources := createDatedResources()
+		It("Should return all but the 2 most recent resources", func() {
+			resourcesToRemove, err := keepNewest(2)(context.Background(), resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resourcesToRemove).Should(ConsistOf(resources[0], resources[1], resources[2]))
+		})
+
+		It("Should return nil", func() {
+			resourcesToRemove, err := keepNewest(5)(context.Background(), resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resourcesToRemove).Should(BeNil())
+		})
+	})
+
+	Context("NewPruneOlderThan", func() {
+		resources := createDatedResources()
+		It("Should return the resources created before the max age", func() {
+			resourcesToRemove, err := NewPruneOlderThan(-90*time.Minute)(context.Background(), resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resourcesToRemove).Should(ConsistOf(resources[0], resources[1]))
+		})
+
+		It("Should use the clock of the PruneContext", func() {
+			fakeClock := clocktesting.NewFakePassiveClock(time.Now().Add(5 * time.Hour))
+			ctx := WithPruneContext(context.Background(), PruneContext{Clock: fakeClock})
+			resourcesToRemove, err := NewPruneOlderThan(150*time.Minute)(ctx, resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resourcesToRemove).Should(ConsistOf(resources[0], resources[1], resources[2]))
+		})
+	})
+
+	Context("PersistentVolumeClaims", func() {
+		var (
+			c         client.Client
+			finished  metav1.Time
+			fakeClock *clocktesting.FakePassiveClock
+		)
+
+		newPVC := func(name string, owners ...client.Object) *corev1.PersistentVolumeClaim {
+			pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+			for _, owner := range owners {
+				gvk := owner.GetObjectKind().GroupVersionKind()
+				pvc.OwnerReferences = append(pvc.OwnerReferences, metav1.OwnerReference{
+					APIVersion: gvk.GroupVersion().String(),
+					Kind:       gvk.Kind,
+					Name:       owner.GetName(),
+					UID:        owner.GetUID(),
+				})
+			}
+			return pvc
+		}
+		newJob := func(name string, completion *metav1.Time) *batchv1.Job {
+			job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: types.UID(name)}}
+			job.SetGroupVersionKind(batchv1.SchemeGroupVersion.WithKind("Job"))
+			job.Status.CompletionTime = completion
+			return job
+		}
+		newPod := func(name string, phase corev1.PodPhase, claim string) *corev1.Pod {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: types.UID(name)}}
+			pod.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+			pod.Status.Phase = phase
+			if claim != "" {
+				pod.Spec.Volumes = []corev1.Volume{{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+					},
+				}}
+			}
+			return pod
+		}
+
+		BeforeEach(func() {
+			now := time.Now()
+			finished = metav1.NewTime(now.Add(-2 * time.Hour).Truncate(time.Second))
+			fakeClock = clocktesting.NewFakePassiveClock(now)
+			c = crFake.NewClientBuilder().WithObjects(
+				newJob("done", &finished),
+				newJob("running", nil),
+				newPod("mounter", corev1.PodRunning, "mounted"),
+				newPod("finished", corev1.PodSucceeded, "done"),
+			).Build()
+		})
+
+		It("Should Mark PersistentVolumeClaims of Finished Jobs as Prunable", func() {
+			Expect(NewPVCIsPrunable(c)(newPVC("done", newJob("done", nil)))).To(Succeed())
+		})
+
+		It("Should Mark PersistentVolumeClaims of Deleted Owners as Prunable", func() {
+			Expect(NewPVCIsPrunable(c)(newPVC("gone", newJob("gone", nil)))).To(Succeed())
+		})
+
+		It("Should Not Mark PersistentVolumeClaims of Running Jobs as Prunable", func() {
+			err := NewPVCIsPrunable(c)(newPVC("running", newJob("running", nil)))
+			Expect(IsUnprunable(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("Job running has not finished")))
+		})
+
+		It("Should Not Mark PersistentVolumeClaims Mounted by Running Pods as Prunable", func() {
+			err := NewPVCIsPrunable(c)(newPVC("mounted", newJob("done", nil)))
+			Expect(IsUnprunable(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("mounted by running Pod mounter")))
+		})
+
+		It("Should Not Mark PersistentVolumeClaims Without Workload Owners as Prunable", func() {
+			err := NewPVCIsPrunable(c)(newPVC("unowned"))
+			Expect(IsUnprunable(err)).To(BeTrue())
+		})
+
+		It("Should Prune PersistentVolumeClaims Released Before the Retention", func() {
+			ctx := WithPruneContext(context.Background(), PruneContext{Clock: fakeClock})
+			released := newPVC("done", newJob("done", nil))
+			retained := newPVC("retained", newPod("finished", "", ""))
+			retained.CreationTimestamp = metav1.NewTime(fakeClock.Now())
+			running := newPVC("running", newJob("running", nil))
+			objs := []client.Object{released, retained, running}
+
+			resourcesToRemove, err := NewPVCRetentionStrategy(c, time.Hour)(ctx, objs)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resourcesToRemove).Should(ConsistOf(released))
+
+			resourcesToRemove, err = NewPVCRetentionStrategy(c, 3*time.Hour)(ctx, objs)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resourcesToRemove).Should(BeEmpty())
+		})
+	})
+
 	Context("NewPruneByDateStrategy", func() {
 		resources := createDatedResources()
 		It("Should return 2 resources", func() {
@@ -531,6 +3372,125 @@ var _ = Describe("Prune", func() {
 		})
 	})
 
+	Context("NewPruneBySizeStrategy", func() {
+		configMap := func(namespace, name string, age time.Duration) client.Object {
+			return &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         namespace,
+					Name:              name,
+					CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+				},
+				Data: map[string]string{"result": strings.Repeat("x", 1000)},
+			}
+		}
+		resources := []client.Object{
+			configMap("a", "newest", time.Minute),
+			configMap("a", "oldest", 4*time.Hour),
+			configMap("b", "alone", 5*time.Hour),
+			configMap("a", "recent", time.Hour),
+			configMap("a", "old", 2*time.Hour),
+		}
+
+		It("Should Prune the Oldest Resources Exceeding the Budget of Each Namespace", func() {
+			resourcesToRemove, err := NewPruneBySizeStrategy(2500)(context.Background(), resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resourcesToRemove).Should(HaveLen(2))
+			Expect(resourcesToRemove[0].GetName()).Should(Equal("oldest"))
+			Expect(resourcesToRemove[1].GetName()).Should(Equal("old"))
+		})
+
+		It("Should Not Prune Resources Within the Budget", func() {
+			resourcesToRemove, err := NewPruneBySizeStrategy(10000)(context.Background(), resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resourcesToRemove).Should(BeEmpty())
+		})
+
+		It("Should Prune All Resources Larger Than the Budget", func() {
+			resourcesToRemove, err := NewPruneBySizeStrategy(100)(context.Background(), resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resourcesToRemove).Should(HaveLen(5))
+		})
+	})
+
+	Context("Strategy Combinators", func() {
+		// churro0 is the oldest resource and churro4 the newest
+		resources := createDatedResources()
+		names := func(objs []client.Object) []string {
+			var result []string
+			for _, obj := range objs {
+				result = append(result, obj.GetName())
+			}
+			return result
+		}
+		// prunes churro1 to churro4
+		newerThanNow := NewPruneByDateStrategy(time.Now().Add(30 * time.Minute))
+		// prunes churro3 and churro4
+		newest := NewPruneByDateStrategy(time.Now().Add(150 * time.Minute))
+
+		It("AllOf Should Prune Resources Selected by All Strategies", func() {
+			resourcesToRemove, err := AllOf(newerThanNow, myStrategy)(context.Background(), resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(names(resourcesToRemove)).Should(Equal([]string{"churro1", "churro2"}))
+
+			resourcesToRemove, err = AllOf(newest, myStrategy)(context.Background(), resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resourcesToRemove).Should(BeEmpty())
+		})
+
+		It("AnyOf Should Prune Resources Selected by Any Strategy", func() {
+			resourcesToRemove, err := AnyOf(newest, myStrategy)(context.Background(), resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(names(resourcesToRemove)).Should(Equal([]string{"churro1", "churro2", "churro3", "churro4"}))
+		})
+
+		It("Chain Should Give Each Strategy the Resources Selected by the Previous One", func() {
+			// NewPruneByCountStrategy(2) prunes all but the 2 oldest resources it is given
+			resourcesToRemove, err := Chain(newerThanNow, NewPruneByCountStrategy(2))(context.Background(), resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(names(resourcesToRemove)).Should(Equal([]string{"churro3", "churro4"}))
+
+			resourcesToRemove, err = AllOf(newerThanNow, NewPruneByCountStrategy(2))(context.Background(), resources)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(names(resourcesToRemove)).Should(Equal([]string{"churro2", "churro3", "churro4"}))
+		})
+
+		It("Should Return Resources in the Order They Were Given", func() {
+			reversed := make([]client.Object, 0, len(resources))
+			for i := len(resources) - 1; i >= 0; i-- {
+				reversed = append(reversed, resources[i])
+			}
+
+			for _, strategy := range []StrategyFunc{
+				AllOf(newerThanNow, NewPruneByCountStrategy(2)),
+				AnyOf(NewPruneByCountStrategy(2), newest),
+				Chain(NewPruneByCountStrategy(2), newerThanNow),
+			} {
+				resourcesToRemove, err := strategy(context.Background(), reversed)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(names(resourcesToRemove)).Should(Equal([]string{"churro4", "churro3", "churro2"}))
+				Expect(names(reversed)).Should(Equal([]string{"churro4", "churro3", "churro2", "churro1", "churro0"}))
+			}
+		})
+
+		It("Should Not Prune Without Strategies", func() {
+			for _, strategy := range []StrategyFunc{AllOf(), AnyOf(), Chain()} {
+				resourcesToRemove, err := strategy(context.Background(), resources)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resourcesToRemove).Should(BeEmpty())
+			}
+		})
+
+		It("Should Return the Errors of Strategies", func() {
+			failing := func(context.Context, []client.Object) ([]client.Object, error) {
+				return nil, errors.New("TEST")
+			}
+			for _, strategy := range []StrategyFunc{AllOf(myStrategy, failing), AnyOf(failing), Chain(myStrategy, failing)} {
+				_, err := strategy(context.Background(), resources)
+				Expect(err).Should(MatchError(ContainSubstring("TEST")))
+			}
+		})
+	})
+
 })
 
 // create 3 pods and 3 jobs with different start times (now, 2 days old, 4 days old)
@@ -655,6 +3615,20 @@ func createSchemes() (*runtime.Scheme, error) {
 // myStrategy shows how you can write your own strategy
 // In this example it simply removes a resource if it has
 // the name 'churro1' or 'churro2'
+// readerCache is a fake cache reading objects with reader.
+type readerCache struct {
+	*informertest.FakeInformers
+	reader client.Reader
+}
+
+func (c readerCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.reader.Get(ctx, key, obj, opts...)
+}
+
+func (c readerCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.reader.List(ctx, list, opts...)
+}
+
 func myStrategy(_ context.Context, objs []client.Object) ([]client.Object, error) {
 	var objsToRemove []client.Object
 
@@ -681,3 +3655,23 @@ func expectPanic() {
 func myIsPrunable(_ client.Object) error {
 	return nil
 }
+
+// fakeCondition records the status set on a conditions.Condition.
+type fakeCondition struct {
+	status  metav1.ConditionStatus
+	reason  string
+	message string
+}
+
+func (c *fakeCondition) Get(context.Context) (*metav1.Condition, error) {
+	return &metav1.Condition{Status: c.status, Reason: c.reason, Message: c.message}, nil
+}
+
+func (c *fakeCondition) Set(_ context.Context, status metav1.ConditionStatus, opts ...conditions.Option) error {
+	cond := &metav1.Condition{Status: status}
+	for _, opt := range opts {
+		opt(cond)
+	}
+	c.status, c.reason, c.message = cond.Status, cond.Reason, cond.Message
+	return nil
+}
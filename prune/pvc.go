@@ -0,0 +1,185 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewPVCIsPrunable returns an IsPrunableFunc for PersistentVolumeClaims left behind by batch
+// workloads. A PersistentVolumeClaim is prunable if it is owned by Jobs or Pods that have all
+// finished or no longer exist, and no running Pod mounts it. PersistentVolumeClaims without
+// a Job or Pod owner are never prunable. Owners and Pods are read with reader.
+func NewPVCIsPrunable(reader client.Reader) IsPrunableFunc {
+	return func(obj client.Object) error {
+		_, err := pvcReleaseTime(context.Background(), reader, obj)
+		return err
+	}
+}
+
+// NewPVCRetentionStrategy returns a StrategyFunc for PersistentVolumeClaims that prunes those
+// released by their workload more than retention ago, as determined by NewPVCIsPrunable.
+// A PersistentVolumeClaim is released when the last of its owners finished, or when it was
+// created if the finish time of its owners is unknown. The current time is read from the
+// Clock of the PruneContext, see WithClock.
+func NewPVCRetentionStrategy(reader client.Reader, retention time.Duration) StrategyFunc {
+	return func(ctx context.Context, objs []client.Object) ([]client.Object, error) {
+		var c clock.PassiveClock = clock.RealClock{}
+		if pctx, ok := PruneContextFrom(ctx); ok && pctx.Clock != nil {
+			c = pctx.Clock
+		}
+
+		var objsToPrune []client.Object
+
+		cutoff := c.Now().Add(-retention)
+		for _, obj := range objs {
+			released, err := pvcReleaseTime(ctx, reader, obj)
+			if IsUnprunable(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if released.Before(cutoff) {
+				objsToPrune = append(objsToPrune, obj)
+			}
+		}
+
+		return objsToPrune, nil
+	}
+}
+
+// pvcReleaseTime returns the time at which the PersistentVolumeClaim obj was released by its
+// owners, or an Unprunable error if it is still in use.
+func pvcReleaseTime(ctx context.Context, reader client.Reader, obj client.Object) (time.Time, error) {
+	pvc, err := toPVC(obj)
+	if err != nil {
+		return time.Time{}, err
+	}
+	unprunable := func(reason string, args ...interface{}) error {
+		return &Unprunable{Obj: &obj, Reason: fmt.Sprintf(reason, args...)}
+	}
+
+	var released time.Time
+	owners := 0
+	for _, ref := range pvc.GetOwnerReferences() {
+		var (
+			owner    client.Object
+			finished func() (bool, time.Time)
+		)
+		switch {
+		case ref.APIVersion == batchv1.SchemeGroupVersion.String() && ref.Kind == "Job":
+			job := &batchv1.Job{}
+			owner, finished = job, func() (bool, time.Time) { return jobFinished(job) }
+		case ref.APIVersion == corev1.SchemeGroupVersion.String() && ref.Kind == "Pod":
+			pod := &corev1.Pod{}
+			owner, finished = pod, func() (bool, time.Time) { return podFinished(pod) }
+		default:
+			continue
+		}
+		owners++
+
+		err := reader.Get(ctx, client.ObjectKey{Namespace: pvc.Namespace, Name: ref.Name}, owner)
+		if apierrors.IsNotFound(err) || (err == nil && owner.GetUID() != ref.UID && ref.UID != "") {
+			continue
+		}
+		if err != nil {
+			return time.Time{}, fmt.Errorf("error getting %s %s of PersistentVolumeClaim: %w", ref.Kind, ref.Name, err)
+		}
+		done, at := finished()
+		if !done {
+			return time.Time{}, unprunable("%s %s has not finished", ref.Kind, ref.Name)
+		}
+		if at.After(released) {
+			released = at
+		}
+	}
+	if owners == 0 {
+		return time.Time{}, unprunable("PersistentVolumeClaim is not owned by a Job or Pod")
+	}
+
+	pods := &corev1.PodList{}
+	if err := reader.List(ctx, pods, client.InNamespace(pvc.Namespace)); err != nil {
+		return time.Time{}, fmt.Errorf("error listing Pods mounting PersistentVolumeClaim: %w", err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if done, _ := podFinished(pod); done {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == pvc.Name {
+				return time.Time{}, unprunable("PersistentVolumeClaim is mounted by running Pod %s", pod.Name)
+			}
+		}
+	}
+
+	if released.IsZero() {
+		released = pvc.GetCreationTimestamp().Time
+	}
+	return released, nil
+}
+
+func toPVC(obj client.Object) (*corev1.PersistentVolumeClaim, error) {
+	switch o := obj.(type) {
+	case *corev1.PersistentVolumeClaim:
+		return o, nil
+	case runtime.Unstructured:
+		pvc := &corev1.PersistentVolumeClaim{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.UnstructuredContent(), pvc); err != nil {
+			return nil, fmt.Errorf("error converting object to PersistentVolumeClaim: %w", err)
+		}
+		return pvc, nil
+	default:
+		return nil, fmt.Errorf("object of type %T is not a PersistentVolumeClaim", obj)
+	}
+}
+
+// jobFinished returns true if job completed or failed, and the time at which it did.
+func jobFinished(job *batchv1.Job) (bool, time.Time) {
+	if job.Status.CompletionTime != nil {
+		return true, job.Status.CompletionTime.Time
+	}
+	for _, c := range job.Status.Conditions {
+		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) && c.Status == corev1.ConditionTrue {
+			return true, c.LastTransitionTime.Time
+		}
+	}
+	return false, time.Time{}
+}
+
+// podFinished returns true if pod succeeded or failed, and the time at which its last container
+// terminated, if known.
+func podFinished(pod *corev1.Pod) (bool, time.Time) {
+	if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+		return false, time.Time{}
+	}
+	var at time.Time
+	for _, status := range pod.Status.ContainerStatuses {
+		if t := status.State.Terminated; t != nil && t.FinishedAt.After(at) {
+			at = t.FinishedAt.Time
+		}
+	}
+	return true, at
+}
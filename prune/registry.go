@@ -15,14 +15,32 @@
 package prune
 
 import (
+	"context"
+	"strconv"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// Registry is used to register a mapping of GroupVersionKind to an IsPrunableFunc
+// ProtectAnnotation is an annotation that protects an object from being pruned. When it is set to a truthy
+// value, ex. "true", the object is never prunable, regardless of the strategy and IsPrunableFunc in use.
+// This lets users keep individual objects, such as a Job whose results they still need, out of an
+// operator's retention policy:
+//
+//	kubectl annotate job my-job operator-lib.operatorframework.io/prune-protect=true
+const ProtectAnnotation = "operator-lib.operatorframework.io/prune-protect"
+
+// Registry is used to register a mapping of GroupVersionKind to an IsPrunableFunc and
+// to a default strategy
 type Registry struct {
-	// prunables is a map of GVK to an IsPrunableFunc
-	prunables map[schema.GroupVersionKind]IsPrunableFunc
+	// prunables is a map of GVK to an IsPrunableFuncV2
+	prunables map[schema.GroupVersionKind]IsPrunableFuncV2
+
+	// strategies is a map of GVK to the strategy used by Pruners created without one
+	strategies map[schema.GroupVersionKind]StrategyFuncV2
 }
 
 // NewRegistry creates a new Registry
@@ -39,24 +57,124 @@ var defaultRegistry Registry
 
 // RegisterIsPrunableFunc registers a function to check whether it is safe to prune a resource of a certain type.
 func (r *Registry) RegisterIsPrunableFunc(gvk schema.GroupVersionKind, isPrunable IsPrunableFunc) {
+	r.RegisterIsPrunableFuncV2(gvk, IsPrunableV2(isPrunable))
+}
+
+// RegisterIsPrunableFuncV2 registers a function to check whether it is safe to prune a resource of a certain type,
+// given the PruneContext of the run.
+func (r *Registry) RegisterIsPrunableFuncV2(gvk schema.GroupVersionKind, isPrunable IsPrunableFuncV2) {
 	if r.prunables == nil {
-		r.prunables = make(map[schema.GroupVersionKind]IsPrunableFunc)
+		r.prunables = make(map[schema.GroupVersionKind]IsPrunableFuncV2)
 	}
 
 	r.prunables[gvk] = isPrunable
 }
 
-// IsPrunable checks if an object is prunable
+// IsPrunable checks if an object is prunable.
+// Objects protected with the ProtectAnnotation are always Unprunable.
 func (r *Registry) IsPrunable(obj client.Object) error {
+	return r.IsPrunableWithContext(context.Background(), PruneContext{GVK: obj.GetObjectKind().GroupVersionKind()}, obj)
+}
+
+// IsPrunableWithContext checks if an object is prunable during the prune run described by pctx,
+// with the context ctx of the run.
+// Objects protected with the ProtectAnnotation are always Unprunable.
+func (r *Registry) IsPrunableWithContext(ctx context.Context, pctx PruneContext, obj client.Object) error {
+	if err := checkProtected(obj); err != nil {
+		return err
+	}
+
 	isPrunable, ok := r.prunables[obj.GetObjectKind().GroupVersionKind()]
 	if !ok {
 		return nil
 	}
 
-	return isPrunable(obj)
+	return isPrunable(ctx, pctx, obj)
+}
+
+// RegisterDefaultStrategy registers the strategy used by Pruners of resources of a certain type
+// that are created without a strategy.
+func (r *Registry) RegisterDefaultStrategy(gvk schema.GroupVersionKind, strategy StrategyFunc) {
+	r.RegisterDefaultStrategyV2(gvk, StrategyV2(strategy))
+}
+
+// RegisterDefaultStrategyV2 registers the StrategyFuncV2 used by Pruners of resources of a certain
+// type that are created without a strategy.
+func (r *Registry) RegisterDefaultStrategyV2(gvk schema.GroupVersionKind, strategy StrategyFuncV2) {
+	if r.strategies == nil {
+		r.strategies = make(map[schema.GroupVersionKind]StrategyFuncV2)
+	}
+
+	r.strategies[gvk] = strategy
+}
+
+// DefaultStrategy returns the default strategy registered for resources of a certain type, if any.
+func (r *Registry) DefaultStrategy(gvk schema.GroupVersionKind) (StrategyFuncV2, bool) {
+	strategy, ok := r.strategies[gvk]
+	return strategy, ok
 }
 
 // RegisterIsPrunableFunc registers a function to check whether it is safe to prune a resource of a certain type.
 func RegisterIsPrunableFunc(gvk schema.GroupVersionKind, isPrunable IsPrunableFunc) {
 	DefaultRegistry().RegisterIsPrunableFunc(gvk, isPrunable)
 }
+
+// RegisterIsPrunableFuncV2 registers a function to check whether it is safe to prune a resource of a certain type,
+// given the PruneContext of the run.
+func RegisterIsPrunableFuncV2(gvk schema.GroupVersionKind, isPrunable IsPrunableFuncV2) {
+	DefaultRegistry().RegisterIsPrunableFuncV2(gvk, isPrunable)
+}
+
+// RegisterDefaultStrategy registers the strategy used by Pruners of resources of a certain type
+// that are created without a strategy.
+func RegisterDefaultStrategy(gvk schema.GroupVersionKind, strategy StrategyFunc) {
+	DefaultRegistry().RegisterDefaultStrategy(gvk, strategy)
+}
+
+// RegisterDefaultStrategyV2 registers the StrategyFuncV2 used by Pruners of resources of a certain
+// type that are created without a strategy.
+func RegisterDefaultStrategyV2(gvk schema.GroupVersionKind, strategy StrategyFuncV2) {
+	DefaultRegistry().RegisterDefaultStrategyV2(gvk, strategy)
+}
+
+// RegisterFinishedPodIsPrunable registers FinishedPodIsPrunable for Pods, so that failed and
+// evicted Pods are pruned along with succeeded ones.
+func (r *Registry) RegisterFinishedPodIsPrunable() {
+	r.RegisterIsPrunableFunc(corev1.SchemeGroupVersion.WithKind("Pod"), FinishedPodIsPrunable)
+}
+
+// RegisterReleasedPersistentVolumeIsPrunable registers ReleasedPersistentVolumeIsPrunable for
+// PersistentVolumes.
+func (r *Registry) RegisterReleasedPersistentVolumeIsPrunable() {
+	r.RegisterIsPrunableFunc(corev1.SchemeGroupVersion.WithKind("PersistentVolume"), ReleasedPersistentVolumeIsPrunable)
+}
+
+// RegisterCronJobJobIsPrunable registers CronJobJobIsPrunable for Jobs, replacing
+// DefaultJobIsPrunable.
+func (r *Registry) RegisterCronJobJobIsPrunable() {
+	r.RegisterIsPrunableFunc(batchv1.SchemeGroupVersion.WithKind("Job"), CronJobJobIsPrunable)
+}
+
+// RegisterUnusedIsPrunable registers NewUnusedIsPrunable(reader, minAge) for ConfigMaps and
+// Secrets.
+func (r *Registry) RegisterUnusedIsPrunable(reader client.Reader, minAge time.Duration) {
+	isPrunable := NewUnusedIsPrunable(reader, minAge)
+	r.RegisterIsPrunableFuncV2(corev1.SchemeGroupVersion.WithKind("ConfigMap"), isPrunable)
+	r.RegisterIsPrunableFuncV2(corev1.SchemeGroupVersion.WithKind("Secret"), isPrunable)
+}
+
+// checkProtected returns an Unprunable error if obj has a truthy ProtectAnnotation.
+func checkProtected(obj client.Object) error {
+	value, ok := obj.GetAnnotations()[ProtectAnnotation]
+	if !ok {
+		return nil
+	}
+	if protected, err := strconv.ParseBool(value); err != nil || !protected {
+		return nil
+	}
+
+	return &Unprunable{
+		Obj:    &obj,
+		Reason: "object is protected by the " + ProtectAnnotation + " annotation",
+	}
+}
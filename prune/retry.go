@@ -0,0 +1,84 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultDeleteBackoff is the backoff used by a Pruner to retry deletions that failed
+// with a retriable error, unless overridden with WithDeleteBackoff.
+var DefaultDeleteBackoff = wait.Backoff{
+	Steps:    4,
+	Duration: 200 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+// IsRetriable checks if a given error returned by the API server is transient, meaning
+// that the same request may succeed if it is retried later. Conflicts, throttling (429),
+// timeouts and an unavailable API server are considered retriable.
+func IsRetriable(err error) bool {
+	return apierrors.IsConflict(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsServerTimeout(err) ||
+		apierrors.IsServiceUnavailable(err)
+}
+
+// deleteWithRetry deletes obj, retrying with the Pruner's backoff for as long as the
+// returned error is retriable. When all attempts are exhausted the last error is returned.
+func (p Pruner) deleteWithRetry(ctx context.Context, obj client.Object) error {
+	return p.retry(ctx, obj, func(ctx context.Context) error {
+		return p.deleteObject(ctx, p.client, obj)
+	})
+}
+
+// retry calls fn for obj with the Pruner's backoff for as long as the returned error is
+// retriable. When all attempts are exhausted the last error is returned.
+func (p Pruner) retry(ctx context.Context, obj client.Object, fn func(ctx context.Context) error) error {
+	var lastErr error
+	err := wait.ExponentialBackoffWithContext(ctx, p.deleteBackoff, func(ctx context.Context) (bool, error) {
+		lastErr = fn(ctx)
+		switch {
+		case lastErr == nil:
+			return true, nil
+		case IsRetriable(lastErr):
+			log.V(1).Info("Retrying deletion after transient error", "object", client.ObjectKeyFromObject(obj), "error", lastErr.Error())
+			return false, nil
+		default:
+			return false, lastErr
+		}
+	})
+	if wait.Interrupted(err) && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
+
+// deleteOptions returns the options of the delete requests sent by the Pruner.
+func (p Pruner) deleteOptions() []client.DeleteOption {
+	opts := make([]client.DeleteOption, 0, len(p.deleteOpts)+1)
+	opts = append(opts, p.deleteOpts...)
+	if p.dryRun {
+		opts = append(opts, client.DryRunAll)
+	}
+	return opts
+}
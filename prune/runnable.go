@@ -0,0 +1,86 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// DefaultPruneInterval is the interval at which a Runnable prunes when no interval is provided.
+const DefaultPruneInterval = time.Hour
+
+// Runnable runs a Pruner periodically. It is a manager.Runnable that prunes while the operator
+// is the leader, so that pruning can be added to a controller-runtime manager with mgr.Add.
+type Runnable struct {
+	pruner   *Pruner
+	interval time.Duration
+}
+
+var _ manager.Runnable = &Runnable{}
+var _ manager.LeaderElectionRunnable = &Runnable{}
+
+// NewRunnable returns a Runnable running pruner every interval, DefaultPruneInterval if interval
+// is not positive. The first run starts as soon as the Runnable is started. If a run reports
+// that objects become prunable earlier than the next run, see Result.NextRun, the next run is
+// brought forward.
+func NewRunnable(pruner *Pruner, interval time.Duration) *Runnable {
+	if interval <= 0 {
+		interval = DefaultPruneInterval
+	}
+	return &Runnable{pruner: pruner, interval: interval}
+}
+
+// Start implements manager.Runnable. It prunes until the context is done. Errors are logged
+// and retried at the next run.
+func (r *Runnable) Start(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+		}
+
+		timer.Reset(r.run(ctx))
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Objects are only pruned by
+// the leader.
+func (r *Runnable) NeedLeaderElection() bool {
+	return true
+}
+
+// run prunes once and returns the delay until the next run.
+func (r *Runnable) run(ctx context.Context) time.Duration {
+	result, err := r.pruner.PruneWithResult(ctx)
+	if err != nil {
+		log.Error(err, "Failed to prune", "gvk", r.pruner.gvk)
+		return r.interval
+	}
+	for _, failed := range result.Failed {
+		log.Error(failed.Err, "Failed to prune object", "gvk", r.pruner.gvk, "object", client.ObjectKeyFromObject(failed.Obj))
+	}
+	if result.NextRun > 0 && result.NextRun < r.interval {
+		return result.NextRun
+	}
+	return r.interval
+}
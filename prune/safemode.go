@@ -0,0 +1,95 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// protectionFinalizerSuffix is the suffix of the names of protection finalizers, such as
+// kubernetes.io/pvc-protection, which hold the deletion of objects that are still in use.
+const protectionFinalizerSuffix = "-protection"
+
+// WithSafeMode makes the Pruner keep the objects that another controller may still manage,
+// in addition to those kept by the IsPrunable functions of its Registry:
+//
+//   - objects that have owner references to kinds other than ownerKinds, e.g. the kinds of the
+//     operator's custom resources, since they are managed by their owners;
+//   - objects that are already being deleted;
+//   - objects that have a protection finalizer, such as kubernetes.io/pvc-protection, since they
+//     are still in use.
+//
+// Safe mode applies to the objects listed by the Pruner, not to the dependents of a PruneGraph.
+func WithSafeMode(ownerKinds ...schema.GroupKind) PrunerOption {
+	return func(p *Pruner) {
+		p.safeMode = &safeMode{ownerKinds: ownerKinds}
+	}
+}
+
+// safeMode configures the checks of WithSafeMode.
+type safeMode struct {
+	ownerKinds []schema.GroupKind
+}
+
+// check returns an Unprunable error if obj may still be managed by another controller.
+func (s safeMode) check(obj client.Object) error {
+	unprunable := func(reason string, args ...interface{}) error {
+		return &Unprunable{Obj: &obj, Reason: fmt.Sprintf(reason, args...)}
+	}
+
+	if obj.GetDeletionTimestamp() != nil {
+		return unprunable("object is being deleted")
+	}
+	for _, finalizer := range obj.GetFinalizers() {
+		if strings.HasSuffix(finalizer, protectionFinalizerSuffix) {
+			return unprunable("object has protection finalizer %s", finalizer)
+		}
+	}
+	for _, ref := range obj.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return unprunable("object has an owner reference with invalid apiVersion %q", ref.APIVersion)
+		}
+		if !s.ownsKind(gv.WithKind(ref.Kind).GroupKind()) {
+			return unprunable("object is owned by %s %s", ref.Kind, ref.Name)
+		}
+	}
+	return nil
+}
+
+func (s safeMode) ownsKind(gk schema.GroupKind) bool {
+	for _, ownerKind := range s.ownerKinds {
+		if ownerKind == gk {
+			return true
+		}
+	}
+	return false
+}
+
+// isPrunable checks if obj is prunable during the prune run described by pctx, according to the
+// safe mode of the Pruner, if enabled, and its Registry.
+func (p Pruner) isPrunable(ctx context.Context, pctx PruneContext, obj client.Object) error {
+	if p.safeMode != nil {
+		if err := p.safeMode.check(obj); err != nil {
+			return err
+		}
+	}
+	return p.registry.IsPrunableWithContext(ctx, pctx, obj)
+}
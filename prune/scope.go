@@ -0,0 +1,59 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WithAllNamespaces prunes resources in all namespaces, overriding WithNamespace. This is the
+// same as not setting a namespace, but makes the intent explicit. Pruners of cluster-scoped
+// kinds, such as PersistentVolumes or ClusterRoleBindings, must not be restricted to a
+// namespace: their runs fail instead of pruning resources of the whole cluster.
+func WithAllNamespaces() PrunerOption {
+	return func(p *Pruner) {
+		p.namespace = metav1.NamespaceAll
+	}
+}
+
+// AllNamespaces returns true if the Pruner finds resources to prune in all namespaces, i.e.
+// when no namespace is set.
+func (p Pruner) AllNamespaces() bool {
+	return p.namespace == metav1.NamespaceAll
+}
+
+// clusterScoped returns true if the Pruner's kind is cluster-scoped, according to the REST
+// mapper of its client. It returns an error if the Pruner is restricted to a namespace while the
+// kind is cluster-scoped, since the namespace would be ignored when listing and all resources of
+// the cluster would be pruned. Kinds unknown to the REST mapper are assumed to be namespaced.
+func (p Pruner) clusterScoped() (bool, error) {
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(p.gvk)
+	namespaced, err := p.client.IsObjectNamespaced(obj)
+	if meta.IsNoMatchError(err) {
+		log.V(1).Info("Unknown scope, assuming a namespaced kind", "gvk", p.gvk)
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("error determining the scope of %s: %w", p.gvk, err)
+	}
+
+	if !namespaced && p.namespace != metav1.NamespaceAll {
+		return false, fmt.Errorf("cannot prune cluster-scoped %s in namespace %q, use WithAllNamespaces instead", p.gvk, p.namespace)
+	}
+	return !namespaced, nil
+}
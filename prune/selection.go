@@ -0,0 +1,90 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Selection describes the policy that selected an object for pruning, so that the deletion of
+// the object can be explained, e.g. "OlderThan(maxAge=24h0m0s)".
+type Selection struct {
+	// Strategy is the name of the strategy that selected the object
+	Strategy string
+
+	// Parameters are the parameters of the strategy, if any
+	Parameters map[string]string
+}
+
+// String returns the name of the strategy followed by its parameters, sorted by name.
+func (s Selection) String() string {
+	params := make([]string, 0, len(s.Parameters))
+	for name, value := range s.Parameters {
+		params = append(params, name+"="+value)
+	}
+	sort.Strings(params)
+	return fmt.Sprintf("%s(%s)", s.Strategy, strings.Join(params, ", "))
+}
+
+// StrategyV2WithSelection adapts a StrategyFunc to a StrategyFuncV2 like StrategyV2, and
+// attributes all the objects it selects to selection, e.g.
+//
+//	StrategyV2WithSelection(NewPruneByCountStrategy(5), Selection{
+//		Strategy:   "ByCount",
+//		Parameters: map[string]string{"count": "5"},
+//	})
+func StrategyV2WithSelection(strategy StrategyFunc, selection Selection) StrategyFuncV2 {
+	return func(ctx context.Context, pctx PruneContext, objs []client.Object) (StrategyResult, error) {
+		result, err := StrategyV2(strategy)(ctx, pctx, objs)
+		if err != nil {
+			return result, err
+		}
+		result.Selections = make(map[client.ObjectKey]Selection, len(result.Objects))
+		for _, obj := range result.Objects {
+			result.Selections[client.ObjectKeyFromObject(obj)] = selection
+		}
+		return result, nil
+	}
+}
+
+// recordSelections records in result the selections of the objects in objs, as returned by the
+// strategy in selections.
+func recordSelections(result *Result, objs []client.Object, selections map[client.ObjectKey]Selection) {
+	for _, obj := range objs {
+		key := client.ObjectKeyFromObject(obj)
+		selection, ok := selections[key]
+		if !ok {
+			continue
+		}
+		if result.Selections == nil {
+			result.Selections = map[client.ObjectKey]Selection{}
+		}
+		result.Selections[key] = selection
+	}
+}
+
+// selectedBy returns the description of the policy that selected obj, as recorded in result.
+func selectedBy(result *Result, obj client.Object) string {
+	selection, ok := result.Selections[client.ObjectKeyFromObject(obj)]
+	if !ok {
+		return "unknown"
+	}
+	return selection.String()
+}
@@ -16,9 +16,13 @@ package prune
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"sort"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -33,12 +37,7 @@ func NewPruneByCountStrategy(count int) StrategyFunc {
 
 		// sort objects by creation date
 		sortedObjs := objs
-
-		sort.Slice(sortedObjs, func(i, j int) bool {
-			iTimestamp := sortedObjs[i].GetCreationTimestamp()
-			jTimestamp := sortedObjs[j].GetCreationTimestamp()
-			return iTimestamp.Before(&jTimestamp)
-		})
+		SortObjects(sortedObjs)
 
 		return sortedObjs[count:], nil
 	}
@@ -59,3 +58,143 @@ func NewPruneByDateStrategy(date time.Time) StrategyFunc {
 		return objsToPrune, nil
 	}
 }
+
+// NewPruneOlderThan returns a StrategyFunc that will return a list of resources to prune
+// whose CreationTimestamp is more than age before the current time. The current time is read
+// from the Clock of the PruneContext, see WithClock, and defaults to the real time.
+func NewPruneOlderThan(age time.Duration) StrategyFunc {
+	return func(ctx context.Context, objs []client.Object) ([]client.Object, error) {
+		var c clock.PassiveClock = clock.RealClock{}
+		if pctx, ok := PruneContextFrom(ctx); ok && pctx.Clock != nil {
+			c = pctx.Clock
+		}
+
+		var objsToPrune []client.Object
+
+		cutoff := c.Now().Add(-age)
+		for _, obj := range objs {
+			if obj.GetCreationTimestamp().Time.Before(cutoff) {
+				objsToPrune = append(objsToPrune, obj)
+			}
+		}
+
+		return objsToPrune, nil
+	}
+}
+
+// NewPruneOlderThanV2 returns a StrategyFuncV2 that prunes the same resources as NewPruneOlderThan,
+// and hints in StrategyResult.NextRun when the oldest of the remaining resources becomes older
+// than age. The resources it prunes are attributed to the "OlderThan" strategy with the maxAge
+// parameter, see StrategyResult.Selections.
+func NewPruneOlderThanV2(age time.Duration) StrategyFuncV2 {
+	return func(_ context.Context, pctx PruneContext, objs []client.Object) (StrategyResult, error) {
+		var c clock.PassiveClock = clock.RealClock{}
+		if pctx.Clock != nil {
+			c = pctx.Clock
+		}
+
+		result := StrategyResult{Selections: map[client.ObjectKey]Selection{}}
+		selection := Selection{Strategy: "OlderThan", Parameters: map[string]string{"maxAge": age.String()}}
+
+		now := c.Now()
+		cutoff := now.Add(-age)
+		for _, obj := range objs {
+			created := obj.GetCreationTimestamp().Time
+			if created.Before(cutoff) {
+				result.Objects = append(result.Objects, obj)
+				result.Selections[client.ObjectKeyFromObject(obj)] = selection
+				continue
+			}
+			// an object created exactly at the cutoff becomes prunable right after it
+			if next := created.Sub(cutoff) + time.Nanosecond; result.NextRun == 0 || next < result.NextRun {
+				result.NextRun = next
+			}
+		}
+
+		return result, nil
+	}
+}
+
+// NewPruneBySizeStrategy returns a StrategyFunc that will return a list of resources to prune so
+// that the resources of each namespace fit in maxBytes, e.g. ConfigMaps holding large results.
+// The size of a resource is the size of its JSON serialization. The most recently created
+// resources of a namespace are kept as long as their total size does not exceed maxBytes, and
+// all older resources are pruned.
+func NewPruneBySizeStrategy(maxBytes int64) StrategyFunc {
+	return func(_ context.Context, objs []client.Object) ([]client.Object, error) {
+		byNamespace := map[string][]client.Object{}
+		for _, obj := range objs {
+			byNamespace[obj.GetNamespace()] = append(byNamespace[obj.GetNamespace()], obj)
+		}
+
+		var objsToPrune []client.Object
+		for _, nsObjs := range byNamespace {
+			// newest first
+			sort.SliceStable(nsObjs, func(i, j int) bool {
+				return lessObject(nsObjs[j], nsObjs[i])
+			})
+
+			var total int64
+			for i, obj := range nsObjs {
+				data, err := json.Marshal(obj)
+				if err != nil {
+					return nil, fmt.Errorf("error computing the size of %s: %w", client.ObjectKeyFromObject(obj), err)
+				}
+				total += int64(len(data))
+				if total > maxBytes {
+					objsToPrune = append(objsToPrune, nsObjs[i:]...)
+					break
+				}
+			}
+		}
+
+		SortObjects(objsToPrune)
+		return objsToPrune, nil
+	}
+}
+
+// SortObjects sorts objs in the order in which a Pruner deletes them: oldest first, then by
+// namespace and name. Objects with the same creation time are therefore ordered deterministically,
+// independently of the order in which they were listed.
+func SortObjects(objs []client.Object) {
+	sort.SliceStable(objs, func(i, j int) bool {
+		return lessObject(objs[i], objs[j])
+	})
+}
+
+// lessObject returns true if a is ordered before b, see SortObjects.
+func lessObject(a, b metav1.Object) bool {
+	ta, tb := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !ta.Equal(&tb) {
+		return ta.Before(&tb)
+	}
+	if a.GetNamespace() != b.GetNamespace() {
+		return a.GetNamespace() < b.GetNamespace()
+	}
+	return a.GetName() < b.GetName()
+}
+
+// Defaults of the strategies registered for Jobs and Pods in the DefaultRegistry.
+const (
+	// DefaultJobHistoryLimit is the number of most recent Jobs kept by the default Job strategy.
+	DefaultJobHistoryLimit = 3
+	// DefaultPodMaxAge is the age after which Pods are pruned by the default Pod strategy.
+	DefaultPodMaxAge = time.Hour
+)
+
+// keepNewest returns a StrategyFunc that prunes all but the count most recently created resources.
+func keepNewest(count int) StrategyFunc {
+	return func(_ context.Context, objs []client.Object) ([]client.Object, error) {
+		if len(objs) <= count {
+			return nil, nil
+		}
+
+		sortedObjs := make([]client.Object, len(objs))
+		copy(sortedObjs, objs)
+		sort.SliceStable(sortedObjs, func(i, j int) bool {
+			return lessObject(sortedObjs[j], sortedObjs[i])
+		})
+
+		return sortedObjs[count:], nil
+	}
+}
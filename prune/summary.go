@@ -0,0 +1,255 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+// Annotations set on owners by the OwnerSummaryFunc of NewOwnerAnnotationSummary.
+const (
+	// LastPruneTimeAnnotation is set to the time of the last prune run that pruned children of the
+	// owner, in RFC 3339 format.
+	LastPruneTimeAnnotation = "operator-lib.operatorframework.io/last-prune-time"
+	// PrunedCountAnnotation is set to the number of children of the owner pruned by the last run.
+	PrunedCountAnnotation = "operator-lib.operatorframework.io/pruned-count"
+)
+
+// PrunedChildrenReason is the reason of the conditions set by NewOwnerConditionSummary.
+const PrunedChildrenReason = "PrunedChildren"
+
+// OwnerSummary summarizes the retention action of a prune run on the children of an owner.
+type OwnerSummary struct {
+	// Owner is the controller of the pruned children.
+	Owner metav1.OwnerReference
+	// Namespace is the namespace of the owner, empty for cluster-scoped owners.
+	Namespace string
+	// Pruned are the children of the owner pruned by the run.
+	Pruned []client.Object
+	// Time is the time of the run, read from the clock of the Pruner, see WithClock.
+	Time time.Time
+}
+
+// OwnerSummaryFunc is called with the OwnerSummary of each owner whose children were pruned by a
+// run, see WithOwnerSummary, e.g. to record the summary on the owner.
+type OwnerSummaryFunc func(ctx context.Context, summary OwnerSummary) error
+
+// WithOwnerSummary calls fn after each run with the OwnerSummary of each owner whose children
+// were pruned, such as the custom resource that created the pruned Jobs. Children are grouped by
+// their controller owner reference, and only owners of ownerKinds are summarized, or all owners if
+// none is given. Owners are summarized in the order of their namespace and name.
+//
+// Dry runs are not summarized. Errors returned by fn are logged and do not fail the run, since
+// the children are already pruned. NewOwnerConditionSummary and NewOwnerAnnotationSummary record
+// the summary on the owner.
+func WithOwnerSummary(fn OwnerSummaryFunc, ownerKinds ...schema.GroupKind) PrunerOption {
+	return func(p *Pruner) {
+		p.afterRun = append(p.afterRun, func(ctx context.Context, result *Result) {
+			pctx, _ := PruneContextFrom(ctx)
+			if pctx.DryRun {
+				return
+			}
+			now := time.Now()
+			if pctx.Clock != nil {
+				now = pctx.Clock.Now()
+			}
+			for _, summary := range ownerSummaries(result.Pruned, ownerKinds, now, p.client) {
+				if err := fn(ctx, summary); err != nil {
+					log.Error(err, "Failed to summarize pruning on owner", "owner", summary.Owner.Name,
+						"kind", summary.Owner.Kind, "namespace", summary.Namespace)
+					metrics.RecordError(metrics.SubsystemPrune, "owner_summary_failed")
+				}
+			}
+		})
+	}
+}
+
+// ownerSummaries groups pruned by controller owner of ownerKinds, or of any kind if empty. The
+// scope of the owners is resolved with the RESTMapper of c.
+func ownerSummaries(pruned []client.Object, ownerKinds []schema.GroupKind, now time.Time, c client.Client) []OwnerSummary {
+	type ownerKey struct {
+		namespace string
+		uid       string
+		name      string
+	}
+	byOwner := map[ownerKey]*OwnerSummary{}
+	namespacedKinds := map[schema.GroupVersionKind]bool{}
+	for _, obj := range pruned {
+		ref := metav1.GetControllerOfNoCopy(obj)
+		if ref == nil || !ownerKindMatches(*ref, ownerKinds) {
+			continue
+		}
+		gvk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind)
+		namespaced, ok := namespacedKinds[gvk]
+		if !ok {
+			namespaced = isNamespacedKind(c, gvk)
+			namespacedKinds[gvk] = namespaced
+		}
+		namespace := ""
+		if namespaced {
+			namespace = obj.GetNamespace()
+		}
+
+		key := ownerKey{namespace: namespace, uid: string(ref.UID), name: ref.Name}
+		summary, ok := byOwner[key]
+		if !ok {
+			summary = &OwnerSummary{Owner: *ref, Namespace: namespace, Time: now}
+			byOwner[key] = summary
+		}
+		summary.Pruned = append(summary.Pruned, obj)
+	}
+
+	summaries := make([]OwnerSummary, 0, len(byOwner))
+	for _, summary := range byOwner {
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Namespace != summaries[j].Namespace {
+			return summaries[i].Namespace < summaries[j].Namespace
+		}
+		return summaries[i].Owner.Name < summaries[j].Owner.Name
+	})
+	return summaries
+}
+
+// isNamespacedKind returns true if gvk is namespaced, assuming a namespaced kind if its scope is
+// unknown.
+func isNamespacedKind(c client.Client, gvk schema.GroupVersionKind) bool {
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(gvk)
+	namespaced, err := c.IsObjectNamespaced(obj)
+	if err != nil {
+		log.V(1).Info("Unknown scope of owner, assuming a namespaced kind", "gvk", gvk, "error", err.Error())
+		return true
+	}
+	return namespaced
+}
+
+func ownerKindMatches(ref metav1.OwnerReference, ownerKinds []schema.GroupKind) bool {
+	if len(ownerKinds) == 0 {
+		return true
+	}
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return false
+	}
+	for _, kind := range ownerKinds {
+		if kind == gv.WithKind(ref.Kind).GroupKind() {
+			return true
+		}
+	}
+	return false
+}
+
+// NewOwnerConditionSummary returns an OwnerSummaryFunc setting a condition of type conditionType,
+// with status True and reason PrunedChildrenReason, in the status of the owner. Its message
+// holds the number of pruned children and its lastTransitionTime the time of the first run that
+// set it. The condition is set with server-side apply as fieldOwner, so that the other
+// conditions of the owner are kept, provided its conditions are a list map keyed by type. Owners
+// deleted or replaced since their children were pruned are skipped.
+func NewOwnerConditionSummary(c client.Client, conditionType, fieldOwner string) OwnerSummaryFunc {
+	return func(ctx context.Context, summary OwnerSummary) error {
+		current := summaryApplyObject(summary)
+		if err := c.Get(ctx, client.ObjectKeyFromObject(current), current); err != nil {
+			return skipGoneOwner(summary, fmt.Errorf("error getting owner %s: %w", client.ObjectKeyFromObject(current), err))
+		}
+		if current.GetUID() != summary.Owner.UID {
+			return skipGoneOwner(summary, nil)
+		}
+
+		transitionTime := summary.Time.UTC().Format(time.RFC3339)
+		conditions, _, _ := unstructured.NestedSlice(current.Object, "status", "conditions")
+		for _, existing := range conditions {
+			existing, ok := existing.(map[string]interface{})
+			if !ok || existing["type"] != conditionType || existing["status"] != string(metav1.ConditionTrue) {
+				continue
+			}
+			if lastTransitionTime, ok := existing["lastTransitionTime"].(string); ok && lastTransitionTime != "" {
+				transitionTime = lastTransitionTime
+			}
+		}
+
+		owner := summaryApplyObject(summary)
+		condition := map[string]interface{}{
+			"type":               conditionType,
+			"status":             string(metav1.ConditionTrue),
+			"reason":             PrunedChildrenReason,
+			"message":            fmt.Sprintf("Pruned %d objects", len(summary.Pruned)),
+			"lastTransitionTime": transitionTime,
+		}
+		if err := unstructured.SetNestedSlice(owner.Object, []interface{}{condition}, "status", "conditions"); err != nil {
+			return err
+		}
+		if err := c.Status().Patch(ctx, owner, client.Apply, client.FieldOwner(fieldOwner), client.ForceOwnership); err != nil {
+			return skipGoneOwner(summary, fmt.Errorf("error applying condition %s to owner %s: %w", conditionType, client.ObjectKeyFromObject(owner), err))
+		}
+		return nil
+	}
+}
+
+// NewOwnerAnnotationSummary returns an OwnerSummaryFunc setting LastPruneTimeAnnotation and
+// PrunedCountAnnotation on the owner, for owners without a status or conditions. The
+// annotations are set with server-side apply as fieldOwner. Owners deleted or replaced since
+// their children were pruned are skipped.
+func NewOwnerAnnotationSummary(c client.Client, fieldOwner string) OwnerSummaryFunc {
+	return func(ctx context.Context, summary OwnerSummary) error {
+		owner := summaryApplyObject(summary)
+		owner.SetAnnotations(map[string]string{
+			LastPruneTimeAnnotation: summary.Time.UTC().Format(time.RFC3339),
+			PrunedCountAnnotation:   strconv.Itoa(len(summary.Pruned)),
+		})
+		if err := c.Patch(ctx, owner, client.Apply, client.FieldOwner(fieldOwner), client.ForceOwnership); err != nil {
+			return skipGoneOwner(summary, fmt.Errorf("error applying prune annotations to owner %s: %w", client.ObjectKeyFromObject(owner), err))
+		}
+		return nil
+	}
+}
+
+// summaryApplyObject returns an apply configuration of the owner of summary with its
+// identifying fields only. Its UID is a precondition of the apply request, so that owners
+// deleted since their children were pruned are not recreated: the API server rejects the
+// request with a Conflict instead.
+func summaryApplyObject(summary OwnerSummary) *unstructured.Unstructured {
+	owner := &unstructured.Unstructured{}
+	owner.SetAPIVersion(summary.Owner.APIVersion)
+	owner.SetKind(summary.Owner.Kind)
+	owner.SetName(summary.Owner.Name)
+	owner.SetNamespace(summary.Namespace)
+	owner.SetUID(summary.Owner.UID)
+	return owner
+}
+
+// skipGoneOwner returns err, unless it shows that the owner of summary was deleted or replaced
+// by an object with the same name, in which case the owner is skipped.
+func skipGoneOwner(summary OwnerSummary, err error) error {
+	if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+		return err
+	}
+	log.V(1).Info("Owner is gone, skipping its summary", "owner", summary.Owner.Name,
+		"kind", summary.Owner.Kind, "namespace", summary.Namespace)
+	return nil
+}
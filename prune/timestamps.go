@@ -0,0 +1,114 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MissingTimestampPolicy defines how objects without a creationTimestamp are handled by a Pruner.
+// Such objects are rare, but can be seen when timestamps are stripped by admission webhooks or
+// in objects built by tests, and the strategies of this package rely on creation timestamps.
+type MissingTimestampPolicy string
+
+const (
+	// MissingTimestampAsOldest passes objects without a creationTimestamp to the strategy as is,
+	// which makes them the oldest objects for the strategies of this package. This is the default.
+	MissingTimestampAsOldest MissingTimestampPolicy = "AsOldest"
+	// MissingTimestampAsNewest passes objects without a creationTimestamp to the strategy as if
+	// they were created at the start of the run, so that they are pruned last.
+	MissingTimestampAsNewest MissingTimestampPolicy = "AsNewest"
+	// MissingTimestampSkip never prunes objects without a creationTimestamp.
+	MissingTimestampSkip MissingTimestampPolicy = "Skip"
+)
+
+// WithMissingTimestampPolicy sets how objects without a creationTimestamp are handled. It defaults
+// to MissingTimestampAsOldest. Objects without a creationTimestamp are recorded in
+// Result.MissingTimestamps whatever the policy.
+func WithMissingTimestampPolicy(policy MissingTimestampPolicy) PrunerOption {
+	return func(p *Pruner) {
+		switch policy {
+		case MissingTimestampAsOldest, MissingTimestampAsNewest, MissingTimestampSkip:
+			p.missingTimestamps = policy
+		default:
+			p.err = fmt.Errorf("error when creating a new Pruner: unknown missing timestamp policy %q", policy)
+		}
+	}
+}
+
+// applyMissingTimestampPolicy returns the objects of objs to pass to the strategy according to
+// the Pruner's MissingTimestampPolicy, the objects of objs without a creationTimestamp, and a
+// function returning a copy of the objects selected by the strategy, mapped back to the objects
+// of objs.
+func (p Pruner) applyMissingTimestampPolicy(pctx PruneContext, objs []client.Object) ([]client.Object, []client.Object, func([]client.Object) []client.Object) {
+	unchanged := func(selected []client.Object) []client.Object {
+		return append([]client.Object(nil), selected...)
+	}
+
+	var missing []client.Object
+	for _, obj := range objs {
+		if missingCreationTimestamp(obj) {
+			missing = append(missing, obj)
+		}
+	}
+	if len(missing) == 0 {
+		return objs, nil, unchanged
+	}
+	log.V(1).Info("Found objects without a creationTimestamp", "count", len(missing), "policy", p.missingTimestamps)
+
+	switch p.missingTimestamps {
+	case MissingTimestampSkip:
+		kept := make([]client.Object, 0, len(objs)-len(missing))
+		for _, obj := range objs {
+			if !missingCreationTimestamp(obj) {
+				kept = append(kept, obj)
+			}
+		}
+		return kept, missing, unchanged
+	case MissingTimestampAsNewest:
+		now := metav1.NewTime(pctx.Clock.Now())
+		originals := make(map[client.Object]client.Object, len(missing))
+		substituted := make([]client.Object, 0, len(objs))
+		for _, obj := range objs {
+			if missingCreationTimestamp(obj) {
+				stamped := obj.DeepCopyObject().(client.Object)
+				stamped.SetCreationTimestamp(now)
+				originals[stamped] = obj
+				obj = stamped
+			}
+			substituted = append(substituted, obj)
+		}
+		return substituted, missing, func(selected []client.Object) []client.Object {
+			restored := make([]client.Object, 0, len(selected))
+			for _, obj := range selected {
+				if original, ok := originals[obj]; ok {
+					obj = original
+				}
+				restored = append(restored, obj)
+			}
+			return restored
+		}
+	default:
+		return objs, missing, unchanged
+	}
+}
+
+func missingCreationTimestamp(obj client.Object) bool {
+	created := obj.GetCreationTimestamp()
+	return created.IsZero()
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// DeletionCheckFunc returns an Unprunable error if obj is still needed by the operator and must
+// not be deleted. Other errors are reported to the API server, which then applies the failure
+// policy of the webhook.
+type DeletionCheckFunc func(ctx context.Context, obj client.Object) error
+
+// DefaultProtectionWebhookAllowedUsers are the users whose deletions are always allowed by a
+// ProtectionWebhook: the garbage collector and the namespace controller, so that protected objects
+// are still deleted along with their owner or namespace.
+var DefaultProtectionWebhookAllowedUsers = []string{
+	"system:serviceaccount:kube-system:generic-garbage-collector",
+	"system:serviceaccount:kube-system:namespace-controller",
+}
+
+// ProtectionWebhook is a validating admission webhook that denies the deletion of objects
+// protected by the ProtectAnnotation, or that its Check reports as still needed, so that the
+// retention policy enforced by an operator cannot be bypassed by accidental manual deletions.
+// Users can still delete a protected object by removing its ProtectAnnotation first. Denied and
+// allowed deletions are logged with the user that requested them for auditing.
+//
+// It is served by the webhook server of the manager, for the DELETE operation of the resources
+// it protects:
+//
+//	mgr.GetWebhookServer().Register("/validate-prune-protect", &webhook.Admission{Handler: &prune.ProtectionWebhook{}})
+type ProtectionWebhook struct {
+	// Check, if set, is called for objects that are not protected by the ProtectAnnotation.
+	Check DeletionCheckFunc
+
+	// AllowedUsers are users whose deletions are always allowed, such as the service account of
+	// the operator, in addition to DefaultProtectionWebhookAllowedUsers.
+	AllowedUsers []string
+}
+
+var _ admission.Handler = &ProtectionWebhook{}
+
+// Handle implements admission.Handler.
+func (w *ProtectionWebhook) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Delete {
+		return admission.Allowed("")
+	}
+	if len(req.OldObject.Raw) == 0 {
+		log.V(1).Info("Allowing deletion of unknown object", "user", req.UserInfo.Username,
+			"resource", req.Resource, "namespace", req.Namespace, "name", req.Name)
+		return admission.Allowed("")
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(req.OldObject.Raw); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("error decoding object: %w", err))
+	}
+	logger := log.WithValues("user", req.UserInfo.Username, "gvk", obj.GroupVersionKind(),
+		"object", client.ObjectKeyFromObject(obj))
+
+	allowedUsers := sets.New(DefaultProtectionWebhookAllowedUsers...).Insert(w.AllowedUsers...)
+	if allowedUsers.Has(req.UserInfo.Username) {
+		return admission.Allowed("")
+	}
+
+	err := checkProtected(obj)
+	if err == nil && w.Check != nil {
+		err = w.Check(ctx, obj)
+	}
+	var unprunable *Unprunable
+	switch {
+	case err == nil:
+		logger.V(1).Info("Allowing deletion")
+		return admission.Allowed("")
+	case errors.As(err, &unprunable):
+		logger.Info("Denying deletion of protected object", "reason", unprunable.Reason)
+		return admission.Denied(unprunable.Error())
+	default:
+		logger.Error(err, "Failed to check deletion")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+}
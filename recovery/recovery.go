@@ -0,0 +1,150 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package recovery provides a reconciler wrapper that recovers panics in reconcilers.
+//
+// A panic in a Reconcile function crashes the whole operator process unless it is recovered.
+// Reconcilers wrapped with Wrap recover panics instead: the panic is logged with its stack
+// trace and the request being reconciled, counted in the reconcile_panics_total metric (see
+// RegisterMetrics), optionally reported in a Degraded condition, and returned as an error so
+// that the request is requeued with the controller's rate limiting backoff.
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/operator-framework/operator-lib/conditions"
+	libmetrics "github.com/operator-framework/operator-lib/internal/metrics"
+	"github.com/operator-framework/operator-lib/recovery/internal/metrics"
+)
+
+var log = logf.Log.WithName("recovery")
+
+// ReconcilePanicReason is the reason of the condition set when a panic is recovered,
+// see WithCondition.
+const ReconcilePanicReason = "ReconcilePanic"
+
+// PanicError is returned by a wrapped reconciler when the reconciler panicked.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value interface{}
+	// Stack is the stack trace of the goroutine that panicked.
+	Stack []byte
+}
+
+// Error implements error.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("recovered from panic in reconciler: %v", e.Value)
+}
+
+// Unwrap returns the value passed to panic if it is an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// RegisterMetrics registers the recovery metrics with reg, e.g. controller-runtime's
+// metrics.Registry. The metrics report the panics recovered from reconcilers, by controller. The
+// metrics shared by the library are registered as well, see the metrics package.
+func RegisterMetrics(reg prometheus.Registerer) error {
+	if err := metrics.Register(reg); err != nil {
+		return fmt.Errorf("error registering recovery metrics: %w", err)
+	}
+	if err := libmetrics.Register(reg); err != nil {
+		return fmt.Errorf("error registering operator-lib metrics: %w", err)
+	}
+	return nil
+}
+
+// Option configures a reconciler returned by Wrap.
+type Option func(*options)
+
+type options struct {
+	controller string
+	condition  conditions.Condition
+}
+
+// WithControllerName sets the name of the controller reported in logs and in the
+// "controller" label of the reconcile_panics_total metric.
+func WithControllerName(name string) Option {
+	return func(o *options) {
+		o.controller = name
+	}
+}
+
+// WithCondition sets a condition, typically the Degraded condition of the operator's
+// OperatorCondition, that is set to True with ReconcilePanicReason when a panic is recovered.
+// Failures to set the condition are logged.
+func WithCondition(cond conditions.Condition) Option {
+	return func(o *options) {
+		o.condition = cond
+	}
+}
+
+// Wrap returns a reconciler that calls r and recovers panics raised by it. A recovered panic
+// is returned as a *PanicError, so that the request is requeued with backoff.
+func Wrap[request comparable](r reconcile.TypedReconciler[request], opts ...Option) reconcile.TypedReconciler[request] {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &reconciler[request]{reconciler: r, options: o}
+}
+
+type reconciler[request comparable] struct {
+	reconciler reconcile.TypedReconciler[request]
+	options
+}
+
+// Reconcile implements reconcile.TypedReconciler.
+func (r *reconciler[request]) Reconcile(ctx context.Context, req request) (result reconcile.Result, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			panicErr := &PanicError{Value: v, Stack: debug.Stack()}
+			r.recovered(ctx, req, panicErr)
+			result, err = reconcile.Result{}, panicErr
+		}
+	}()
+	return r.reconciler.Reconcile(ctx, req)
+}
+
+func (r *reconciler[request]) recovered(ctx context.Context, req request, panicErr *PanicError) {
+	metrics.ReconcilePanics.WithLabelValues(r.controller).Inc()
+
+	// the logger of the context carries the object being reconciled when called by a controller
+	logger := logf.FromContext(ctx, "request", req)
+	if r.controller != "" {
+		logger = logger.WithValues("controller", r.controller)
+	}
+	logger.Error(panicErr, "Recovered from panic in reconciler", "stacktrace", string(panicErr.Stack))
+
+	if r.condition == nil {
+		return
+	}
+	if err := r.condition.Set(ctx, metav1.ConditionTrue,
+		conditions.WithReason(ReconcilePanicReason),
+		conditions.WithMessage(panicErr.Error()),
+	); err != nil {
+		log.Error(err, "Failed to set condition after recovering from panic", "request", req)
+	}
+}
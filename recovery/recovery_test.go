@@ -0,0 +1,148 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/operator-framework/operator-lib/conditions"
+	"github.com/operator-framework/operator-lib/recovery/internal/metrics"
+)
+
+// fakeCondition records the last status set on it.
+type fakeCondition struct {
+	cond *metav1.Condition
+	err  error
+}
+
+func (c *fakeCondition) Get(_ context.Context) (*metav1.Condition, error) {
+	return c.cond, nil
+}
+
+func (c *fakeCondition) Set(_ context.Context, status metav1.ConditionStatus, opts ...conditions.Option) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.cond = &metav1.Condition{Type: "Degraded", Status: status}
+	for _, opt := range opts {
+		opt(c.cond)
+	}
+	return nil
+}
+
+var _ = Describe("Wrap", func() {
+	var (
+		ctx = context.TODO()
+		req = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "foo"}}
+	)
+
+	panics := func(v interface{}) reconcile.Reconciler {
+		return reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+			panic(v)
+		})
+	}
+
+	It("should pass through the result of the reconciler", func() {
+		r := Wrap[reconcile.Request](reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+			return reconcile.Result{RequeueAfter: time.Minute}, errors.New("TEST")
+		}))
+		result, err := r.Reconcile(ctx, req)
+		Expect(err).To(MatchError("TEST"))
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+	})
+
+	It("should recover panics and return them as errors", func() {
+		panicked := metrics.ReconcilePanics.WithLabelValues("test")
+		before := testutil.ToFloat64(panicked)
+
+		r := Wrap(panics("boom"), WithControllerName("test"))
+		result, err := r.Reconcile(ctx, req)
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		var panicErr *PanicError
+		Expect(errors.As(err, &panicErr)).To(BeTrue())
+		Expect(panicErr.Value).To(Equal("boom"))
+		Expect(string(panicErr.Stack)).To(ContainSubstring("recovery_test.go"))
+		Expect(err).To(MatchError("recovered from panic in reconciler: boom"))
+		Expect(testutil.ToFloat64(panicked)).To(Equal(before + 1))
+	})
+
+	It("should unwrap errors passed to panic", func() {
+		cause := fmt.Errorf("TEST")
+		_, err := Wrap(panics(cause)).Reconcile(ctx, req)
+		Expect(errors.Is(err, cause)).To(BeTrue())
+	})
+
+	It("should recover runtime errors", func() {
+		r := Wrap[reconcile.Request](reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+			var m map[string]string
+			m["foo"] = "bar"
+			return reconcile.Result{}, nil
+		}))
+		_, err := r.Reconcile(ctx, req)
+		Expect(err).To(MatchError(ContainSubstring("assignment to entry in nil map")))
+	})
+
+	It("should set the condition when a panic is recovered", func() {
+		cond := &fakeCondition{}
+		_, err := Wrap(panics("boom"), WithCondition(cond)).Reconcile(ctx, req)
+		Expect(err).To(HaveOccurred())
+		Expect(cond.cond).NotTo(BeNil())
+		Expect(cond.cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.cond.Reason).To(Equal(ReconcilePanicReason))
+		Expect(cond.cond.Message).To(ContainSubstring("boom"))
+	})
+
+	It("should return the panic when the condition cannot be set", func() {
+		cond := &fakeCondition{err: errors.New("TEST")}
+		_, err := Wrap(panics("boom"), WithCondition(cond)).Reconcile(ctx, req)
+		var panicErr *PanicError
+		Expect(errors.As(err, &panicErr)).To(BeTrue())
+	})
+
+	It("should not set the condition when the reconciler does not panic", func() {
+		cond := &fakeCondition{}
+		r := Wrap[reconcile.Request](reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+			return reconcile.Result{}, nil
+		}), WithCondition(cond))
+		Expect(r.Reconcile(ctx, req)).To(Equal(reconcile.Result{}))
+		Expect(cond.cond).To(BeNil())
+	})
+})
+
+var _ = Describe("RegisterMetrics", func() {
+	It("should export the recovery metrics with the registry, once", func() {
+		reg := prometheus.NewRegistry()
+		Expect(RegisterMetrics(reg)).To(Succeed())
+		Expect(RegisterMetrics(reg)).To(Succeed())
+
+		_, err := Wrap[reconcile.Request](reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+			panic("boom")
+		}), WithControllerName("metrics")).Reconcile(context.TODO(), reconcile.Request{})
+		Expect(err).To(HaveOccurred())
+		Expect(testutil.GatherAndCount(reg, "reconcile_panics_total")).To(BeNumerically(">", 0))
+	})
+})
@@ -0,0 +1,233 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package render loads the manifests of an operand, typically embedded in the operator
+// binary with go:embed, and turns them into client.Objects ready to be applied.
+//
+// Manifests are read from the .yaml, .yml and .json files of an fs.FS, in lexical order,
+// and may contain multiple YAML documents. Simple substitutions can be applied to every
+// object: the namespace, container images and labels. Objects whose kind is known to the
+// given scheme are decoded into typed objects, others are returned as unstructured objects.
+// When a RESTMapper is given, every kind is checked to be served by the cluster.
+package render
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Option configures how manifests are rendered.
+type Option func(*options)
+
+type options struct {
+	namespace string
+	images    map[string]string
+	labels    map[string]string
+	mapper    meta.RESTMapper
+}
+
+// WithNamespace sets the namespace of the rendered objects that do not have one. If a RESTMapper
+// is set with WithRESTMapper, cluster-scoped objects are left without a namespace.
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithImages replaces the image of the containers and init containers of the rendered objects,
+// keyed by container name. Containers are looked up in spec.containers and in the pod templates
+// at spec.template and spec.jobTemplate.spec.template.
+func WithImages(images map[string]string) Option {
+	return func(o *options) {
+		o.images = images
+	}
+}
+
+// WithLabels adds labels to the rendered objects, overriding labels of the manifests with the same key.
+func WithLabels(labels map[string]string) Option {
+	return func(o *options) {
+		o.labels = labels
+	}
+}
+
+// WithRESTMapper validates that the kind of every rendered object is served by the cluster,
+// using the discovery information of mapper, e.g. the manager's RESTMapper.
+func WithRESTMapper(mapper meta.RESTMapper) Option {
+	return func(o *options) {
+		o.mapper = mapper
+	}
+}
+
+// podSpecPaths are the paths of the pod specs whose containers are updated by WithImages.
+var podSpecPaths = [][]string{
+	{"spec"},
+	{"spec", "template", "spec"},
+	{"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// Load renders the manifests found in fsys, decoding them with scheme.
+func Load(fsys fs.FS, scheme *runtime.Scheme, opts ...Option) ([]client.Object, error) {
+	var objs []client.Object
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		switch path.Ext(name) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		fileObjs, err := Decode(data, scheme, opts...)
+		if err != nil {
+			return fmt.Errorf("error rendering %s: %w", name, err)
+		}
+		objs = append(objs, fileObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+// Decode renders the YAML or JSON documents in data, decoding them with scheme.
+func Decode(data []byte, scheme *runtime.Scheme, opts ...Option) ([]client.Object, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	var objs []client.Object
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		u := &unstructured.Unstructured{}
+		if err := decoder.Decode(&u.Object); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("error decoding manifest: %w", err)
+		}
+		if len(u.Object) == 0 {
+			// Empty document, e.g. a trailing "---"
+			continue
+		}
+
+		if err := o.apply(u); err != nil {
+			return nil, err
+		}
+
+		obj, err := toObject(u, scheme)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// apply applies the substitutions and validations of o to u.
+func (o *options) apply(u *unstructured.Unstructured) error {
+	gvk := u.GroupVersionKind()
+	if gvk.Kind == "" {
+		return fmt.Errorf("manifest %q has no kind", u.GetName())
+	}
+
+	namespaced := true
+	if o.mapper != nil {
+		mapping, err := o.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return fmt.Errorf("kind %s of %q is not served by the cluster: %w", gvk, u.GetName(), err)
+		}
+		namespaced = mapping.Scope.Name() == meta.RESTScopeNameNamespace
+	}
+	if o.namespace != "" && namespaced && u.GetNamespace() == "" {
+		u.SetNamespace(o.namespace)
+	}
+
+	if len(o.labels) > 0 {
+		labels := u.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		for k, v := range o.labels {
+			labels[k] = v
+		}
+		u.SetLabels(labels)
+	}
+
+	if len(o.images) > 0 {
+		for _, specPath := range podSpecPaths {
+			for _, field := range []string{"containers", "initContainers"} {
+				if err := o.setImages(u, append(specPath, field)...); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// setImages replaces the images of the containers at the given path of u.
+func (o *options) setImages(u *unstructured.Unstructured, fields ...string) error {
+	containers, found, err := unstructured.NestedSlice(u.Object, fields...)
+	if err != nil || !found {
+		return err
+	}
+	for i, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(container, "name")
+		if image, ok := o.images[name]; ok {
+			container["image"] = image
+			containers[i] = container
+		}
+	}
+	return unstructured.SetNestedSlice(u.Object, containers, fields...)
+}
+
+// toObject converts u into a typed object if its kind is known to scheme.
+func toObject(u *unstructured.Unstructured, scheme *runtime.Scheme) (client.Object, error) {
+	if scheme == nil || !scheme.Recognizes(u.GroupVersionKind()) {
+		return u, nil
+	}
+	typed, err := scheme.New(u.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, typed); err != nil {
+		return nil, fmt.Errorf("error converting %q to %s: %w", u.GetName(), u.GroupVersionKind(), err)
+	}
+	obj, ok := typed.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a client.Object", u.GroupVersionKind())
+	}
+	obj.GetObjectKind().SetGroupVersionKind(u.GroupVersionKind())
+	return obj, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+const deployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: operand
+  labels:
+    app: operand
+spec:
+  selector:
+    matchLabels:
+      app: operand
+  template:
+    metadata:
+      labels:
+        app: operand
+    spec:
+      initContainers:
+      - name: init
+        image: init:latest
+      containers:
+      - name: server
+        image: server:latest
+      - name: sidecar
+        image: sidecar:latest
+`
+
+const rbac = `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: operand
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: operand
+  namespace: fixed
+---
+`
+
+const custom = `{"apiVersion": "example.com/v1", "kind": "Widget", "metadata": {"name": "operand"}}`
+
+var _ = Describe("Load", func() {
+	var (
+		fsys   fstest.MapFS
+		scheme *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		fsys = fstest.MapFS{
+			"manifests/01-deployment.yaml": {Data: []byte(deployment)},
+			"manifests/00-rbac.yml":        {Data: []byte(rbac)},
+			"manifests/02-widget.json":     {Data: []byte(custom)},
+			"manifests/README.md":          {Data: []byte("not a manifest")},
+		}
+		scheme = clientgoscheme.Scheme
+	})
+
+	It("should decode the manifests in lexical order", func() {
+		objs, err := Load(fsys, scheme)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objs).To(HaveLen(4))
+		Expect(objs[0]).To(BeAssignableToTypeOf(&rbacv1.ClusterRole{}))
+		Expect(objs[1]).To(BeAssignableToTypeOf(&corev1.ServiceAccount{}))
+		Expect(objs[2]).To(BeAssignableToTypeOf(&appsv1.Deployment{}))
+		Expect(objs[3]).To(BeAssignableToTypeOf(&unstructured.Unstructured{}))
+		Expect(objs[2].GetObjectKind().GroupVersionKind()).To(Equal(appsv1.SchemeGroupVersion.WithKind("Deployment")))
+	})
+
+	It("should apply substitutions", func() {
+		objs, err := Load(fsys, scheme,
+			WithNamespace("operand-ns"),
+			WithImages(map[string]string{"server": "server:v1", "init": "init:v1"}),
+			WithLabels(map[string]string{"app": "renamed", "owner": "operator"}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(objs[1].GetNamespace()).To(Equal("fixed"))
+		d := objs[2].(*appsv1.Deployment)
+		Expect(d.Namespace).To(Equal("operand-ns"))
+		Expect(d.Labels).To(Equal(map[string]string{"app": "renamed", "owner": "operator"}))
+		Expect(d.Spec.Template.Spec.InitContainers[0].Image).To(Equal("init:v1"))
+		Expect(d.Spec.Template.Spec.Containers[0].Image).To(Equal("server:v1"))
+		Expect(d.Spec.Template.Spec.Containers[1].Image).To(Equal("sidecar:latest"))
+	})
+
+	It("should leave cluster-scoped objects without a namespace when a RESTMapper is set", func() {
+		mapper := meta.NewDefaultRESTMapper(nil)
+		mapper.Add(rbacv1.SchemeGroupVersion.WithKind("ClusterRole"), meta.RESTScopeRoot)
+		mapper.Add(corev1.SchemeGroupVersion.WithKind("ServiceAccount"), meta.RESTScopeNamespace)
+		mapper.Add(appsv1.SchemeGroupVersion.WithKind("Deployment"), meta.RESTScopeNamespace)
+		mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}, meta.RESTScopeNamespace)
+
+		objs, err := Load(fsys, scheme, WithNamespace("operand-ns"), WithRESTMapper(mapper))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objs[0].GetNamespace()).To(BeEmpty())
+		Expect(objs[2].GetNamespace()).To(Equal("operand-ns"))
+		Expect(objs[3].GetNamespace()).To(Equal("operand-ns"))
+	})
+
+	It("should return an error if a kind is not served by the cluster", func() {
+		mapper := meta.NewDefaultRESTMapper(nil)
+		_, err := Load(fsys, scheme, WithRESTMapper(mapper))
+		Expect(err).To(MatchError(ContainSubstring("error rendering manifests/00-rbac.yml")))
+		Expect(meta.IsNoMatchError(err)).To(BeTrue())
+	})
+
+	It("should return an error for invalid manifests", func() {
+		_, err := Decode([]byte("metadata:\n  name: nokind\n"), scheme)
+		Expect(err).To(MatchError(ContainSubstring("has no kind")))
+
+		_, err = Decode([]byte("kind: [\n"), scheme)
+		Expect(err).To(MatchError(ContainSubstring("error decoding manifest")))
+	})
+})
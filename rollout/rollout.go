@@ -0,0 +1,227 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rollout helps operators detect when their own Deployment is being rolled out.
+//
+// While the Deployment of an operator is rolled out, pods of the old and of the new version
+// of the operator run side by side, and either may be reconciling. Disruptive operations, such
+// as storage migrations or restarts of operands, are better deferred until the rollout has
+// settled, so that they are not performed by a half-upgraded operator. This package finds the
+// Deployment of the operator from its own pod, reports whether it is stable and waits for it to
+// be.
+//
+// The checks are meant to guard disruptive operations at reconcile time: a reconciler calls
+// IsSelfStable before such an operation, and requeues the request with a delay while the
+// operator is rolling out, so that no event is lost. Startup code can block on
+// WaitForStableSelf instead. The Deployment is looked up with the reader passed to these
+// functions: a cached reader, such as the manager's client, starts informers for Pods and
+// ReplicaSets, so an uncached reader, such as the manager's API reader, is usually preferable.
+//
+// The pod of the operator is found with the POD_NAME environment variable, which must be set
+// with the downward API, and the namespace of its service account. An operator that is not
+// running in a Deployment, e.g. when run locally, is always considered stable.
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/operator-framework/operator-lib/internal/utils"
+)
+
+var log = logf.Log.WithName("rollout")
+
+// podNameEnvVar is the constant for env variable POD_NAME
+const podNameEnvVar = "POD_NAME"
+
+// DefaultPollInterval is the interval at which WaitForStableSelf checks the Deployment of the
+// operator by default.
+const DefaultPollInterval = 5 * time.Second
+
+// ErrNoDeployment is returned when the operator is not running in a pod controlled by a
+// Deployment.
+var ErrNoDeployment = errors.New("operator is not running in a Deployment")
+
+type options struct {
+	namespace    string
+	podName      string
+	pollInterval time.Duration
+}
+
+// Option configures how the Deployment of the operator is found and checked.
+type Option func(*options)
+
+// WithNamespace sets the namespace of the operator. It defaults to the namespace of the
+// operator's service account.
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithPodName sets the name of the operator's pod. It defaults to the value of the POD_NAME
+// environment variable.
+func WithPodName(name string) Option {
+	return func(o *options) {
+		o.podName = name
+	}
+}
+
+// WithPollInterval sets the interval at which WaitForStableSelf checks the Deployment of the
+// operator. It defaults to DefaultPollInterval.
+func WithPollInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.pollInterval = interval
+	}
+}
+
+func newOptions(opts []Option) (*options, error) {
+	o := &options{pollInterval: DefaultPollInterval}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.podName == "" {
+		o.podName = os.Getenv(podNameEnvVar)
+		if o.podName == "" {
+			return nil, fmt.Errorf("%w: required env %s not set", ErrNoDeployment, podNameEnvVar)
+		}
+	}
+	if o.namespace == "" {
+		ns, err := utils.GetOperatorNamespace()
+		if errors.Is(err, utils.ErrNoNamespace) {
+			return nil, fmt.Errorf("%w: %v", ErrNoDeployment, err)
+		} else if err != nil {
+			return nil, fmt.Errorf("error getting operator namespace: %w", err)
+		}
+		o.namespace = ns
+	}
+	return o, nil
+}
+
+// SelfDeployment returns the Deployment controlling the pod of the operator through a
+// ReplicaSet. An error wrapping ErrNoDeployment is returned if there is none.
+func SelfDeployment(ctx context.Context, reader client.Reader, opts ...Option) (*appsv1.Deployment, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	return selfDeployment(ctx, reader, o)
+}
+
+func selfDeployment(ctx context.Context, reader client.Reader, o *options) (*appsv1.Deployment, error) {
+	pod := &corev1.Pod{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: o.namespace, Name: o.podName}, pod); err != nil {
+		return nil, fmt.Errorf("error getting operator pod: %w", err)
+	}
+	rsRef := metav1.GetControllerOf(pod)
+	if rsRef == nil || rsRef.Kind != "ReplicaSet" {
+		return nil, fmt.Errorf("%w: pod %s is not controlled by a ReplicaSet", ErrNoDeployment, pod.Name)
+	}
+
+	rs := &appsv1.ReplicaSet{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: o.namespace, Name: rsRef.Name}, rs); err != nil {
+		return nil, fmt.Errorf("error getting operator ReplicaSet: %w", err)
+	}
+	deploymentRef := metav1.GetControllerOf(rs)
+	if deploymentRef == nil || deploymentRef.Kind != "Deployment" {
+		return nil, fmt.Errorf("%w: ReplicaSet %s is not controlled by a Deployment", ErrNoDeployment, rs.Name)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: o.namespace, Name: deploymentRef.Name}, deployment); err != nil {
+		return nil, fmt.Errorf("error getting operator Deployment: %w", err)
+	}
+	return deployment, nil
+}
+
+// IsStable returns true if the rollout of deployment has completed: its latest spec has been
+// observed, all its replicas have been updated and are available, and no replicas of older
+// ReplicaSets remain. A Deployment whose rollout exceeded its progress deadline is not stable.
+func IsStable(deployment *appsv1.Deployment) bool {
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false
+	}
+	for _, cond := range deployment.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Reason == "ProgressDeadlineExceeded" {
+			return false
+		}
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+	return status.UpdatedReplicas >= replicas &&
+		status.Replicas <= status.UpdatedReplicas &&
+		status.AvailableReplicas >= status.UpdatedReplicas
+}
+
+// IsSelfStable returns true if the Deployment of the operator is stable, see IsStable, or if
+// the operator is not running in a Deployment.
+func IsSelfStable(ctx context.Context, reader client.Reader, opts ...Option) (bool, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return errors.Is(err, ErrNoDeployment), ignoreNoDeployment(err)
+	}
+	return isSelfStable(ctx, reader, o)
+}
+
+func isSelfStable(ctx context.Context, reader client.Reader, o *options) (bool, error) {
+	deployment, err := selfDeployment(ctx, reader, o)
+	if err != nil {
+		return errors.Is(err, ErrNoDeployment), ignoreNoDeployment(err)
+	}
+	return IsStable(deployment), nil
+}
+
+// WaitForStableSelf blocks until the Deployment of the operator is stable, see IsStable, or the
+// context is done, in which case the context's error is returned. It returns immediately if
+// the operator is not running in a Deployment. Errors checking the Deployment are logged and
+// the check is retried.
+func WaitForStableSelf(ctx context.Context, reader client.Reader, opts ...Option) error {
+	o, err := newOptions(opts)
+	if err != nil {
+		return ignoreNoDeployment(err)
+	}
+
+	return wait.PollUntilContextCancel(ctx, o.pollInterval, true, func(ctx context.Context) (bool, error) {
+		stable, err := isSelfStable(ctx, reader, o)
+		if err != nil {
+			log.Error(err, "Failed to check the rollout of the operator")
+			return false, nil
+		}
+		if !stable {
+			log.V(1).Info("Waiting for the rollout of the operator to settle")
+		}
+		return stable, nil
+	})
+}
+
+func ignoreNoDeployment(err error) error {
+	if errors.Is(err, ErrNoDeployment) {
+		log.V(1).Info("Operator is not running in a Deployment", "reason", err.Error())
+		return nil
+	}
+	return err
+}
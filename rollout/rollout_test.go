@@ -0,0 +1,188 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/operator-framework/operator-lib/internal/utils"
+)
+
+const namespace = "operator-ns"
+
+var _ = Describe("Rollout", func() {
+	var (
+		c          client.Client
+		deployment *appsv1.Deployment
+		opts       []Option
+	)
+
+	BeforeEach(func() {
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "operator", Namespace: namespace, UID: "deployment-uid", Generation: 2},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 2,
+				Replicas:           2,
+				UpdatedReplicas:    2,
+				AvailableReplicas:  2,
+			},
+		}
+		rs := &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "operator-1234",
+				Namespace: namespace,
+				UID:       "rs-uid",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1", Kind: "Deployment", Name: deployment.Name, UID: deployment.UID, Controller: ptr.To(true),
+				}},
+			},
+		}
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "operator-1234-abcd",
+				Namespace: namespace,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1", Kind: "ReplicaSet", Name: rs.Name, UID: rs.UID, Controller: ptr.To(true),
+				}},
+			},
+		}
+		c = fake.NewClientBuilder().WithObjects(deployment, rs, pod).WithStatusSubresource(deployment).Build()
+		opts = []Option{WithNamespace(namespace), WithPodName(pod.Name)}
+	})
+
+	startRollout := func() {
+		d := &appsv1.Deployment{}
+		Expect(c.Get(context.Background(), client.ObjectKeyFromObject(deployment), d)).To(Succeed())
+		d.Status.Replicas = 3
+		d.Status.UpdatedReplicas = 1
+		d.Status.AvailableReplicas = 2
+		Expect(c.Status().Update(context.Background(), d)).To(Succeed())
+	}
+	finishRollout := func() {
+		d := &appsv1.Deployment{}
+		Expect(c.Get(context.Background(), client.ObjectKeyFromObject(deployment), d)).To(Succeed())
+		d.Status.Replicas = 2
+		d.Status.UpdatedReplicas = 2
+		d.Status.AvailableReplicas = 2
+		Expect(c.Status().Update(context.Background(), d)).To(Succeed())
+	}
+
+	Describe("IsStable", func() {
+		It("should be true when all replicas are updated and available", func() {
+			Expect(IsStable(deployment)).To(BeTrue())
+		})
+		It("should be false when the latest spec has not been observed", func() {
+			deployment.Generation = 3
+			Expect(IsStable(deployment)).To(BeFalse())
+		})
+		It("should be false while new replicas are scaling up", func() {
+			deployment.Status.UpdatedReplicas = 1
+			deployment.Status.Replicas = 3
+			Expect(IsStable(deployment)).To(BeFalse())
+		})
+		It("should be false while old replicas are terminating", func() {
+			deployment.Status.Replicas = 3
+			Expect(IsStable(deployment)).To(BeFalse())
+		})
+		It("should be false while updated replicas are not available", func() {
+			deployment.Status.AvailableReplicas = 1
+			Expect(IsStable(deployment)).To(BeFalse())
+		})
+		It("should be false when the progress deadline is exceeded", func() {
+			deployment.Status.Conditions = []appsv1.DeploymentCondition{{
+				Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded",
+			}}
+			Expect(IsStable(deployment)).To(BeFalse())
+		})
+	})
+
+	Describe("SelfDeployment", func() {
+		It("should return the Deployment of the operator pod", func() {
+			d, err := SelfDeployment(context.Background(), c, opts...)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(d.Name).To(Equal(deployment.Name))
+		})
+		It("should use the POD_NAME env and the operator namespace by default", func() {
+			GinkgoT().Setenv(podNameEnvVar, "operator-1234-abcd")
+			original := utils.GetOperatorNamespace
+			utils.GetOperatorNamespace = func() (string, error) { return namespace, nil }
+			DeferCleanup(func() { utils.GetOperatorNamespace = original })
+
+			d, err := SelfDeployment(context.Background(), c)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(d.Name).To(Equal(deployment.Name))
+		})
+		It("should return ErrNoDeployment when POD_NAME is not set", func() {
+			GinkgoT().Setenv(podNameEnvVar, "")
+			_, err := SelfDeployment(context.Background(), c, WithNamespace(namespace))
+			Expect(err).To(MatchError(ErrNoDeployment))
+		})
+		It("should return ErrNoDeployment when the pod has no ReplicaSet", func() {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "standalone", Namespace: namespace}}
+			Expect(c.Create(context.Background(), pod)).To(Succeed())
+			_, err := SelfDeployment(context.Background(), c, WithNamespace(namespace), WithPodName(pod.Name))
+			Expect(err).To(MatchError(ErrNoDeployment))
+		})
+		It("should return an error when the pod does not exist", func() {
+			_, err := SelfDeployment(context.Background(), c, WithNamespace(namespace), WithPodName("missing"))
+			Expect(err).To(HaveOccurred())
+			Expect(err).ToNot(MatchError(ErrNoDeployment))
+		})
+	})
+
+	Describe("IsSelfStable", func() {
+		It("should report the rollout of the operator Deployment", func() {
+			Expect(IsSelfStable(context.Background(), c, opts...)).To(BeTrue())
+			startRollout()
+			Expect(IsSelfStable(context.Background(), c, opts...)).To(BeFalse())
+		})
+		It("should be true when the operator is not running in a Deployment", func() {
+			GinkgoT().Setenv(podNameEnvVar, "")
+			Expect(IsSelfStable(context.Background(), c, WithNamespace(namespace))).To(BeTrue())
+		})
+	})
+
+	Describe("WaitForStableSelf", func() {
+		It("should return once the rollout has settled", func() {
+			startRollout()
+			done := make(chan error)
+			go func() {
+				defer GinkgoRecover()
+				done <- WaitForStableSelf(context.Background(), c, append(opts, WithPollInterval(time.Millisecond))...)
+			}()
+			Consistently(done).WithTimeout(20 * time.Millisecond).ShouldNot(Receive())
+			finishRollout()
+			Eventually(done).Should(Receive(BeNil()))
+		})
+		It("should return the context error when the context is done", func() {
+			startRollout()
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			err := WaitForStableSelf(ctx, c, append(opts, WithPollInterval(time.Millisecond))...)
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+		})
+	})
+})
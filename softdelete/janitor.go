@@ -0,0 +1,145 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package softdelete
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// DefaultJanitorInterval is the interval at which a Janitor deletes expired objects by default.
+const DefaultJanitorInterval = 5 * time.Minute
+
+// Janitor deletes the objects marked for deletion whose deadline has passed. A Janitor is
+// a manager.Runnable that sweeps periodically while the operator is the leader; Sweep can
+// also be called directly.
+type Janitor struct {
+	client    client.Client
+	gvks      []schema.GroupVersionKind
+	namespace string
+	interval  time.Duration
+	clock     clock.PassiveClock
+}
+
+// JanitorOption configures a Janitor.
+type JanitorOption func(*Janitor)
+
+// WithNamespace restricts the Janitor to objects in namespace. Objects in all namespaces are
+// swept by default.
+func WithNamespace(namespace string) JanitorOption {
+	return func(j *Janitor) {
+		j.namespace = namespace
+	}
+}
+
+// WithInterval sets the interval at which the Janitor sweeps. It defaults to
+// DefaultJanitorInterval.
+func WithInterval(interval time.Duration) JanitorOption {
+	return func(j *Janitor) {
+		j.interval = interval
+	}
+}
+
+// WithClock sets the clock the Janitor compares deadlines to. It defaults to the real clock.
+func WithClock(c clock.PassiveClock) JanitorOption {
+	return func(j *Janitor) {
+		j.clock = c
+	}
+}
+
+var _ manager.Runnable = &Janitor{}
+var _ manager.LeaderElectionRunnable = &Janitor{}
+
+// NewJanitor returns a Janitor deleting the expired objects of the given kinds with c.
+func NewJanitor(c client.Client, gvks []schema.GroupVersionKind, opts ...JanitorOption) (*Janitor, error) {
+	if len(gvks) == 0 {
+		return nil, fmt.Errorf("error when creating a new Janitor: at least one gvk is required")
+	}
+
+	j := &Janitor{
+		client:   c,
+		gvks:     gvks,
+		interval: DefaultJanitorInterval,
+		clock:    clock.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(j)
+	}
+	return j, nil
+}
+
+// Start implements manager.Runnable. It sweeps until the context is done. Errors are logged
+// and retried at the next interval.
+func (j *Janitor) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := j.Sweep(ctx); err != nil {
+			log.Error(err, "Failed to delete expired objects")
+		}
+	}, j.interval)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Objects are only deleted
+// by the leader.
+func (j *Janitor) NeedLeaderElection() bool {
+	return true
+}
+
+// Sweep deletes the objects marked for deletion whose deadline has passed and returns them.
+// Objects are deleted on the condition that they did not change since they were listed, so
+// that an object restored concurrently is not deleted.
+func (j *Janitor) Sweep(ctx context.Context) ([]client.Object, error) {
+	now := j.clock.Now()
+
+	var deleted []client.Object
+	for _, gvk := range j.gvks {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := j.client.List(ctx, list, client.InNamespace(j.namespace), client.MatchingLabelsSelector{Selector: Selector()}); err != nil {
+			return deleted, fmt.Errorf("error listing %s marked for deletion: %w", gvk, err)
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if !Expired(obj, now) || !obj.GetDeletionTimestamp().IsZero() {
+				continue
+			}
+			obj.SetGroupVersionKind(gvk)
+
+			uid, resourceVersion := obj.GetUID(), obj.GetResourceVersion()
+			preconditions := client.Preconditions{UID: &uid, ResourceVersion: &resourceVersion}
+			err := j.client.Delete(ctx, obj, preconditions, client.PropagationPolicy(metav1.DeletePropagationBackground))
+			switch {
+			case err == nil:
+				log.V(1).Info("Deleted expired object", "gvk", gvk, "object", client.ObjectKeyFromObject(obj))
+				deleted = append(deleted, obj)
+			case apierrors.IsNotFound(err) || apierrors.IsConflict(err):
+				// deleted or changed concurrently, the next sweep will have another look
+			default:
+				return deleted, fmt.Errorf("error deleting expired object %s: %w", client.ObjectKeyFromObject(obj), err)
+			}
+		}
+	}
+	return deleted, nil
+}
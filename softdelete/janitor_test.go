@@ -0,0 +1,150 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package softdelete
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("Janitor", func() {
+	var (
+		ctx       context.Context
+		c         client.Client
+		now       time.Time
+		fakeClock *clocktesting.FakePassiveClock
+		gvks      []schema.GroupVersionKind
+	)
+
+	newConfigMap := func(name, namespace string, deadline *time.Time) *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+		if deadline != nil {
+			cm.Labels = map[string]string{DeadlineLabel: strconv.FormatInt(deadline.Unix(), 10)}
+		}
+		return cm
+	}
+	exists := func(name, namespace string) bool {
+		err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &corev1.ConfigMap{})
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return true
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Now()
+		fakeClock = clocktesting.NewFakePassiveClock(now)
+		gvks = []schema.GroupVersionKind{corev1.SchemeGroupVersion.WithKind("ConfigMap")}
+
+		past, future := now.Add(-time.Minute), now.Add(time.Hour)
+		c = fake.NewClientBuilder().WithObjects(
+			newConfigMap("expired", "default", &past),
+			newConfigMap("expired", "other", &past),
+			newConfigMap("pending", "default", &future),
+			newConfigMap("unmarked", "default", nil),
+		).Build()
+	})
+
+	It("should require at least one gvk", func() {
+		_, err := NewJanitor(c, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should delete the expired objects", func() {
+		janitor, err := NewJanitor(c, gvks, WithClock(fakeClock))
+		Expect(err).NotTo(HaveOccurred())
+
+		deleted, err := janitor.Sweep(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(HaveLen(2))
+		Expect(exists("expired", "default")).To(BeFalse())
+		Expect(exists("expired", "other")).To(BeFalse())
+		Expect(exists("pending", "default")).To(BeTrue())
+		Expect(exists("unmarked", "default")).To(BeTrue())
+
+		fakeClock.SetTime(now.Add(2 * time.Hour))
+		deleted, err = janitor.Sweep(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(HaveLen(1))
+		Expect(exists("pending", "default")).To(BeFalse())
+		Expect(exists("unmarked", "default")).To(BeTrue())
+	})
+
+	It("should only delete objects in its namespace", func() {
+		janitor, err := NewJanitor(c, gvks, WithClock(fakeClock), WithNamespace("other"))
+		Expect(err).NotTo(HaveOccurred())
+
+		deleted, err := janitor.Sweep(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(HaveLen(1))
+		Expect(exists("expired", "default")).To(BeTrue())
+		Expect(exists("expired", "other")).To(BeFalse())
+	})
+
+	It("should not delete objects restored after they were listed", func() {
+		c = interceptor.NewClient(c.(client.WithWatch), interceptor.Funcs{
+			Delete: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if obj.GetNamespace() == "default" {
+					cm := &corev1.ConfigMap{}
+					Expect(cl.Get(ctx, client.ObjectKeyFromObject(obj), cm)).To(Succeed())
+					Expect(Restore(ctx, cl, cm)).To(Succeed())
+				}
+				return cl.Delete(ctx, obj, opts...)
+			},
+		})
+		janitor, err := NewJanitor(c, gvks, WithClock(fakeClock))
+		Expect(err).NotTo(HaveOccurred())
+
+		deleted, err := janitor.Sweep(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(HaveLen(1))
+		Expect(exists("expired", "default")).To(BeTrue())
+		Expect(exists("expired", "other")).To(BeFalse())
+	})
+
+	It("should return unexpected errors", func() {
+		c = interceptor.NewClient(c.(client.WithWatch), interceptor.Funcs{
+			Delete: func(context.Context, client.WithWatch, client.Object, ...client.DeleteOption) error {
+				return fmt.Errorf("TEST")
+			},
+		})
+		janitor, err := NewJanitor(c, gvks, WithClock(fakeClock))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = janitor.Sweep(ctx)
+		Expect(err).To(MatchError(ContainSubstring("TEST")))
+	})
+
+	It("should need leader election", func() {
+		janitor, err := NewJanitor(c, gvks)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(janitor.NeedLeaderElection()).To(BeTrue())
+	})
+})
@@ -0,0 +1,152 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package softdelete provides deferred deletion of operands.
+//
+// Instead of deleting the operands of a custom resource as soon as it is removed, an operator
+// can mark them for deletion with MarkForDeletion. Marked operands are labeled with a deletion
+// deadline and detached from their owner, so that the garbage collector keeps them. A Janitor
+// deletes them once the deadline has passed, and Restore cancels the deletion until then. This
+// allows operators to offer "restore within N hours" semantics.
+//
+// Marked operands can also be cleaned up with the prune package, by selecting them with
+// Selector and pruning them with NewPruneStrategy.
+package softdelete
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/operator-framework/operator-lib/prune"
+)
+
+var log = logf.Log.WithName("softdelete")
+
+// DeadlineLabel is set on objects marked for deletion to the time after which they are
+// deleted, in seconds since the Unix epoch.
+const DeadlineLabel = "operator-lib.operatorframework.io/delete-after"
+
+// MarkForDeletion marks obj for deletion after gracePeriod. The owner references of obj
+// pointing to owner, if owner is not nil, are removed so that obj is not garbage collected
+// along with owner. If obj is already marked for deletion, its deadline is kept, so that
+// MarkForDeletion can be called on every reconciliation of a deleted owner.
+func MarkForDeletion(ctx context.Context, c client.Client, obj client.Object, owner client.Object, gracePeriod time.Duration) error {
+	_, marked := Deadline(obj)
+	ownerRefs := obj.GetOwnerReferences()
+	if owner != nil {
+		kept := ownerRefs[:0:0]
+		for _, ref := range ownerRefs {
+			if ref.UID != owner.GetUID() {
+				kept = append(kept, ref)
+			}
+		}
+		ownerRefs = kept
+	}
+	if marked && len(ownerRefs) == len(obj.GetOwnerReferences()) {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	if !marked {
+		objLabels := obj.GetLabels()
+		if objLabels == nil {
+			objLabels = map[string]string{}
+		}
+		objLabels[DeadlineLabel] = strconv.FormatInt(time.Now().Add(gracePeriod).Unix(), 10)
+		obj.SetLabels(objLabels)
+	}
+	obj.SetOwnerReferences(ownerRefs)
+	if err := c.Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("error marking object for deletion: %w", err)
+	}
+	log.V(1).Info("Marked object for deletion", "object", client.ObjectKeyFromObject(obj), "deadline", obj.GetLabels()[DeadlineLabel])
+	return nil
+}
+
+// Restore cancels the deletion of obj, if it is marked for deletion. The owner references
+// removed by MarkForDeletion are not restored; the operator is expected to adopt obj again
+// when reconciling its restored owner.
+func Restore(ctx context.Context, c client.Client, obj client.Object) error {
+	if _, marked := Deadline(obj); !marked {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	objLabels := obj.GetLabels()
+	delete(objLabels, DeadlineLabel)
+	obj.SetLabels(objLabels)
+	if err := c.Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("error restoring object: %w", err)
+	}
+	log.V(1).Info("Restored object", "object", client.ObjectKeyFromObject(obj))
+	return nil
+}
+
+// Deadline returns the time after which obj is deleted, and true if obj is marked for deletion.
+// Objects with a malformed DeadlineLabel are considered marked for immediate deletion.
+func Deadline(obj client.Object) (time.Time, bool) {
+	value, ok := obj.GetLabels()[DeadlineLabel]
+	if !ok {
+		return time.Time{}, false
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, true
+	}
+	return time.Unix(seconds, 0), true
+}
+
+// Expired returns true if obj is marked for deletion and its deadline has passed at now.
+func Expired(obj client.Object, now time.Time) bool {
+	deadline, marked := Deadline(obj)
+	return marked && !now.Before(deadline)
+}
+
+// Selector returns a label selector matching the objects marked for deletion.
+func Selector() labels.Selector {
+	requirement, err := labels.NewRequirement(DeadlineLabel, selection.Exists, nil)
+	if err != nil {
+		panic(err)
+	}
+	return labels.NewSelector().Add(*requirement)
+}
+
+// NewPruneStrategy returns a prune.StrategyFunc that prunes the objects whose deletion deadline
+// has passed. The current time is read from the Clock of the prune.PruneContext, see
+// prune.WithClock. It is typically used with a Pruner selecting objects with Selector.
+func NewPruneStrategy() prune.StrategyFunc {
+	return func(ctx context.Context, objs []client.Object) ([]client.Object, error) {
+		var c clock.PassiveClock = clock.RealClock{}
+		if pctx, ok := prune.PruneContextFrom(ctx); ok && pctx.Clock != nil {
+			c = pctx.Clock
+		}
+
+		var objsToPrune []client.Object
+		now := c.Now()
+		for _, obj := range objs {
+			if Expired(obj, now) {
+				objsToPrune = append(objsToPrune, obj)
+			}
+		}
+		return objsToPrune, nil
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package softdelete
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/operator-framework/operator-lib/prune"
+)
+
+var _ = Describe("SoftDelete", func() {
+	var (
+		ctx   context.Context
+		c     client.Client
+		owner *corev1.ConfigMap
+		obj   *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		c = fake.NewClientBuilder().Build()
+		owner = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default", UID: "owner-uid"}}
+		obj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      "operand",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "test"},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "v1", Kind: "ConfigMap", Name: "owner", UID: "owner-uid"},
+				{APIVersion: "v1", Kind: "ConfigMap", Name: "other", UID: "other-uid"},
+			},
+		}}
+		Expect(c.Create(ctx, obj)).To(Succeed())
+	})
+
+	get := func() *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(obj), cm)).To(Succeed())
+		return cm
+	}
+
+	Describe("MarkForDeletion", func() {
+		It("should label the object with its deadline and detach it from its owner", func() {
+			Expect(MarkForDeletion(ctx, c, obj, owner, time.Hour)).To(Succeed())
+
+			cm := get()
+			deadline, marked := Deadline(cm)
+			Expect(marked).To(BeTrue())
+			Expect(deadline).To(BeTemporally("~", time.Now().Add(time.Hour), 2*time.Second))
+			Expect(cm.GetLabels()).To(HaveKeyWithValue("app", "test"))
+			Expect(cm.GetOwnerReferences()).To(HaveLen(1))
+			Expect(cm.GetOwnerReferences()[0].UID).To(Equal(types.UID("other-uid")))
+		})
+
+		It("should keep the deadline of an object already marked for deletion", func() {
+			Expect(MarkForDeletion(ctx, c, obj, nil, time.Hour)).To(Succeed())
+			first := get().GetLabels()[DeadlineLabel]
+
+			cm := get()
+			Expect(MarkForDeletion(ctx, c, cm, owner, 5*time.Hour)).To(Succeed())
+			Expect(get().GetLabels()).To(HaveKeyWithValue(DeadlineLabel, first))
+			Expect(get().GetOwnerReferences()).To(HaveLen(1))
+		})
+	})
+
+	Describe("Restore", func() {
+		It("should cancel the deletion of the object", func() {
+			Expect(MarkForDeletion(ctx, c, obj, owner, time.Hour)).To(Succeed())
+
+			cm := get()
+			Expect(Restore(ctx, c, cm)).To(Succeed())
+			_, marked := Deadline(get())
+			Expect(marked).To(BeFalse())
+			Expect(get().GetLabels()).To(HaveKeyWithValue("app", "test"))
+		})
+
+		It("should do nothing if the object is not marked for deletion", func() {
+			Expect(Restore(ctx, c, obj)).To(Succeed())
+			Expect(get().GetResourceVersion()).To(Equal(obj.GetResourceVersion()))
+		})
+	})
+
+	Describe("Expired", func() {
+		It("should compare the deadline to the given time", func() {
+			now := time.Now()
+			obj.SetLabels(map[string]string{DeadlineLabel: strconv.FormatInt(now.Unix(), 10)})
+			Expect(Expired(obj, now.Add(-time.Minute))).To(BeFalse())
+			Expect(Expired(obj, now.Add(time.Minute))).To(BeTrue())
+		})
+
+		It("should consider a malformed deadline expired", func() {
+			obj.SetLabels(map[string]string{DeadlineLabel: "tomorrow"})
+			Expect(Expired(obj, time.Now())).To(BeTrue())
+		})
+
+		It("should not consider unmarked objects expired", func() {
+			Expect(Expired(obj, time.Now())).To(BeFalse())
+		})
+	})
+
+	Describe("Selector", func() {
+		It("should only match objects marked for deletion", func() {
+			Expect(MarkForDeletion(ctx, c, obj, nil, time.Hour)).To(Succeed())
+			other := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}
+			Expect(c.Create(ctx, other)).To(Succeed())
+
+			list := &corev1.ConfigMapList{}
+			Expect(c.List(ctx, list, client.MatchingLabelsSelector{Selector: Selector()})).To(Succeed())
+			Expect(list.Items).To(HaveLen(1))
+			Expect(list.Items[0].Name).To(Equal("operand"))
+		})
+	})
+
+	Describe("NewPruneStrategy", func() {
+		It("should select the expired objects", func() {
+			now := time.Now()
+			expired := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "expired",
+				Labels: map[string]string{DeadlineLabel: strconv.FormatInt(now.Add(-time.Minute).Unix(), 10)}}}
+			pending := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "pending",
+				Labels: map[string]string{DeadlineLabel: strconv.FormatInt(now.Add(time.Hour).Unix(), 10)}}}
+
+			pctx := prune.PruneContext{Clock: clocktesting.NewFakePassiveClock(now)}
+			objs, err := NewPruneStrategy()(prune.WithPruneContext(ctx, pctx), []client.Object{expired, pending, obj})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objs).To(ConsistOf(expired))
+		})
+	})
+})
@@ -0,0 +1,86 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Collector adds usage counters to a UsageReport.
+type Collector interface {
+	Collect(ctx context.Context, report *UsageReport) error
+}
+
+// CollectorFunc is a function implementing Collector.
+type CollectorFunc func(ctx context.Context, report *UsageReport) error
+
+// Collect implements Collector.
+func (f CollectorFunc) Collect(ctx context.Context, report *UsageReport) error {
+	return f(ctx, report)
+}
+
+// ObjectCounts returns a Collector reporting the number of objects of each of the given kinds,
+// in all namespaces, in UsageReport.ObjectCounts. Objects are listed with reader as metadata only.
+func ObjectCounts(reader client.Reader, gvks ...schema.GroupVersionKind) Collector {
+	return CollectorFunc(func(ctx context.Context, report *UsageReport) error {
+		for _, gvk := range gvks {
+			list := &metav1.PartialObjectMetadataList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+			if err := reader.List(ctx, list); err != nil {
+				return fmt.Errorf("error counting %s: %w", gvk, err)
+			}
+			if report.ObjectCounts == nil {
+				report.ObjectCounts = map[string]int{}
+			}
+			report.ObjectCounts[gvk.GroupKind().String()] = len(list.Items)
+		}
+		return nil
+	})
+}
+
+// FeatureGates returns a Collector reporting whether each feature gate is enabled in
+// UsageReport.FeatureGates, as returned by gates when a report is collected.
+func FeatureGates(gates func() map[string]bool) Collector {
+	return CollectorFunc(func(_ context.Context, report *UsageReport) error {
+		for name, enabled := range gates() {
+			if report.FeatureGates == nil {
+				report.FeatureGates = map[string]bool{}
+			}
+			report.FeatureGates[name] = enabled
+		}
+		return nil
+	})
+}
+
+// Counter returns a Collector reporting the value returned by value in UsageReport.Counters, under
+// name, for counters specific to an operator.
+func Counter(name string, value func(ctx context.Context) (int64, error)) Collector {
+	return CollectorFunc(func(ctx context.Context, report *UsageReport) error {
+		v, err := value(ctx)
+		if err != nil {
+			return fmt.Errorf("error collecting counter %s: %w", name, err)
+		}
+		if report.Counters == nil {
+			report.Counters = map[string]int64{}
+		}
+		report.Counters[name] = v
+		return nil
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Exporter exports UsageReports.
+type Exporter interface {
+	Export(ctx context.Context, report *UsageReport) error
+}
+
+// PrometheusExporter exports UsageReports as Prometheus gauges:
+//
+//   - operator_lib_telemetry_info{operator_version} is set to 1
+//   - operator_lib_telemetry_objects{kind} is set to the number of objects of each kind
+//   - operator_lib_telemetry_feature_gate_enabled{gate} is set to 1 for enabled feature gates
+//   - operator_lib_telemetry_counter{name} is set to the value of each counter
+//
+// The gauges reflect the last exported UsageReport.
+type PrometheusExporter struct {
+	info         *prometheus.GaugeVec
+	objects      *prometheus.GaugeVec
+	featureGates *prometheus.GaugeVec
+	counters     *prometheus.GaugeVec
+}
+
+// NewPrometheusExporter returns a PrometheusExporter whose gauges are registered with reg.
+func NewPrometheusExporter(reg prometheus.Registerer) (*PrometheusExporter, error) {
+	e := &PrometheusExporter{
+		info: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "operator_lib_telemetry_info",
+			Help: "Information about the operator reporting telemetry",
+		}, []string{"operator_version"}),
+		objects: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "operator_lib_telemetry_objects",
+			Help: "Number of objects by kind",
+		}, []string{"kind"}),
+		featureGates: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "operator_lib_telemetry_feature_gate_enabled",
+			Help: "Whether each feature gate is enabled (1) or not (0)",
+		}, []string{"gate"}),
+		counters: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "operator_lib_telemetry_counter",
+			Help: "Usage counters specific to the operator",
+		}, []string{"name"}),
+	}
+	for _, c := range []prometheus.Collector{e.info, e.objects, e.featureGates, e.counters} {
+		if err := reg.Register(c); err != nil {
+			return nil, fmt.Errorf("error registering telemetry metrics: %w", err)
+		}
+	}
+	return e, nil
+}
+
+// Export implements Exporter.
+func (e *PrometheusExporter) Export(_ context.Context, report *UsageReport) error {
+	e.info.Reset()
+	e.info.WithLabelValues(report.OperatorVersion).Set(1)
+
+	e.objects.Reset()
+	for kind, count := range report.ObjectCounts {
+		e.objects.WithLabelValues(kind).Set(float64(count))
+	}
+
+	e.featureGates.Reset()
+	for gate, enabled := range report.FeatureGates {
+		value := 0.0
+		if enabled {
+			value = 1
+		}
+		e.featureGates.WithLabelValues(gate).Set(value)
+	}
+
+	e.counters.Reset()
+	for name, value := range report.Counters {
+		e.counters.WithLabelValues(name).Set(float64(value))
+	}
+	return nil
+}
+
+// DefaultHTTPExporterTimeout is the timeout of the requests of HTTPExporters created without a
+// client.
+const DefaultHTTPExporterTimeout = 30 * time.Second
+
+// HTTPExporter exports UsageReports by posting them as JSON to an endpoint, ex. the telemetry
+// service of an operator vendor.
+type HTTPExporter struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPExporter returns an HTTPExporter posting UsageReports to url with client. If client is
+// nil, a client with a timeout of DefaultHTTPExporterTimeout is used, so that an unresponsive
+// endpoint does not block the UsageReporter.
+func NewHTTPExporter(url string, client *http.Client) *HTTPExporter {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultHTTPExporterTimeout}
+	}
+	return &HTTPExporter{url: url, client: client}
+}
+
+// Export implements Exporter. Responses with a status other than 2xx are errors.
+func (e *HTTPExporter) Export(ctx context.Context, report *UsageReport) error {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("error marshaling report: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error posting report: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.New("error posting report: unexpected status " + resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package telemetry provides opt-in usage reporting for operators.
+//
+// A UsageReporter periodically collects anonymized usage counters, such as the number of
+// custom resources by kind and the feature gates that are enabled, into a UsageReport, and
+// exports it. UsageReports are exported as Prometheus metrics by default, and can be pushed
+// over HTTP with an HTTPExporter. Nothing is collected nor exported unless the operator opted
+// in, see WithOptIn and OptedInFromEnv:
+//
+//	reporter, err := telemetry.NewUsageReporter(
+//		telemetry.WithOptIn(telemetry.OptedInFromEnv()),
+//		telemetry.WithOperatorVersion(version),
+//		telemetry.WithCollectors(telemetry.ObjectCounts(mgr.GetClient(), myKindGVK)),
+//	)
+//	...
+//	err = mgr.Add(reporter)
+//
+// UsageReports never contain names, namespaces or contents of objects. The installation ID, if
+// set with WithInstallationID, is hashed before it is reported.
+package telemetry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var log = logf.Log.WithName("telemetry")
+
+// OptInEnvVar is the environment variable read by OptedInFromEnv.
+const OptInEnvVar = "OPERATOR_TELEMETRY_OPT_IN"
+
+// DefaultReportInterval is the interval at which a UsageReporter reports by default.
+const DefaultReportInterval = 24 * time.Hour
+
+// ErrNotOptedIn is returned when a report is requested from a UsageReporter that did not opt
+// in.
+var ErrNotOptedIn = errors.New("telemetry is not opted in")
+
+// UsageReport holds the anonymized usage counters of an operator.
+type UsageReport struct {
+	// InstallationID is the hashed installation ID, see WithInstallationID
+	InstallationID string `json:"installationID,omitempty"`
+	// OperatorVersion is the version of the operator, see WithOperatorVersion
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+	// GeneratedAt is the time at which the report was collected
+	GeneratedAt time.Time `json:"generatedAt"`
+	// ObjectCounts are the numbers of objects by kind, in the "Kind.group" format
+	ObjectCounts map[string]int `json:"objectCounts,omitempty"`
+	// FeatureGates report whether each feature gate is enabled
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// Counters are the counters of custom Collectors
+	Counters map[string]int64 `json:"counters,omitempty"`
+}
+
+// OptedInFromEnv returns true if the OptInEnvVar environment variable is set to a truthy value,
+// ex. "true". Unset and invalid values opt out.
+func OptedInFromEnv() bool {
+	optedIn, err := strconv.ParseBool(os.Getenv(OptInEnvVar))
+	return err == nil && optedIn
+}
+
+// UsageReporter collects and exports UsageReports. A UsageReporter is a manager.Runnable that
+// reports periodically while the operator is the leader; Report can also be called directly.
+type UsageReporter struct {
+	optedIn         bool
+	operatorVersion string
+	installationID  string
+	collectors      []Collector
+	exporters       []Exporter
+	interval        time.Duration
+
+	// err is the first error found in the options
+	err error
+}
+
+// Option configures a UsageReporter.
+type Option func(*UsageReporter)
+
+// WithOptIn sets whether the operator opted in to telemetry. UsageReporters are opted out by
+// default, so that nothing is collected without explicit consent, ex. from OptedInFromEnv.
+func WithOptIn(optedIn bool) Option {
+	return func(r *UsageReporter) {
+		r.optedIn = optedIn
+	}
+}
+
+// WithOperatorVersion sets the version of the operator reported in
+// UsageReport.OperatorVersion.
+func WithOperatorVersion(version string) Option {
+	return func(r *UsageReporter) {
+		r.operatorVersion = version
+	}
+}
+
+// WithInstallationID sets an identifier of the installation, ex. the UID of the kube-system
+// namespace, so that reports of the same installation can be correlated. Only a SHA-256 hash
+// of id is reported.
+func WithInstallationID(id string) Option {
+	return func(r *UsageReporter) {
+		sum := sha256.Sum256([]byte(id))
+		r.installationID = hex.EncodeToString(sum[:])
+	}
+}
+
+// WithCollectors adds collectors to the UsageReporter. Collectors are called in the order they
+// were added.
+func WithCollectors(collectors ...Collector) Option {
+	return func(r *UsageReporter) {
+		r.collectors = append(r.collectors, collectors...)
+	}
+}
+
+// WithExporters adds exporters to the UsageReporter, replacing the default PrometheusExporter.
+func WithExporters(exporters ...Exporter) Option {
+	return func(r *UsageReporter) {
+		r.exporters = append(r.exporters, exporters...)
+	}
+}
+
+// WithInterval sets the interval at which the UsageReporter reports. It defaults to
+// DefaultReportInterval. NewUsageReporter returns an error if interval is not positive.
+func WithInterval(interval time.Duration) Option {
+	return func(r *UsageReporter) {
+		if interval <= 0 {
+			r.err = fmt.Errorf("error when creating a new UsageReporter: interval must be positive, got %s", interval)
+			return
+		}
+		r.interval = interval
+	}
+}
+
+var _ manager.Runnable = &UsageReporter{}
+var _ manager.LeaderElectionRunnable = &UsageReporter{}
+
+// NewUsageReporter returns a UsageReporter configured with opts. Without exporters, reports are
+// exported by a PrometheusExporter registered with controller-runtime's metrics.Registry, if the
+// operator opted in.
+func NewUsageReporter(opts ...Option) (*UsageReporter, error) {
+	r := &UsageReporter{interval: DefaultReportInterval}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if r.optedIn && len(r.exporters) == 0 {
+		exporter, err := NewPrometheusExporter(crmetrics.Registry)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating a new UsageReporter: %w", err)
+		}
+		r.exporters = []Exporter{exporter}
+	}
+	return r, nil
+}
+
+// OptedIn returns true if the operator opted in to telemetry, see WithOptIn.
+func (r *UsageReporter) OptedIn() bool {
+	return r.optedIn
+}
+
+// Collect collects a UsageReport without exporting it. It returns ErrNotOptedIn if the operator did
+// not opt in.
+func (r *UsageReporter) Collect(ctx context.Context) (*UsageReport, error) {
+	if !r.optedIn {
+		return nil, ErrNotOptedIn
+	}
+
+	report := &UsageReport{
+		InstallationID:  r.installationID,
+		OperatorVersion: r.operatorVersion,
+		GeneratedAt:     time.Now().UTC(),
+	}
+	for _, collector := range r.collectors {
+		if err := collector.Collect(ctx, report); err != nil {
+			return nil, fmt.Errorf("error collecting telemetry: %w", err)
+		}
+	}
+	return report, nil
+}
+
+// Report collects a UsageReport and exports it with all exporters. Errors of exporters are joined
+// together; a failing exporter does not prevent the others from exporting.
+func (r *UsageReporter) Report(ctx context.Context) (*UsageReport, error) {
+	report, err := r.Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, exporter := range r.exporters {
+		if err := exporter.Export(ctx, report); err != nil {
+			errs = append(errs, fmt.Errorf("error exporting telemetry: %w", err))
+		}
+	}
+	return report, errors.Join(errs...)
+}
+
+// Start implements manager.Runnable. It reports until the context is done, or returns right
+// away if the operator did not opt in. Errors are logged and retried at the next interval.
+func (r *UsageReporter) Start(ctx context.Context) error {
+	if !r.optedIn {
+		log.V(1).Info("Telemetry is not opted in, not reporting")
+		return nil
+	}
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := r.Report(ctx); err != nil {
+			log.Error(err, "Failed to report telemetry")
+		}
+	}, r.interval)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Only the leader reports, so
+// that an installation is not reported once per replica.
+func (r *UsageReporter) NeedLeaderElection() bool {
+	return true
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakeExporter struct {
+	reports []*UsageReport
+	err     error
+}
+
+func (e *fakeExporter) Export(_ context.Context, report *UsageReport) error {
+	e.reports = append(e.reports, report)
+	return e.err
+}
+
+var _ = Describe("Telemetry", func() {
+	var (
+		ctx      context.Context
+		c        client.Client
+		exporter *fakeExporter
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		c = fake.NewClientBuilder().WithObjects(
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns1"}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "ns2"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "ns1"}},
+		).Build()
+		exporter = &fakeExporter{}
+	})
+
+	Describe("OptedInFromEnv", func() {
+		It("should only opt in for truthy values", func() {
+			GinkgoT().Setenv(OptInEnvVar, "")
+			Expect(OptedInFromEnv()).To(BeFalse())
+			GinkgoT().Setenv(OptInEnvVar, "yes please")
+			Expect(OptedInFromEnv()).To(BeFalse())
+			GinkgoT().Setenv(OptInEnvVar, "true")
+			Expect(OptedInFromEnv()).To(BeTrue())
+		})
+	})
+
+	Describe("UsageReporter", func() {
+		It("should not collect nor export without opt in", func() {
+			called := false
+			reporter, err := NewUsageReporter(
+				WithCollectors(CollectorFunc(func(context.Context, *UsageReport) error {
+					called = true
+					return nil
+				})),
+				WithExporters(exporter),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reporter.OptedIn()).To(BeFalse())
+
+			_, err = reporter.Report(ctx)
+			Expect(err).To(MatchError(ErrNotOptedIn))
+			Expect(reporter.Start(ctx)).To(Succeed())
+			Expect(called).To(BeFalse())
+			Expect(exporter.reports).To(BeEmpty())
+		})
+
+		It("should collect and export a report", func() {
+			reporter, err := NewUsageReporter(
+				WithOptIn(true),
+				WithOperatorVersion("v1.2.3"),
+				WithInstallationID("cluster-uid"),
+				WithCollectors(
+					ObjectCounts(c, corev1.SchemeGroupVersion.WithKind("ConfigMap"), corev1.SchemeGroupVersion.WithKind("Secret")),
+					FeatureGates(func() map[string]bool { return map[string]bool{"Alpha": true, "Beta": false} }),
+					Counter("reconciles", func(context.Context) (int64, error) { return 42, nil }),
+				),
+				WithExporters(exporter),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			report, err := reporter.Report(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exporter.reports).To(ConsistOf(report))
+			Expect(report.OperatorVersion).To(Equal("v1.2.3"))
+			Expect(report.InstallationID).To(HaveLen(64))
+			Expect(report.InstallationID).NotTo(ContainSubstring("cluster-uid"))
+			Expect(report.GeneratedAt).NotTo(BeZero())
+			Expect(report.ObjectCounts).To(Equal(map[string]int{"ConfigMap": 2, "Secret": 1}))
+			Expect(report.FeatureGates).To(Equal(map[string]bool{"Alpha": true, "Beta": false}))
+			Expect(report.Counters).To(Equal(map[string]int64{"reconciles": 42}))
+		})
+
+		It("should fail when a collector fails", func() {
+			reporter, err := NewUsageReporter(
+				WithOptIn(true),
+				WithCollectors(Counter("broken", func(context.Context) (int64, error) { return 0, errors.New("boom") })),
+				WithExporters(exporter),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reporter.Report(ctx)
+			Expect(err).To(MatchError(ContainSubstring("boom")))
+			Expect(exporter.reports).To(BeEmpty())
+		})
+
+		It("should export with all exporters even if one fails", func() {
+			failing := &fakeExporter{err: errors.New("unavailable")}
+			reporter, err := NewUsageReporter(WithOptIn(true), WithExporters(failing, exporter))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = reporter.Report(ctx)
+			Expect(err).To(MatchError(ContainSubstring("unavailable")))
+			Expect(failing.reports).To(HaveLen(1))
+			Expect(exporter.reports).To(HaveLen(1))
+		})
+
+		It("should reject non-positive intervals", func() {
+			for _, interval := range []time.Duration{0, -time.Second} {
+				_, err := NewUsageReporter(WithInterval(interval))
+				Expect(err).To(MatchError(ContainSubstring("interval must be positive")))
+			}
+		})
+
+		It("should report periodically until the context is done", func() {
+			reporter, err := NewUsageReporter(WithOptIn(true), WithExporters(exporter), WithInterval(10*time.Millisecond))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reporter.NeedLeaderElection()).To(BeTrue())
+
+			runCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			Expect(reporter.Start(runCtx)).To(Succeed())
+			Expect(len(exporter.reports)).To(BeNumerically(">", 1))
+		})
+	})
+
+	Describe("PrometheusExporter", func() {
+		It("should expose the last report as gauges", func() {
+			reg := prometheus.NewRegistry()
+			e, err := NewPrometheusExporter(reg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(e.Export(ctx, &UsageReport{
+				OperatorVersion: "v1",
+				ObjectCounts:    map[string]int{"Foo.example.com": 3, "Bar.example.com": 1},
+				FeatureGates:    map[string]bool{"Alpha": true},
+			})).To(Succeed())
+			Expect(e.Export(ctx, &UsageReport{
+				OperatorVersion: "v2",
+				ObjectCounts:    map[string]int{"Foo.example.com": 5},
+			})).To(Succeed())
+
+			Expect(testutil.ToFloat64(e.info.WithLabelValues("v2"))).To(Equal(1.0))
+			Expect(testutil.ToFloat64(e.objects.WithLabelValues("Foo.example.com"))).To(Equal(5.0))
+			Expect(testutil.CollectAndCount(e.objects)).To(Equal(1))
+			Expect(testutil.CollectAndCount(e.info)).To(Equal(1))
+			Expect(testutil.CollectAndCount(e.featureGates)).To(Equal(0))
+		})
+
+		It("should fail to register twice with the same registry", func() {
+			reg := prometheus.NewRegistry()
+			_, err := NewPrometheusExporter(reg)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = NewPrometheusExporter(reg)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("HTTPExporter", func() {
+		It("should post the report as JSON", func() {
+			var received UsageReport
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Method).To(Equal(http.MethodPost))
+				Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+				body, err := io.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(json.Unmarshal(body, &received)).To(Succeed())
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer server.Close()
+
+			report := &UsageReport{OperatorVersion: "v1", ObjectCounts: map[string]int{"Foo.example.com": 3}}
+			Expect(NewHTTPExporter(server.URL, nil).Export(ctx, report)).To(Succeed())
+			Expect(received.OperatorVersion).To(Equal("v1"))
+			Expect(received.ObjectCounts).To(Equal(report.ObjectCounts))
+		})
+
+		It("should use a client with a timeout by default", func() {
+			Expect(NewHTTPExporter("http://example.com", nil).client.Timeout).To(Equal(DefaultHTTPExporterTimeout))
+		})
+
+		It("should fail on unexpected statuses", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, strings.Repeat("x", 10), http.StatusInternalServerError)
+			}))
+			defer server.Close()
+
+			err := NewHTTPExporter(server.URL, server.Client()).Export(ctx, &UsageReport{})
+			Expect(err).To(MatchError(ContainSubstring("500")))
+		})
+	})
+})
@@ -0,0 +1,159 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package terminating helps operators behave well in namespaces that are being deleted.
+//
+// Once a namespace is terminating, the API server rejects the creation of new objects in it.
+// Operators that keep trying to create their dependents there end up in a create-fail-requeue
+// loop until the namespace is gone. This package detects terminating namespaces, provides a
+// predicate and a client that stop the creation of new objects in them, and a helper that
+// removes finalizers of objects in terminating namespaces without waiting for their cleanup.
+package terminating
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/operator-framework/operator-lib/internal/metrics"
+)
+
+var log = logf.Log.WithName("terminating")
+
+// ErrNamespaceTerminating is returned when an object is not created because its namespace
+// is terminating.
+var ErrNamespaceTerminating = errors.New("namespace is terminating")
+
+// IsNamespaceTerminating returns true if the namespace with the given name has a deletionTimestamp
+// set or does not exist anymore.
+func IsNamespaceTerminating(ctx context.Context, reader client.Reader, namespace string) (bool, error) {
+	ns := &corev1.Namespace{}
+	if err := reader.Get(ctx, client.ObjectKey{Name: namespace}, ns); apierrors.IsNotFound(err) {
+		return true, nil
+	} else if err != nil {
+		return false, fmt.Errorf("error getting namespace %q: %w", namespace, err)
+	}
+	return !ns.GetDeletionTimestamp().IsZero() || ns.Status.Phase == corev1.NamespaceTerminating, nil
+}
+
+// Check returns an error wrapping ErrNamespaceTerminating if the namespace with the given
+// name is terminating. It can be used before creating objects in namespace.
+func Check(ctx context.Context, reader client.Reader, namespace string) error {
+	terminating, err := IsNamespaceTerminating(ctx, reader, namespace)
+	if err != nil {
+		return err
+	}
+	if terminating {
+		return fmt.Errorf("%w: %s", ErrNamespaceTerminating, namespace)
+	}
+	return nil
+}
+
+// IsTerminatingError returns true if err wraps ErrNamespaceTerminating, or if it was returned
+// by the API server because an object was created in a terminating namespace. Reconcilers
+// can use it to stop requeueing requests that cannot succeed.
+func IsTerminatingError(err error) bool {
+	return errors.Is(err, ErrNamespaceTerminating) || apierrors.HasStatusCause(err, corev1.NamespaceTerminatingCause)
+}
+
+// NewGuardedClient returns a client that refuses to create objects in terminating namespaces,
+// returning an error wrapping ErrNamespaceTerminating instead of sending the request to the API
+// server. Namespaces are looked up with reader, typically the manager's cache. All other
+// operations are passed through to c.
+func NewGuardedClient(c client.Client, reader client.Reader) client.Client {
+	return &guardedClient{Client: c, reader: reader}
+}
+
+type guardedClient struct {
+	client.Client
+	reader client.Reader
+}
+
+func (c *guardedClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if obj.GetNamespace() != "" {
+		if err := Check(ctx, c.reader, obj.GetNamespace()); err != nil {
+			return err
+		}
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+// Predicate returns a predicate that drops the Create, Update and Generic events of objects in
+// terminating namespaces, so that no new dependents are created for them. Events of objects
+// that are being deleted themselves always pass, so that their finalizers can still be handled,
+// as do Delete events and events of cluster-scoped objects. Namespaces are looked up with
+// reader, typically the manager's cache. If a namespace cannot be looked up, events pass.
+func Predicate[T client.Object](reader client.Reader) predicate.TypedPredicate[T] {
+	return predicate.TypedFuncs[T]{
+		CreateFunc: func(e event.TypedCreateEvent[T]) bool {
+			return passes(reader, e.Object)
+		},
+		UpdateFunc: func(e event.TypedUpdateEvent[T]) bool {
+			return passes(reader, e.ObjectNew)
+		},
+		DeleteFunc: func(event.TypedDeleteEvent[T]) bool {
+			return true
+		},
+		GenericFunc: func(e event.TypedGenericEvent[T]) bool {
+			return passes(reader, e.Object)
+		},
+	}
+}
+
+func passes(reader client.Reader, o client.Object) bool {
+	if o == nil || o.GetNamespace() == "" || !o.GetDeletionTimestamp().IsZero() {
+		return true
+	}
+	terminating, err := IsNamespaceTerminating(context.Background(), reader, o.GetNamespace())
+	if err != nil {
+		log.Error(err, "Failed to check if namespace is terminating", "namespace", o.GetNamespace())
+		return true
+	}
+	if terminating {
+		log.V(1).Info("Dropping event of object in terminating namespace", "object", client.ObjectKeyFromObject(o))
+		metrics.RecordDroppedEvent(metrics.SubsystemTerminating, metrics.DropReasonNamespaceTerminating)
+		return false
+	}
+	return true
+}
+
+// RemoveFinalizer removes finalizer from obj and updates it if obj is being deleted in a
+// terminating namespace. It returns true if obj was handled, in which case the reconciler can
+// skip its usual cleanup: the dependents of obj in the namespace are deleted along with it.
+// Cleanup of resources outside of the namespace must still be done by the reconciler.
+func RemoveFinalizer(ctx context.Context, c client.Client, obj client.Object, finalizer string) (bool, error) {
+	if obj.GetDeletionTimestamp().IsZero() || obj.GetNamespace() == "" || !controllerutil.ContainsFinalizer(obj, finalizer) {
+		return false, nil
+	}
+	terminating, err := IsNamespaceTerminating(ctx, c, obj.GetNamespace())
+	if err != nil || !terminating {
+		return false, err
+	}
+
+	controllerutil.RemoveFinalizer(obj, finalizer)
+	if err := c.Update(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("error removing finalizer %q: %w", finalizer, err)
+	}
+	log.V(1).Info("Removed finalizer of object in terminating namespace", "object", client.ObjectKeyFromObject(obj),
+		"finalizer", finalizer)
+	return true, nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terminating
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+const finalizer = "example.com/cleanup"
+
+var _ = Describe("Terminating", func() {
+	var (
+		ctx context.Context
+		c   client.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now := metav1.Now()
+		c = fake.NewClientBuilder().WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "active"}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:              "deleting",
+				DeletionTimestamp: &now,
+				Finalizers:        []string{"kubernetes"},
+			}},
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "terminating"},
+				Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+			},
+		).Build()
+	})
+
+	Describe("IsNamespaceTerminating", func() {
+		It("should return false for an active namespace", func() {
+			Expect(IsNamespaceTerminating(ctx, c, "active")).To(BeFalse())
+		})
+		It("should return true for a namespace with a deletionTimestamp", func() {
+			Expect(IsNamespaceTerminating(ctx, c, "deleting")).To(BeTrue())
+		})
+		It("should return true for a namespace in the Terminating phase", func() {
+			Expect(IsNamespaceTerminating(ctx, c, "terminating")).To(BeTrue())
+		})
+		It("should return true for a namespace that does not exist", func() {
+			Expect(IsNamespaceTerminating(ctx, c, "gone")).To(BeTrue())
+		})
+	})
+
+	Describe("Check", func() {
+		It("should succeed for an active namespace", func() {
+			Expect(Check(ctx, c, "active")).To(Succeed())
+		})
+		It("should return ErrNamespaceTerminating for a terminating namespace", func() {
+			err := Check(ctx, c, "deleting")
+			Expect(err).To(MatchError(ErrNamespaceTerminating))
+			Expect(err).To(MatchError(ContainSubstring("deleting")))
+		})
+	})
+
+	Describe("IsTerminatingError", func() {
+		It("should recognize ErrNamespaceTerminating", func() {
+			Expect(IsTerminatingError(fmt.Errorf("wrapped: %w", ErrNamespaceTerminating))).To(BeTrue())
+		})
+		It("should recognize errors of the API server", func() {
+			err := apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "cm", fmt.Errorf("namespace is terminating"))
+			err.ErrStatus.Details.Causes = []metav1.StatusCause{{Type: corev1.NamespaceTerminatingCause}}
+			Expect(IsTerminatingError(err)).To(BeTrue())
+		})
+		It("should not recognize other errors", func() {
+			Expect(IsTerminatingError(fmt.Errorf("TEST"))).To(BeFalse())
+			Expect(IsTerminatingError(nil)).To(BeFalse())
+		})
+	})
+
+	Describe("NewGuardedClient", func() {
+		It("should create objects in active namespaces", func() {
+			guarded := NewGuardedClient(c, c)
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "active"}}
+			Expect(guarded.Create(ctx, cm)).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), &corev1.ConfigMap{})).To(Succeed())
+		})
+		It("should not create objects in terminating namespaces", func() {
+			guarded := NewGuardedClient(c, c)
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "deleting"}}
+			Expect(guarded.Create(ctx, cm)).To(MatchError(ErrNamespaceTerminating))
+			Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(cm), &corev1.ConfigMap{}))).To(BeTrue())
+		})
+		It("should create cluster-scoped objects", func() {
+			guarded := NewGuardedClient(c, c)
+			Expect(guarded.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "new"}})).To(Succeed())
+		})
+	})
+
+	Describe("Predicate", func() {
+		It("should pass events of objects in active namespaces", func() {
+			p := Predicate[client.Object](c)
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "active"}}
+			Expect(p.Create(event.CreateEvent{Object: cm})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: cm, ObjectNew: cm})).To(BeTrue())
+			Expect(p.Generic(event.GenericEvent{Object: cm})).To(BeTrue())
+		})
+		It("should drop events of objects in terminating namespaces", func() {
+			p := Predicate[client.Object](c)
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "deleting"}}
+			Expect(p.Create(event.CreateEvent{Object: cm})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: cm, ObjectNew: cm})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: cm})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: cm})).To(BeTrue())
+		})
+		It("should pass events of objects being deleted in terminating namespaces", func() {
+			p := Predicate[client.Object](c)
+			now := metav1.Now()
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "deleting", DeletionTimestamp: &now}}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: cm, ObjectNew: cm})).To(BeTrue())
+		})
+	})
+
+	Describe("RemoveFinalizer", func() {
+		create := func(namespace string) *corev1.ConfigMap {
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:       "cm",
+				Namespace:  namespace,
+				Finalizers: []string{finalizer},
+			}}
+			Expect(c.Create(ctx, cm)).To(Succeed())
+			Expect(c.Delete(ctx, cm)).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), cm)).To(Succeed())
+			return cm
+		}
+
+		It("should remove the finalizer of objects being deleted in terminating namespaces", func() {
+			// namespaces that are terminating do not accept new objects, so the object is created first
+			Expect(c.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns"}})).To(Succeed())
+			cm := create("ns")
+			ns := &corev1.Namespace{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "ns"}, ns)).To(Succeed())
+			ns.Status.Phase = corev1.NamespaceTerminating
+			Expect(c.Status().Update(ctx, ns)).To(Succeed())
+
+			Expect(RemoveFinalizer(ctx, c, cm, finalizer)).To(BeTrue())
+			Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(cm), &corev1.ConfigMap{}))).To(BeTrue())
+		})
+		It("should not remove the finalizer of objects in active namespaces", func() {
+			cm := create("active")
+			Expect(RemoveFinalizer(ctx, c, cm, finalizer)).To(BeFalse())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), cm)).To(Succeed())
+			Expect(cm.GetFinalizers()).To(ConsistOf(finalizer))
+		})
+		It("should not remove the finalizer of objects that are not being deleted", func() {
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:       "cm",
+				Namespace:  "terminating",
+				Finalizers: []string{finalizer},
+			}}
+			Expect(RemoveFinalizer(ctx, c, cm, finalizer)).To(BeFalse())
+			Expect(cm.GetFinalizers()).To(ConsistOf(finalizer))
+		})
+	})
+})
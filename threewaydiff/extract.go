@@ -0,0 +1,114 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package threewaydiff
+
+import (
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/value"
+)
+
+// extract returns the parts of v, an unstructured value, that are in set. Fields and list
+// elements that are members of set are copied whole, unless set has children for them, in which
+// case only their children in set are extracted. Keyed list elements keep their key fields.
+func extract(v interface{}, set *fieldpath.Set) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := map[string]interface{}{}
+		set.Children.Iterate(func(pe fieldpath.PathElement) {
+			if pe.FieldName == nil {
+				return
+			}
+			if child, ok := v[*pe.FieldName]; ok {
+				out[*pe.FieldName] = extract(child, set.Children.Descend(pe))
+			}
+		})
+		set.Members.Iterate(func(pe fieldpath.PathElement) {
+			if pe.FieldName == nil {
+				return
+			}
+			if _, extracted := out[*pe.FieldName]; extracted {
+				return
+			}
+			if child, ok := v[*pe.FieldName]; ok {
+				out[*pe.FieldName] = child
+			}
+		})
+		return out
+	case []interface{}:
+		extracted := map[int]interface{}{}
+		set.Children.Iterate(func(pe fieldpath.PathElement) {
+			if i := elementIndex(v, pe); i >= 0 {
+				item := extract(v[i], set.Children.Descend(pe))
+				if m, ok := item.(map[string]interface{}); ok && pe.Key != nil {
+					for _, field := range *pe.Key {
+						m[field.Name] = field.Value.Unstructured()
+					}
+				}
+				extracted[i] = item
+			}
+		})
+		set.Members.Iterate(func(pe fieldpath.PathElement) {
+			if i := elementIndex(v, pe); i >= 0 {
+				if _, ok := extracted[i]; !ok {
+					extracted[i] = v[i]
+				}
+			}
+		})
+
+		out := make([]interface{}, 0, len(extracted))
+		for i := range v {
+			if item, ok := extracted[i]; ok {
+				out = append(out, item)
+			}
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// elementIndex returns the index of the element of list selected by pe, or -1.
+func elementIndex(list []interface{}, pe fieldpath.PathElement) int {
+	switch {
+	case pe.Index != nil:
+		if *pe.Index < len(list) {
+			return *pe.Index
+		}
+	case pe.Value != nil:
+		for i, item := range list {
+			if value.Equals(value.NewValueInterface(item), *pe.Value) {
+				return i
+			}
+		}
+	case pe.Key != nil:
+		for i, item := range list {
+			if m, ok := item.(map[string]interface{}); ok && matchesKey(m, *pe.Key) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// matchesKey returns true if item has the fields of key.
+func matchesKey(item map[string]interface{}, key value.FieldList) bool {
+	for _, field := range key {
+		v, ok := item[field.Name]
+		if !ok || !value.Equals(value.NewValueInterface(v), field.Value) {
+			return false
+		}
+	}
+	return true
+}
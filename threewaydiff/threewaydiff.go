@@ -0,0 +1,280 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package threewaydiff computes minimal patches between the desired state of an object and its
+// live state, so that reconcilers only send updates when they are semantically required and do
+// not churn resourceVersions with no-op updates.
+//
+// Patches are three-way: fields set by the desired state are updated, fields the operator
+// previously set and no longer desires are removed, and fields set by others, such as defaults
+// of the API server or changes made by other controllers, are left untouched. The previous
+// desired state is read from the managedFields of the operator's field manager, see
+// WithFieldManager, or from the LastAppliedAnnotation of the live object, see SetLastApplied.
+// Without either, fields are never removed.
+//
+// Strategic merge patches are used for the built-in kinds of Kubernetes, and JSON merge patches
+// for other kinds, such as custom resources.
+package threewaydiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "gopkg.in/evanphx/json-patch.v4"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+)
+
+// LastAppliedAnnotation records the last desired state of an object, see SetLastApplied. It is
+// the annotation used by kubectl apply.
+const LastAppliedAnnotation = corev1.LastAppliedConfigAnnotation
+
+// Option configures Compute.
+type Option func(*options)
+
+type options struct {
+	fieldManager string
+	jsonMerge    bool
+}
+
+// WithFieldManager reads the previous desired state from the fields of the live object managed
+// by the field manager name, typically the field owner of the operator's create, update and
+// patch requests. It takes precedence over the LastAppliedAnnotation.
+func WithFieldManager(name string) Option {
+	return func(o *options) {
+		o.fieldManager = name
+	}
+}
+
+// WithJSONMergePatch computes JSON merge patches for all kinds, including built-in ones.
+func WithJSONMergePatch() Option {
+	return func(o *options) {
+		o.jsonMerge = true
+	}
+}
+
+// Patch is a patch computed by Compute. It implements client.Patch, so that it can be sent with
+// client.Patch when it is Required.
+type Patch struct {
+	patchType types.PatchType
+	data      []byte
+	required  bool
+}
+
+var _ client.Patch = &Patch{}
+
+// Type implements client.Patch.
+func (p *Patch) Type() types.PatchType {
+	return p.patchType
+}
+
+// Data implements client.Patch. The object is ignored.
+func (p *Patch) Data(client.Object) ([]byte, error) {
+	return p.data, nil
+}
+
+// Required returns true if the patch changes the live object, i.e. if an update is required.
+func (p *Patch) Required() bool {
+	return p.required
+}
+
+// Compute returns the minimal patch bringing live, the object as read from the cluster, to the
+// desired state desired. The status and the metadata set by the API server, such as the
+// resourceVersion, are ignored. If the patch is not Required, no update needs to be sent.
+func Compute(desired, live client.Object, opts ...Option) (*Patch, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	modified, err := desiredJSON(desired)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing desired object: %w", err)
+	}
+	current, err := json.Marshal(live)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing live object: %w", err)
+	}
+	original, err := originalJSON(live, o.fieldManager)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Patch{}
+	var meta strategicpatch.LookupPatchMeta
+	if !o.jsonMerge {
+		meta = patchMeta(desired)
+	}
+	if meta != nil {
+		p.patchType = types.StrategicMergePatchType
+		p.data, err = strategicpatch.CreateThreeWayMergePatch(original, modified, current, meta, true)
+	} else {
+		p.patchType = types.MergePatchType
+		p.data, err = jsonmergepatch.CreateThreeWayJSONMergePatch(original, modified, current)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error computing patch: %w", err)
+	}
+
+	// Patches may hold directives, such as the order of list elements, that do not change the
+	// live object, so the patch is applied to tell whether it does.
+	var patched []byte
+	if meta != nil {
+		patched, err = strategicpatch.StrategicMergePatchUsingLookupPatchMeta(current, p.data, meta)
+	} else {
+		patched, err = jsonpatch.MergePatch(current, p.data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error applying patch: %w", err)
+	}
+	p.required, err = changed(current, patched)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// changed returns true if the JSON documents before and after are not equal.
+func changed(before, after []byte) (bool, error) {
+	var beforeContent, afterContent interface{}
+	if err := json.Unmarshal(before, &beforeContent); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(after, &afterContent); err != nil {
+		return false, err
+	}
+	return !equality.Semantic.DeepEqual(beforeContent, afterContent), nil
+}
+
+// SetLastApplied records the desired state of obj in its LastAppliedAnnotation, so that the
+// fields removed from a later desired state are removed from the live object by the patches
+// returned by Compute. It is called on the desired object before it is created or patched.
+func SetLastApplied(obj client.Object) error {
+	annotations := obj.GetAnnotations()
+	delete(annotations, LastAppliedAnnotation)
+	obj.SetAnnotations(annotations)
+
+	data, err := desiredJSON(obj)
+	if err != nil {
+		return fmt.Errorf("error serializing desired object: %w", err)
+	}
+
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[LastAppliedAnnotation] = string(data)
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// serverMetadataFields are the fields of the metadata set by the API server.
+var serverMetadataFields = []string{
+	"creationTimestamp", "deletionGracePeriodSeconds", "deletionTimestamp", "generation",
+	"managedFields", "resourceVersion", "selfLink", "uid",
+}
+
+// desiredJSON serializes obj without its status and the metadata set by the API server.
+func desiredJSON(obj client.Object) ([]byte, error) {
+	objContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	// The content of unstructured objects is not copied by the converter, so copy the maps
+	// before removing fields from them.
+	content := make(map[string]interface{}, len(objContent))
+	for k, v := range objContent {
+		if k != "status" {
+			content[k] = v
+		}
+	}
+	if objMetadata, ok := content["metadata"].(map[string]interface{}); ok {
+		metadata := make(map[string]interface{}, len(objMetadata))
+		for k, v := range objMetadata {
+			metadata[k] = v
+		}
+		for _, field := range serverMetadataFields {
+			delete(metadata, field)
+		}
+		content["metadata"] = metadata
+	}
+	return json.Marshal(content)
+}
+
+// originalJSON returns the previous desired state of live, or nil if it is unknown.
+func originalJSON(live client.Object, fieldManager string) ([]byte, error) {
+	if fieldManager != "" {
+		set, found, err := managedFields(live, fieldManager)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(live)
+			if err != nil {
+				return nil, fmt.Errorf("error serializing live object: %w", err)
+			}
+			return json.Marshal(extract(content, set))
+		}
+	}
+
+	if lastApplied, ok := live.GetAnnotations()[LastAppliedAnnotation]; ok {
+		return []byte(lastApplied), nil
+	}
+	return nil, nil
+}
+
+// managedFields returns the fields of obj managed by fieldManager, and false if it manages none.
+func managedFields(obj client.Object, fieldManager string) (*fieldpath.Set, bool, error) {
+	set := &fieldpath.Set{}
+	found := false
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager != fieldManager || entry.Subresource != "" || entry.FieldsV1 == nil {
+			continue
+		}
+		entrySet := &fieldpath.Set{}
+		if err := entrySet.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			return nil, false, fmt.Errorf("error decoding managed fields of %s: %w", fieldManager, err)
+		}
+		set = set.Union(entrySet)
+		found = true
+	}
+	return set, found, nil
+}
+
+// patchMeta returns the strategic merge patch metadata of the kind of obj, or nil if it is not a
+// built-in kind.
+func patchMeta(obj client.Object) strategicpatch.LookupPatchMeta {
+	var typed runtime.Object = obj
+	if _, ok := obj.(runtime.Unstructured); ok {
+		var err error
+		if typed, err = clientgoscheme.Scheme.New(obj.GetObjectKind().GroupVersionKind()); err != nil {
+			return nil
+		}
+	} else if _, _, err := clientgoscheme.Scheme.ObjectKinds(obj); err != nil {
+		return nil
+	}
+
+	meta, err := strategicpatch.NewPatchMetaFromStruct(typed)
+	if err != nil {
+		return nil
+	}
+	return meta
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 The Operator-SDK Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package threewaydiff
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Compute", func() {
+	var desired, live *appsv1.Deployment
+
+	BeforeEach(func() {
+		desired = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "churro",
+				Labels:    map[string]string{"app": "churro"},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To[int32](2),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "churro"}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "churro"}},
+					Spec: corev1.PodSpec{Containers: []corev1.Container{
+						{Name: "churro", Image: "churro:v1"},
+					}},
+				},
+			},
+		}
+
+		// the live object has the defaults of the API server, changes made by other
+		// controllers, metadata and a status
+		live = desired.DeepCopy()
+		live.ResourceVersion = "42"
+		live.UID = "uid"
+		live.CreationTimestamp = metav1.Now()
+		live.Labels["team"] = "pastry"
+		live.Spec.ProgressDeadlineSeconds = ptr.To[int32](600)
+		live.Spec.Template.Spec.Containers[0].ImagePullPolicy = corev1.PullIfNotPresent
+		live.Spec.Template.Spec.Containers = append(live.Spec.Template.Spec.Containers,
+			corev1.Container{Name: "sidecar", Image: "sidecar:v1"})
+		live.Status.Replicas = 2
+	})
+
+	It("should not require an update when the desired state is live", func() {
+		patch, err := Compute(desired, live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patch.Required()).To(BeFalse())
+		Expect(patch.Type()).To(Equal(types.StrategicMergePatchType))
+	})
+
+	It("should only patch the changed fields", func() {
+		desired.Spec.Replicas = ptr.To[int32](3)
+		desired.Spec.Template.Spec.Containers[0].Image = "churro:v2"
+
+		patch, err := Compute(desired, live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patch.Required()).To(BeTrue())
+		data, err := patch.Data(live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decode(data)).To(Equal(map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": float64(3),
+				"template": map[string]interface{}{"spec": map[string]interface{}{
+					"$setElementOrder/containers": []interface{}{map[string]interface{}{"name": "churro"}},
+					"containers":                  []interface{}{map[string]interface{}{"name": "churro", "image": "churro:v2"}},
+				}},
+			},
+		}))
+	})
+
+	It("should remove the fields removed since the last applied state", func() {
+		desired.Labels["tier"] = "dessert"
+		Expect(SetLastApplied(desired)).To(Succeed())
+		live.Labels["tier"] = "dessert"
+		live.Annotations = map[string]string{LastAppliedAnnotation: desired.Annotations[LastAppliedAnnotation]}
+
+		delete(desired.Labels, "tier")
+		Expect(SetLastApplied(desired)).To(Succeed())
+		patch, err := Compute(desired, live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patch.Required()).To(BeTrue())
+
+		cl := fake.NewClientBuilder().WithObjects(live).Build()
+		Expect(cl.Patch(context.TODO(), live, patch)).To(Succeed())
+		Expect(live.Labels).To(Equal(map[string]string{"app": "churro", "team": "pastry"}))
+		Expect(live.Spec.Template.Spec.Containers).To(HaveLen(2))
+	})
+
+	It("should not remove fields without a previous state", func() {
+		delete(desired.Labels, "app")
+		patch, err := Compute(desired, live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patch.Required()).To(BeFalse())
+	})
+
+	It("should remove the fields removed since they were set by the field manager", func() {
+		live.Labels["tier"] = "dessert"
+		live.ManagedFields = []metav1.ManagedFieldsEntry{
+			{
+				Manager:    "my-operator",
+				Operation:  metav1.ManagedFieldsOperationApply,
+				FieldsType: "FieldsV1",
+				FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{".":{},"f:app":{},"f:tier":{}}},` +
+					`"f:spec":{"f:replicas":{},"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"churro\"}":{".":{},"f:image":{},"f:name":{}}}}}}}`)},
+			},
+			{
+				Manager:    "other-controller",
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				FieldsType: "FieldsV1",
+				FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:team":{}}},` +
+					`"f:spec":{"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"sidecar\"}":{".":{},"f:image":{},"f:name":{}}}}}}}`)},
+			},
+		}
+
+		patch, err := Compute(desired, live, WithFieldManager("my-operator"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patch.Required()).To(BeTrue())
+		data, err := patch.Data(live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decode(data)).To(HaveKeyWithValue("metadata", map[string]interface{}{
+			"labels": map[string]interface{}{"tier": nil},
+		}))
+
+		cl := fake.NewClientBuilder().WithObjects(live).Build()
+		Expect(cl.Patch(context.TODO(), live, patch)).To(Succeed())
+		Expect(live.Labels).To(Equal(map[string]string{"app": "churro", "team": "pastry"}))
+		Expect(live.Spec.Template.Spec.Containers).To(HaveLen(2))
+		Expect(live.Spec.Replicas).To(Equal(ptr.To[int32](2)))
+	})
+
+	It("should use JSON merge patches for custom resources", func() {
+		cr := func(size int64) *unstructured.Unstructured {
+			u := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "cache.example.com/v1",
+				"kind":       "Memcached",
+				"metadata":   map[string]interface{}{"namespace": "default", "name": "churro"},
+				"spec":       map[string]interface{}{"size": size},
+			}}
+			return u
+		}
+		liveCR := cr(1)
+		liveCR.SetResourceVersion("42")
+		Expect(unstructured.SetNestedField(liveCR.Object, "Ready", "status", "phase")).To(Succeed())
+
+		patch, err := Compute(cr(1), liveCR)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patch.Required()).To(BeFalse())
+
+		patch, err = Compute(cr(2), liveCR)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patch.Type()).To(Equal(types.MergePatchType))
+		data, err := patch.Data(liveCR)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decode(data)).To(Equal(map[string]interface{}{"spec": map[string]interface{}{"size": float64(2)}}))
+	})
+
+	It("should use JSON merge patches when asked to", func() {
+		desired.Spec.Replicas = ptr.To[int32](3)
+		patch, err := Compute(desired, live, WithJSONMergePatch())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patch.Type()).To(Equal(types.MergePatchType))
+		Expect(patch.Required()).To(BeTrue())
+	})
+
+	It("should not modify unstructured desired objects of built-in kinds", func() {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+		Expect(err).NotTo(HaveOccurred())
+		u := &unstructured.Unstructured{Object: content}
+		u.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("Deployment"))
+		u.SetResourceVersion("42")
+
+		patch, err := Compute(u, live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patch.Type()).To(Equal(types.StrategicMergePatchType))
+		Expect(u.GetResourceVersion()).To(Equal("42"))
+	})
+})
+
+func decode(data []byte) map[string]interface{} {
+	m := map[string]interface{}{}
+	ExpectWithOffset(1, json.Unmarshal(data, &m)).To(Succeed())
+	return m
+}